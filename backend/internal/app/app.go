@@ -23,13 +23,22 @@ type App struct {
 	Logger *zap.Logger
 
 	// Services
-	BuildingService      service.BuildingServiceInterface
-	CourseService        service.CourseServiceInterface
-	CourseSessionService service.CourseSessionServiceInterface
-	RoomService          service.RoomServiceInterface
-	RoomTypeService      service.RoomTypeServiceInterface
-	ScheduleService      service.ScheduleServiceInterface
-	SchedulerService     service.SchedulerServiceInterface
+	BuildingService               service.BuildingServiceInterface
+	BuildingTravelTimeService     service.BuildingTravelTimeServiceInterface
+	CohortService                 service.CohortServiceInterface
+	CourseService                 service.CourseServiceInterface
+	CourseSessionService          service.CourseSessionServiceInterface
+	CourseSectionService          service.CourseSectionServiceInterface
+	InstructorService             service.InstructorServiceInterface
+	InstructorAvailabilityService service.InstructorAvailabilityServiceInterface
+	RoomService                   service.RoomServiceInterface
+	RoomUnavailabilityService     service.RoomUnavailabilityServiceInterface
+	RoomTypeService               service.RoomTypeServiceInterface
+	ScheduleService               service.ScheduleServiceInterface
+	SchedulerService              service.SchedulerServiceInterface
+	SessionGroupService           service.SessionGroupServiceInterface
+	SessionPinService             service.SessionPinServiceInterface
+	SessionLinkService            service.SessionLinkServiceInterface
 }
 
 // New initializes the application with all dependencies
@@ -52,24 +61,42 @@ func New(cfg *Config) (*App, error) {
 
 	// Initialize repositories
 	buildingRepo := repository.NewBuildingRepository(db, logger)
+	buildingTravelTimeRepo := repository.NewBuildingTravelTimeRepository(db, logger)
+	cohortRepo := repository.NewCohortRepository(db, logger)
 	courseRepo := repository.NewCourseRepository(db, logger)
 	courseSessionRepo := repository.NewCourseSessionRepository(db, logger)
+	courseSectionRepo := repository.NewCourseSectionRepository(db, logger)
+	instructorRepo := repository.NewInstructorRepository(db, logger)
+	instructorAvailabilityRepo := repository.NewInstructorAvailabilityRepository(db, logger)
 	roomRepo := repository.NewRoomRepository(db, logger)
+	roomUnavailabilityRepo := repository.NewRoomUnavailabilityRepository(db, logger)
 	roomTypeRepo := repository.NewRoomTypeRepository(db, logger)
 	scheduleRepo := repository.NewScheduleRepository(db, logger)
+	sessionGroupRepo := repository.NewSessionGroupRepository(db, logger)
+	sessionPinRepo := repository.NewSessionPinRepository(db, logger)
+	sessionLinkRepo := repository.NewSessionLinkRepository(db, logger)
 
 	// Initialize services
 	buildingService := service.NewBuildingService(buildingRepo)
+	buildingTravelTimeService := service.NewBuildingTravelTimeService(buildingTravelTimeRepo)
+	cohortService := service.NewCohortService(cohortRepo)
 	courseService := service.NewCourseService(courseRepo)
 	courseSessionService := service.NewCourseSessionService(courseSessionRepo)
+	courseSectionService := service.NewCourseSectionService(courseSectionRepo)
+	instructorService := service.NewInstructorService(instructorRepo)
+	instructorAvailabilityService := service.NewInstructorAvailabilityService(instructorAvailabilityRepo)
 	roomService := service.NewRoomService(roomRepo)
+	roomUnavailabilityService := service.NewRoomUnavailabilityService(roomUnavailabilityRepo)
 	roomTypeService := service.NewRoomTypeService(roomTypeRepo)
 	scheduleService := service.NewScheduleService(scheduleRepo)
+	sessionGroupService := service.NewSessionGroupService(sessionGroupRepo)
+	sessionPinService := service.NewSessionPinService(sessionPinRepo)
+	sessionLinkService := service.NewSessionLinkService(sessionLinkRepo)
 
 	// Initialize scheduler
 	weightStrategy := &weight.TotalTimeWeight{}
 	scheduler := greedy.NewGreedyScheduler(weightStrategy)
-	schedulerService := service.NewSchedulerService(scheduler, scheduleRepo, roomRepo, courseRepo, courseSessionRepo)
+	schedulerService := service.NewSchedulerService(scheduler, scheduleRepo, roomRepo, courseRepo, courseSessionRepo, instructorRepo, cohortRepo, roomUnavailabilityRepo, instructorAvailabilityRepo, buildingTravelTimeRepo, sessionPinRepo, sessionLinkRepo, courseSectionRepo, roomTypeRepo, sessionGroupRepo)
 
 	// Initialize router
 	router := chi.NewRouter()
@@ -78,17 +105,26 @@ func New(cfg *Config) (*App, error) {
 	router.Use(middleware.RequestID)
 
 	app := &App{
-		Config:               cfg,
-		DB:                   db,
-		Router:               router,
-		Logger:               logger,
-		BuildingService:      buildingService,
-		CourseService:        courseService,
-		CourseSessionService: courseSessionService,
-		RoomService:          roomService,
-		RoomTypeService:      roomTypeService,
-		ScheduleService:      scheduleService,
-		SchedulerService:     schedulerService,
+		Config:                        cfg,
+		DB:                            db,
+		Router:                        router,
+		Logger:                        logger,
+		BuildingService:               buildingService,
+		BuildingTravelTimeService:     buildingTravelTimeService,
+		CohortService:                 cohortService,
+		CourseService:                 courseService,
+		CourseSessionService:          courseSessionService,
+		CourseSectionService:          courseSectionService,
+		InstructorService:             instructorService,
+		InstructorAvailabilityService: instructorAvailabilityService,
+		RoomService:                   roomService,
+		RoomUnavailabilityService:     roomUnavailabilityService,
+		RoomTypeService:               roomTypeService,
+		ScheduleService:               scheduleService,
+		SchedulerService:              schedulerService,
+		SessionGroupService:           sessionGroupService,
+		SessionPinService:             sessionPinService,
+		SessionLinkService:            sessionLinkService,
 	}
 
 	app.setupRoutes()
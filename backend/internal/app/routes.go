@@ -10,11 +10,20 @@ import (
 func (a *App) setupRoutes() {
 	// Initialize handlers
 	buildingHandler := handlers.NewBuildingHandler(a.BuildingService)
+	buildingTravelTimeHandler := handlers.NewBuildingTravelTimeHandler(a.BuildingTravelTimeService)
+	cohortHandler := handlers.NewCohortHandler(a.CohortService)
 	courseHandler := handlers.NewCourseHandler(a.CourseService)
 	courseSessionHandler := handlers.NewCourseSessionHandler(a.CourseSessionService)
+	courseSectionHandler := handlers.NewCourseSectionHandler(a.CourseSectionService)
+	instructorHandler := handlers.NewInstructorHandler(a.InstructorService)
+	instructorAvailabilityHandler := handlers.NewInstructorAvailabilityHandler(a.InstructorAvailabilityService)
 	roomHandler := handlers.NewRoomHandler(a.RoomService)
+	roomUnavailabilityHandler := handlers.NewRoomUnavailabilityHandler(a.RoomUnavailabilityService)
 	roomTypeHandler := handlers.NewRoomTypeHandler(a.RoomTypeService)
 	scheduleHandler := handlers.NewScheduleHandler(a.ScheduleService)
+	sessionGroupHandler := handlers.NewSessionGroupHandler(a.SessionGroupService)
+	sessionPinHandler := handlers.NewSessionPinHandler(a.SessionPinService)
+	sessionLinkHandler := handlers.NewSessionLinkHandler(a.SessionLinkService)
 	schedulerHandler := handlers.NewSchedulerHandler(a.SchedulerService)
 
 	a.Router.Route("/api/v1", func(r chi.Router) {
@@ -25,6 +34,19 @@ func (a *App) setupRoutes() {
 			r.Get("/{id}", buildingHandler.GetByID)
 			r.Put("/{id}", buildingHandler.Update)
 			r.Delete("/{id}", buildingHandler.Delete)
+			r.Get("/{id}/travel-times", buildingTravelTimeHandler.List)
+			r.Get("/{id}/travel-times/{toBuildingId}", buildingTravelTimeHandler.GetByID)
+			r.Put("/{id}/travel-times/{toBuildingId}", buildingTravelTimeHandler.Set)
+			r.Delete("/{id}/travel-times/{toBuildingId}", buildingTravelTimeHandler.Delete)
+		})
+
+		// Cohorts
+		r.Route("/cohorts", func(r chi.Router) {
+			r.Get("/", cohortHandler.List)
+			r.Post("/", cohortHandler.Create)
+			r.Get("/{id}", cohortHandler.GetByID)
+			r.Put("/{id}", cohortHandler.Update)
+			r.Delete("/{id}", cohortHandler.Delete)
 		})
 
 		// Courses
@@ -35,6 +57,11 @@ func (a *App) setupRoutes() {
 			r.Put("/{id}", courseHandler.Update)
 			r.Delete("/{id}", courseHandler.Delete)
 			r.Get("/{id}/sessions", courseSessionHandler.GetByCourseID)
+			r.Get("/{id}/sections", courseSectionHandler.List)
+			r.Post("/{id}/sections", courseSectionHandler.Create)
+			r.Get("/{id}/sections/{sectionId}", courseSectionHandler.GetByID)
+			r.Put("/{id}/sections/{sectionId}", courseSectionHandler.Update)
+			r.Delete("/{id}/sections/{sectionId}", courseSectionHandler.Delete)
 		})
 
 		// Course Sessions
@@ -44,6 +71,33 @@ func (a *App) setupRoutes() {
 			r.Get("/{id}", courseSessionHandler.GetByID)
 			r.Put("/{id}", courseSessionHandler.Update)
 			r.Delete("/{id}", courseSessionHandler.Delete)
+			r.Get("/{id}/instructors", instructorHandler.GetBySessionID)
+			r.Post("/{id}/instructors", instructorHandler.Assign)
+			r.Delete("/{id}/instructors/{instructorId}", instructorHandler.Unassign)
+			r.Get("/{id}/pins", sessionPinHandler.List)
+			r.Post("/{id}/pins", sessionPinHandler.Create)
+			r.Get("/{id}/pins/{pinId}", sessionPinHandler.GetByID)
+			r.Put("/{id}/pins/{pinId}", sessionPinHandler.Update)
+			r.Delete("/{id}/pins/{pinId}", sessionPinHandler.Delete)
+			r.Get("/{id}/links", sessionLinkHandler.List)
+			r.Post("/{id}/links", sessionLinkHandler.Create)
+			r.Get("/{id}/links/{linkId}", sessionLinkHandler.GetByID)
+			r.Put("/{id}/links/{linkId}", sessionLinkHandler.Update)
+			r.Delete("/{id}/links/{linkId}", sessionLinkHandler.Delete)
+		})
+
+		// Instructors
+		r.Route("/instructors", func(r chi.Router) {
+			r.Get("/", instructorHandler.List)
+			r.Post("/", instructorHandler.Create)
+			r.Get("/{id}", instructorHandler.GetByID)
+			r.Put("/{id}", instructorHandler.Update)
+			r.Delete("/{id}", instructorHandler.Delete)
+			r.Get("/{id}/availability", instructorAvailabilityHandler.List)
+			r.Post("/{id}/availability", instructorAvailabilityHandler.Create)
+			r.Get("/{id}/availability/{availabilityId}", instructorAvailabilityHandler.GetByID)
+			r.Put("/{id}/availability/{availabilityId}", instructorAvailabilityHandler.Update)
+			r.Delete("/{id}/availability/{availabilityId}", instructorAvailabilityHandler.Delete)
 		})
 
 		// Rooms
@@ -53,6 +107,11 @@ func (a *App) setupRoutes() {
 			r.Get("/{id}", roomHandler.GetByID)
 			r.Put("/{id}", roomHandler.Update)
 			r.Delete("/{id}", roomHandler.Delete)
+			r.Get("/{id}/unavailability", roomUnavailabilityHandler.List)
+			r.Post("/{id}/unavailability", roomUnavailabilityHandler.Create)
+			r.Get("/{id}/unavailability/{unavailabilityId}", roomUnavailabilityHandler.GetByID)
+			r.Put("/{id}/unavailability/{unavailabilityId}", roomUnavailabilityHandler.Update)
+			r.Delete("/{id}/unavailability/{unavailabilityId}", roomUnavailabilityHandler.Delete)
 		})
 
 		// Room Types
@@ -71,12 +130,25 @@ func (a *App) setupRoutes() {
 			r.Get("/{id}", scheduleHandler.GetByID)
 			r.Put("/{id}", scheduleHandler.Update)
 			r.Delete("/{id}", scheduleHandler.Delete)
+			r.Post("/{id}/score", schedulerHandler.Score)
+			r.Post("/{id}/repair", schedulerHandler.Repair)
 		})
 
 		// Scheduler
 		r.Route("/scheduler", func(r chi.Router) {
 			r.Post("/generate", schedulerHandler.Generate)
+			r.Post("/generate/stream", schedulerHandler.GenerateStream)
 			r.Post("/generate-and-save", schedulerHandler.GenerateAndSave)
+			r.Get("/strategies", schedulerHandler.Strategies)
+		})
+
+		// Session Groups
+		r.Route("/session-groups", func(r chi.Router) {
+			r.Get("/", sessionGroupHandler.List)
+			r.Post("/", sessionGroupHandler.Create)
+			r.Get("/{id}", sessionGroupHandler.GetByID)
+			r.Put("/{id}", sessionGroupHandler.Update)
+			r.Delete("/{id}", sessionGroupHandler.Delete)
 		})
 	})
 }
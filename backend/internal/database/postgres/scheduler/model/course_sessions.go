@@ -22,4 +22,8 @@ type CourseSessions struct {
 	NumberOfSessions *int32            // How many times per week this session occurs
 	CreatedAt        *time.Time
 	UpdatedAt        *time.Time
+	Enrollment       *int32  // Overrides the course enrollment for this session when set
+	AllowedWindows   string  // JSONB array: [{day, start_time, end_time}, ...] - meetings only take place inside these when any are set
+	PreferredWindows string  // JSONB array: [{day, start_time, end_time, weight}, ...] - meetings are favoured inside these, heavier windows first
+	SpreadRules      *string // JSONB object: {min_days_between, max_per_day, day_patterns} - overrides the scheduler's spreading rules
 }
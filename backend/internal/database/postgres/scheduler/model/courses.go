@@ -13,8 +13,10 @@ import (
 )
 
 type Courses struct {
-	ID        uuid.UUID `sql:"primary_key"`
-	Name      string
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
+	ID         uuid.UUID `sql:"primary_key"`
+	Name       string
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+	Enrollment int32 // Expected number of students enrolled
+	Priority   int32 // Scheduling priority; higher is placed first by the priority weight strategy
 }
@@ -26,6 +26,10 @@ type courseSessionsTable struct {
 	NumberOfSessions postgres.ColumnInteger // How many times per week this session occurs
 	CreatedAt        postgres.ColumnTimestamp
 	UpdatedAt        postgres.ColumnTimestamp
+	Enrollment       postgres.ColumnInteger // Overrides the course enrollment for this session when set
+	AllowedWindows   postgres.ColumnString  // JSONB array: [{day, start_time, end_time}, ...] - meetings only take place inside these when any are set
+	PreferredWindows postgres.ColumnString  // JSONB array: [{day, start_time, end_time, weight}, ...] - meetings are favoured inside these, heavier windows first
+	SpreadRules      postgres.ColumnString  // JSONB object: {min_days_between, max_per_day, day_patterns} - overrides the scheduler's spreading rules
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -75,9 +79,13 @@ func newCourseSessionsTableImpl(schemaName, tableName, alias string) courseSessi
 		NumberOfSessionsColumn = postgres.IntegerColumn("number_of_sessions")
 		CreatedAtColumn        = postgres.TimestampColumn("created_at")
 		UpdatedAtColumn        = postgres.TimestampColumn("updated_at")
-		allColumns             = postgres.ColumnList{IDColumn, CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns         = postgres.ColumnList{CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn}
-		defaultColumns         = postgres.ColumnList{CreatedAtColumn}
+		EnrollmentColumn       = postgres.IntegerColumn("enrollment")
+		AllowedWindowsColumn   = postgres.StringColumn("allowed_windows")
+		PreferredWindowsColumn = postgres.StringColumn("preferred_windows")
+		SpreadRulesColumn      = postgres.StringColumn("spread_rules")
+		allColumns             = postgres.ColumnList{IDColumn, CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn, EnrollmentColumn, AllowedWindowsColumn, PreferredWindowsColumn, SpreadRulesColumn}
+		mutableColumns         = postgres.ColumnList{CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn, EnrollmentColumn, AllowedWindowsColumn, PreferredWindowsColumn, SpreadRulesColumn}
+		defaultColumns         = postgres.ColumnList{CreatedAtColumn, AllowedWindowsColumn, PreferredWindowsColumn}
 	)
 
 	return courseSessionsTable{
@@ -92,6 +100,10 @@ func newCourseSessionsTableImpl(schemaName, tableName, alias string) courseSessi
 		NumberOfSessions: NumberOfSessionsColumn,
 		CreatedAt:        CreatedAtColumn,
 		UpdatedAt:        UpdatedAtColumn,
+		Enrollment:       EnrollmentColumn,
+		AllowedWindows:   AllowedWindowsColumn,
+		PreferredWindows: PreferredWindowsColumn,
+		SpreadRules:      SpreadRulesColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
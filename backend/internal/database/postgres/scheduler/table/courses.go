@@ -17,10 +17,12 @@ type coursesTable struct {
 	postgres.Table
 
 	// Columns
-	ID        postgres.ColumnString
-	Name      postgres.ColumnString
-	CreatedAt postgres.ColumnTimestamp
-	UpdatedAt postgres.ColumnTimestamp
+	ID         postgres.ColumnString
+	Name       postgres.ColumnString
+	CreatedAt  postgres.ColumnTimestamp
+	UpdatedAt  postgres.ColumnTimestamp
+	Enrollment postgres.ColumnInteger // Expected number of students enrolled
+	Priority   postgres.ColumnInteger // Scheduling priority; higher is placed first by the priority weight strategy
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -62,23 +64,27 @@ func newCoursesTable(schemaName, tableName, alias string) *CoursesTable {
 
 func newCoursesTableImpl(schemaName, tableName, alias string) coursesTable {
 	var (
-		IDColumn        = postgres.StringColumn("id")
-		NameColumn      = postgres.StringColumn("name")
-		CreatedAtColumn = postgres.TimestampColumn("created_at")
-		UpdatedAtColumn = postgres.TimestampColumn("updated_at")
-		allColumns      = postgres.ColumnList{IDColumn, NameColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns  = postgres.ColumnList{NameColumn, CreatedAtColumn, UpdatedAtColumn}
-		defaultColumns  = postgres.ColumnList{CreatedAtColumn}
+		IDColumn         = postgres.StringColumn("id")
+		NameColumn       = postgres.StringColumn("name")
+		CreatedAtColumn  = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn  = postgres.TimestampColumn("updated_at")
+		EnrollmentColumn = postgres.IntegerColumn("enrollment")
+		PriorityColumn   = postgres.IntegerColumn("priority")
+		allColumns       = postgres.ColumnList{IDColumn, NameColumn, CreatedAtColumn, UpdatedAtColumn, EnrollmentColumn, PriorityColumn}
+		mutableColumns   = postgres.ColumnList{NameColumn, CreatedAtColumn, UpdatedAtColumn, EnrollmentColumn, PriorityColumn}
+		defaultColumns   = postgres.ColumnList{CreatedAtColumn, EnrollmentColumn, PriorityColumn}
 	)
 
 	return coursesTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:        IDColumn,
-		Name:      NameColumn,
-		CreatedAt: CreatedAtColumn,
-		UpdatedAt: UpdatedAtColumn,
+		ID:         IDColumn,
+		Name:       NameColumn,
+		CreatedAt:  CreatedAtColumn,
+		UpdatedAt:  UpdatedAtColumn,
+		Enrollment: EnrollmentColumn,
+		Priority:   PriorityColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
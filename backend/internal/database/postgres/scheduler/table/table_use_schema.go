@@ -10,10 +10,23 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	BuildingTravelTimes = BuildingTravelTimes.FromSchema(schema)
 	Buildings = Buildings.FromSchema(schema)
+	CohortCourses = CohortCourses.FromSchema(schema)
+	Cohorts = Cohorts.FromSchema(schema)
+	CourseSections = CourseSections.FromSchema(schema)
+	CourseSessionInstructors = CourseSessionInstructors.FromSchema(schema)
 	CourseSessions = CourseSessions.FromSchema(schema)
 	Courses = Courses.FromSchema(schema)
+	InstructorAvailability = InstructorAvailability.FromSchema(schema)
+	Instructors = Instructors.FromSchema(schema)
+	RoomTypeSubstitutes = RoomTypeSubstitutes.FromSchema(schema)
 	RoomTypes = RoomTypes.FromSchema(schema)
+	RoomUnavailability = RoomUnavailability.FromSchema(schema)
 	Rooms = Rooms.FromSchema(schema)
 	Schedules = Schedules.FromSchema(schema)
+	SessionGroupMembers = SessionGroupMembers.FromSchema(schema)
+	SessionGroups = SessionGroups.FromSchema(schema)
+	SessionLinks = SessionLinks.FromSchema(schema)
+	SessionPins = SessionPins.FromSchema(schema)
 }
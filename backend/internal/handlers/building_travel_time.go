@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type BuildingTravelTimeHandler struct {
+	service service.BuildingTravelTimeServiceInterface
+}
+
+func NewBuildingTravelTimeHandler(s service.BuildingTravelTimeServiceInterface) *BuildingTravelTimeHandler {
+	return &BuildingTravelTimeHandler{service: s}
+}
+
+// SetTravelTimeRequest is the body of a request to set the travel time to another building
+type SetTravelTimeRequest struct {
+	Minutes int32 `json:"minutes"`
+}
+
+func (h *BuildingTravelTimeHandler) List(w http.ResponseWriter, r *http.Request) {
+	buildingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid building id")
+		return
+	}
+
+	travelTimes, err := h.service.GetByBuildingID(r.Context(), buildingID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list building travel times")
+		return
+	}
+	JSON(w, http.StatusOK, travelTimes)
+}
+
+func (h *BuildingTravelTimeHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	fromID, toID, ok := parseTravelTimeIDs(w, r)
+	if !ok {
+		return
+	}
+
+	travelTime, err := h.service.GetByID(r.Context(), fromID, toID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "building travel time not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get building travel time")
+		return
+	}
+	JSON(w, http.StatusOK, travelTime)
+}
+
+func (h *BuildingTravelTimeHandler) Set(w http.ResponseWriter, r *http.Request) {
+	fromID, toID, ok := parseTravelTimeIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req SetTravelTimeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	travelTime, err := h.service.Set(r.Context(), models.NewBuildingTravelTime(fromID, toID, req.Minutes, nil, nil))
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to set building travel time")
+		return
+	}
+	JSON(w, http.StatusOK, travelTime)
+}
+
+func (h *BuildingTravelTimeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	fromID, toID, ok := parseTravelTimeIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), fromID, toID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "building travel time not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete building travel time")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseTravelTimeIDs reads the origin and destination building IDs from the URL, writing a 400 on failure
+func parseTravelTimeIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	fromID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid building id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	toID, err := uuid.Parse(chi.URLParam(r, "toBuildingId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid destination building id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return fromID, toID, true
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type CohortHandler struct {
+	service service.CohortServiceInterface
+}
+
+func NewCohortHandler(s service.CohortServiceInterface) *CohortHandler {
+	return &CohortHandler{service: s}
+}
+
+func (h *CohortHandler) List(w http.ResponseWriter, r *http.Request) {
+	cohorts, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list cohorts")
+		return
+	}
+	JSON(w, http.StatusOK, cohorts)
+}
+
+func (h *CohortHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var cohort models.Cohort
+	if err := json.NewDecoder(r.Body).Decode(&cohort); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	cohort.ID = uuid.New()
+
+	created, err := h.service.Create(r.Context(), &cohort)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create cohort")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *CohortHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	cohort, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "cohort not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get cohort")
+		return
+	}
+	JSON(w, http.StatusOK, cohort)
+}
+
+func (h *CohortHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var updates models.CohortUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "cohort not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update cohort")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *CohortHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "cohort not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete cohort")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
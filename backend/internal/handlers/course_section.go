@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type CourseSectionHandler struct {
+	service service.CourseSectionServiceInterface
+}
+
+func NewCourseSectionHandler(s service.CourseSectionServiceInterface) *CourseSectionHandler {
+	return &CourseSectionHandler{service: s}
+}
+
+func (h *CourseSectionHandler) List(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid course id")
+		return
+	}
+
+	sections, err := h.service.GetByCourseID(r.Context(), courseID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list course sections")
+		return
+	}
+	JSON(w, http.StatusOK, sections)
+}
+
+func (h *CourseSectionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid course id")
+		return
+	}
+
+	var section models.CourseSection
+	if err := json.NewDecoder(r.Body).Decode(&section); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	section.ID = uuid.New()
+	section.CourseID = courseID
+
+	created, err := h.service.Create(r.Context(), &section)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to create course section")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *CourseSectionHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	courseID, id, ok := parseCourseSectionIDs(w, r)
+	if !ok {
+		return
+	}
+
+	section, err := h.service.GetByID(r.Context(), courseID, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "course section not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get course section")
+		return
+	}
+	JSON(w, http.StatusOK, section)
+}
+
+func (h *CourseSectionHandler) Update(w http.ResponseWriter, r *http.Request) {
+	courseID, id, ok := parseCourseSectionIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var updates models.CourseSectionUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), courseID, id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "course section not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update course section")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *CourseSectionHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	courseID, id, ok := parseCourseSectionIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), courseID, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "course section not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete course section")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseCourseSectionIDs reads the course and section IDs from the URL, writing a 400 on failure
+func parseCourseSectionIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid course id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "sectionId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid section id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return courseID, id, true
+}
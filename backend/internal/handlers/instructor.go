@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type InstructorHandler struct {
+	service service.InstructorServiceInterface
+}
+
+func NewInstructorHandler(s service.InstructorServiceInterface) *InstructorHandler {
+	return &InstructorHandler{service: s}
+}
+
+type AssignInstructorRequest struct {
+	InstructorID uuid.UUID `json:"instructor_id"`
+}
+
+func (h *InstructorHandler) List(w http.ResponseWriter, r *http.Request) {
+	instructors, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list instructors")
+		return
+	}
+	JSON(w, http.StatusOK, instructors)
+}
+
+func (h *InstructorHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var instructor models.Instructor
+	if err := json.NewDecoder(r.Body).Decode(&instructor); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	instructor.ID = uuid.New()
+
+	created, err := h.service.Create(r.Context(), &instructor)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create instructor")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *InstructorHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	instructor, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get instructor")
+		return
+	}
+	JSON(w, http.StatusOK, instructor)
+}
+
+func (h *InstructorHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var updates models.InstructorUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update instructor")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *InstructorHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete instructor")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *InstructorHandler) GetBySessionID(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	instructors, err := h.service.GetBySessionID(r.Context(), sessionID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to get instructors")
+		return
+	}
+	JSON(w, http.StatusOK, instructors)
+}
+
+func (h *InstructorHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	var req AssignInstructorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.InstructorID == uuid.Nil {
+		Error(w, http.StatusBadRequest, "instructor_id is required")
+		return
+	}
+
+	assignment, err := h.service.Assign(r.Context(), sessionID, req.InstructorID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to assign instructor")
+		return
+	}
+	JSON(w, http.StatusCreated, assignment)
+}
+
+func (h *InstructorHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	instructorID, err := uuid.Parse(chi.URLParam(r, "instructorId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid instructor id")
+		return
+	}
+
+	if err := h.service.Unassign(r.Context(), sessionID, instructorID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor assignment not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to unassign instructor")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type InstructorAvailabilityHandler struct {
+	service service.InstructorAvailabilityServiceInterface
+}
+
+func NewInstructorAvailabilityHandler(s service.InstructorAvailabilityServiceInterface) *InstructorAvailabilityHandler {
+	return &InstructorAvailabilityHandler{service: s}
+}
+
+func (h *InstructorAvailabilityHandler) List(w http.ResponseWriter, r *http.Request) {
+	instructorID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid instructor id")
+		return
+	}
+
+	availability, err := h.service.GetByInstructorID(r.Context(), instructorID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list instructor availability")
+		return
+	}
+	JSON(w, http.StatusOK, availability)
+}
+
+func (h *InstructorAvailabilityHandler) Create(w http.ResponseWriter, r *http.Request) {
+	instructorID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid instructor id")
+		return
+	}
+
+	var availability models.InstructorAvailability
+	if err := json.NewDecoder(r.Body).Decode(&availability); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	availability.ID = uuid.New()
+	availability.InstructorID = instructorID
+
+	created, err := h.service.Create(r.Context(), &availability)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to create instructor availability")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *InstructorAvailabilityHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	instructorID, id, ok := parseInstructorAvailabilityIDs(w, r)
+	if !ok {
+		return
+	}
+
+	availability, err := h.service.GetByID(r.Context(), instructorID, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor availability not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get instructor availability")
+		return
+	}
+	JSON(w, http.StatusOK, availability)
+}
+
+func (h *InstructorAvailabilityHandler) Update(w http.ResponseWriter, r *http.Request) {
+	instructorID, id, ok := parseInstructorAvailabilityIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var updates models.InstructorAvailabilityUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), instructorID, id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor availability not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update instructor availability")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *InstructorAvailabilityHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	instructorID, id, ok := parseInstructorAvailabilityIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), instructorID, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor availability not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete instructor availability")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseInstructorAvailabilityIDs reads the instructor and availability IDs from the URL, writing a 400 on failure
+func parseInstructorAvailabilityIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	instructorID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid instructor id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "availabilityId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid availability id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return instructorID, id, true
+}
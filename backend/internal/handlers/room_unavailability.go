@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type RoomUnavailabilityHandler struct {
+	service service.RoomUnavailabilityServiceInterface
+}
+
+func NewRoomUnavailabilityHandler(s service.RoomUnavailabilityServiceInterface) *RoomUnavailabilityHandler {
+	return &RoomUnavailabilityHandler{service: s}
+}
+
+func (h *RoomUnavailabilityHandler) List(w http.ResponseWriter, r *http.Request) {
+	roomID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+
+	unavailability, err := h.service.GetByRoomID(r.Context(), roomID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list room unavailability")
+		return
+	}
+	JSON(w, http.StatusOK, unavailability)
+}
+
+func (h *RoomUnavailabilityHandler) Create(w http.ResponseWriter, r *http.Request) {
+	roomID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+
+	var unavailability models.RoomUnavailability
+	if err := json.NewDecoder(r.Body).Decode(&unavailability); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	unavailability.ID = uuid.New()
+	unavailability.RoomID = roomID
+
+	created, err := h.service.Create(r.Context(), &unavailability)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to create room unavailability")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *RoomUnavailabilityHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	roomID, id, ok := parseRoomUnavailabilityIDs(w, r)
+	if !ok {
+		return
+	}
+
+	unavailability, err := h.service.GetByID(r.Context(), roomID, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "room unavailability not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get room unavailability")
+		return
+	}
+	JSON(w, http.StatusOK, unavailability)
+}
+
+func (h *RoomUnavailabilityHandler) Update(w http.ResponseWriter, r *http.Request) {
+	roomID, id, ok := parseRoomUnavailabilityIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var updates models.RoomUnavailabilityUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), roomID, id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "room unavailability not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update room unavailability")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *RoomUnavailabilityHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	roomID, id, ok := parseRoomUnavailabilityIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), roomID, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "room unavailability not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete room unavailability")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseRoomUnavailabilityIDs reads the room and unavailability IDs from the URL, writing a 400 on failure
+func parseRoomUnavailabilityIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	roomID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid room id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "unavailabilityId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid unavailability id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return roomID, id, true
+}
@@ -2,9 +2,18 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"sync"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/repair"
 	"github.com/TerrenceMurray/course-scheduler/internal/service"
 )
 
@@ -17,15 +26,21 @@ func NewSchedulerHandler(s service.SchedulerServiceInterface) *SchedulerHandler
 }
 
 type GenerateRequest struct {
-	Name   string            `json:"name"`
-	Config *scheduler.Config `json:"config,omitempty"`
+	Name   string               `json:"name"`
+	Config *scheduler.Config    `json:"config,omitempty"`
+	Pins   []*models.SessionPin `json:"pins,omitempty"` // honoured alongside the stored pins
+
+	// Strategy names the weight strategy ordering the courses (see GET /scheduler/strategies),
+	// or "portfolio" to try every strategy, room selection policy and several seeds and keep the best
+	Strategy string `json:"strategy,omitempty"`
 }
 
 type GenerateResponse struct {
-	Schedule any                        `json:"schedule,omitempty"`
-	Output   *scheduler.Output          `json:"output,omitempty"`
-	Failures []*scheduler.FailedSession `json:"failures,omitempty"`
-	Error    string                     `json:"error,omitempty"`
+	Schedule     any                        `json:"schedule,omitempty"`
+	Output       *scheduler.Output          `json:"output,omitempty"`
+	Failures     []*scheduler.FailedSession `json:"failures,omitempty"`
+	PinConflicts []*scheduler.PinConflict   `json:"pin_conflicts,omitempty"`
+	Error        string                     `json:"error,omitempty"`
 }
 
 func (h *SchedulerHandler) Generate(w http.ResponseWriter, r *http.Request) {
@@ -35,8 +50,15 @@ func (h *SchedulerHandler) Generate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	output, err := h.service.Generate(r.Context(), req.Config)
+	if !validPins(w, req.Pins) {
+		return
+	}
+
+	output, err := h.service.Generate(r.Context(), req.Config, req.Pins, req.Strategy, nil)
 	if err != nil {
+		if writePinConflicts(w, err) || writeInvalidOption(w, err) || writeGroupMismatch(w, err) {
+			return
+		}
 		Error(w, http.StatusInternalServerError, "failed to generate schedule")
 		return
 	}
@@ -59,8 +81,15 @@ func (h *SchedulerHandler) GenerateAndSave(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	schedule, output, err := h.service.GenerateAndSave(r.Context(), req.Name, req.Config)
+	if !validPins(w, req.Pins) {
+		return
+	}
+
+	schedule, output, err := h.service.GenerateAndSave(r.Context(), req.Name, req.Config, req.Pins, req.Strategy, nil)
 	if err != nil {
+		if writePinConflicts(w, err) || writeInvalidOption(w, err) || writeGroupMismatch(w, err) {
+			return
+		}
 		// If we have output but save failed, still return the generated schedule info
 		if output != nil {
 			JSON(w, http.StatusInternalServerError, GenerateResponse{
@@ -80,3 +109,208 @@ func (h *SchedulerHandler) GenerateAndSave(w http.ResponseWriter, r *http.Reques
 		Failures: output.Failures,
 	})
 }
+
+// validPins writes a 400 and returns false when a pin in the request body is invalid on its own
+func validPins(w http.ResponseWriter, pins []*models.SessionPin) bool {
+	for _, pin := range pins {
+		if pin == nil {
+			continue
+		}
+		if err := pin.Validate(); err != nil {
+			Error(w, http.StatusBadRequest, "invalid pin: "+err.Error())
+			return false
+		}
+	}
+
+	return true
+}
+
+// ProgressEvent is a progress report line of the GenerateStream response
+type ProgressEvent struct {
+	Progress scheduler.Progress `json:"progress"`
+}
+
+// GenerateStream generates a schedule like Generate, streaming newline-delimited JSON: a
+// ProgressEvent line for each of the scheduler's progress reports, then a GenerateResponse line.
+// Errors found before the first report get the same status codes as Generate; later ones end
+// the stream with a GenerateResponse carrying the error.
+func (h *SchedulerHandler) GenerateStream(w http.ResponseWriter, r *http.Request) {
+	var req GenerateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if !validPins(w, req.Pins) {
+		return
+	}
+
+	stream := &ndjsonStream{w: w}
+	output, err := h.service.Generate(r.Context(), req.Config, req.Pins, req.Strategy, func(p scheduler.Progress) {
+		stream.write(ProgressEvent{Progress: p})
+	})
+	if err != nil {
+		if stream.isStarted() {
+			stream.write(GenerateResponse{Error: "failed to generate schedule"})
+			return
+		}
+		if writePinConflicts(w, err) || writeInvalidOption(w, err) || writeGroupMismatch(w, err) {
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to generate schedule")
+		return
+	}
+
+	stream.write(GenerateResponse{
+		Output:   output,
+		Failures: output.Failures,
+	})
+}
+
+// ndjsonStream writes one JSON value per line, flushing each, and sends the 200 status with the
+// first. Writes may come from several goroutines.
+type ndjsonStream struct {
+	w       http.ResponseWriter
+	mu      sync.Mutex
+	started bool
+}
+
+func (s *ndjsonStream) write(v any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	json.NewEncoder(s.w).Encode(v)
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *ndjsonStream) isStarted() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.started
+}
+
+// writePinConflicts writes a 422 listing the conflicting pins when err is a pin conflict
+func writePinConflicts(w http.ResponseWriter, err error) bool {
+	var conflictErr *scheduler.PinConflictError
+	if !errors.As(err, &conflictErr) {
+		return false
+	}
+
+	JSON(w, http.StatusUnprocessableEntity, GenerateResponse{
+		PinConflicts: conflictErr.Conflicts,
+		Error:        "pinned sessions conflict",
+	})
+	return true
+}
+
+// writeGroupMismatch writes a 422 when err is a session group whose course sessions cannot meet as one
+func writeGroupMismatch(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, scheduler.ErrSessionGroupMismatch) && !errors.Is(err, scheduler.ErrSessionGroupSectioned) {
+		return false
+	}
+
+	Error(w, http.StatusUnprocessableEntity, err.Error())
+	return true
+}
+
+// writeInvalidOption writes a 400 when err names an unknown weight strategy or room selection policy
+func writeInvalidOption(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, service.ErrUnknownStrategy) && !errors.Is(err, scheduler.ErrUnknownRoomSelection) {
+		return false
+	}
+
+	Error(w, http.StatusBadRequest, err.Error())
+	return true
+}
+
+func (h *SchedulerHandler) Strategies(w http.ResponseWriter, r *http.Request) {
+	JSON(w, http.StatusOK, h.service.Strategies())
+}
+
+func (h *SchedulerHandler) Score(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	score, err := h.service.Score(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "schedule not found")
+			return
+		}
+		if writeGroupMismatch(w, err) {
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to score schedule")
+		return
+	}
+	JSON(w, http.StatusOK, score)
+}
+
+type RepairRequest struct {
+	Config *scheduler.Config `json:"config,omitempty"` // the config the schedule was generated with
+}
+
+type RepairResponse struct {
+	Schedule *models.Schedule           `json:"schedule,omitempty"`
+	Changes  []*repair.Change           `json:"changes,omitempty"`
+	Output   *scheduler.Output          `json:"output,omitempty"`
+	Failures []*scheduler.FailedSession `json:"failures,omitempty"`
+	Error    string                     `json:"error,omitempty"`
+}
+
+func (h *SchedulerHandler) Repair(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	// The body is optional
+	var req RepairRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	schedule, result, err := h.service.Repair(r.Context(), id, req.Config)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "schedule not found")
+			return
+		}
+		if writePinConflicts(w, err) || writeGroupMismatch(w, err) {
+			return
+		}
+		// If the repair worked but saving failed, still return the changes
+		if result != nil {
+			JSON(w, http.StatusInternalServerError, RepairResponse{
+				Changes:  result.Changes,
+				Output:   result.Output,
+				Failures: result.Output.Failures,
+				Error:    "schedule repaired but failed to save: " + err.Error(),
+			})
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to repair schedule")
+		return
+	}
+
+	JSON(w, http.StatusOK, RepairResponse{
+		Schedule: schedule,
+		Changes:  result.Changes,
+		Output:   result.Output,
+		Failures: result.Output.Failures,
+	})
+}
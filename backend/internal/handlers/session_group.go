@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type SessionGroupHandler struct {
+	service service.SessionGroupServiceInterface
+}
+
+func NewSessionGroupHandler(s service.SessionGroupServiceInterface) *SessionGroupHandler {
+	return &SessionGroupHandler{service: s}
+}
+
+func (h *SessionGroupHandler) List(w http.ResponseWriter, r *http.Request) {
+	groups, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list session groups")
+		return
+	}
+	JSON(w, http.StatusOK, groups)
+}
+
+func (h *SessionGroupHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var group models.SessionGroup
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	group.ID = uuid.New()
+
+	created, err := h.service.Create(r.Context(), &group)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create session group")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *SessionGroupHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	group, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session group not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get session group")
+		return
+	}
+	JSON(w, http.StatusOK, group)
+}
+
+func (h *SessionGroupHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var updates models.SessionGroupUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session group not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update session group")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *SessionGroupHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session group not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete session group")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
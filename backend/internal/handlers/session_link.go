@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type SessionLinkHandler struct {
+	service service.SessionLinkServiceInterface
+}
+
+func NewSessionLinkHandler(s service.SessionLinkServiceInterface) *SessionLinkHandler {
+	return &SessionLinkHandler{service: s}
+}
+
+func (h *SessionLinkHandler) List(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	links, err := h.service.GetByCourseSessionID(r.Context(), courseSessionID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list session links")
+		return
+	}
+	JSON(w, http.StatusOK, links)
+}
+
+func (h *SessionLinkHandler) Create(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	var link models.SessionLink
+	if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	link.ID = uuid.New()
+	link.CourseSessionID = courseSessionID
+
+	created, err := h.service.Create(r.Context(), &link)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to create session link")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *SessionLinkHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, id, ok := parseSessionLinkIDs(w, r)
+	if !ok {
+		return
+	}
+
+	link, err := h.service.GetByID(r.Context(), courseSessionID, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session link not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get session link")
+		return
+	}
+	JSON(w, http.StatusOK, link)
+}
+
+func (h *SessionLinkHandler) Update(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, id, ok := parseSessionLinkIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var updates models.SessionLinkUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), courseSessionID, id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session link not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update session link")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *SessionLinkHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, id, ok := parseSessionLinkIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), courseSessionID, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session link not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete session link")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseSessionLinkIDs reads the course session and link IDs from the URL, writing a 400 on failure
+func parseSessionLinkIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	courseSessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "linkId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid link id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return courseSessionID, id, true
+}
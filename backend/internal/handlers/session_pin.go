@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type SessionPinHandler struct {
+	service service.SessionPinServiceInterface
+}
+
+func NewSessionPinHandler(s service.SessionPinServiceInterface) *SessionPinHandler {
+	return &SessionPinHandler{service: s}
+}
+
+func (h *SessionPinHandler) List(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	pins, err := h.service.GetByCourseSessionID(r.Context(), courseSessionID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list session pins")
+		return
+	}
+	JSON(w, http.StatusOK, pins)
+}
+
+func (h *SessionPinHandler) Create(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	var pin models.SessionPin
+	if err := json.NewDecoder(r.Body).Decode(&pin); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	pin.ID = uuid.New()
+	pin.CourseSessionID = courseSessionID
+
+	created, err := h.service.Create(r.Context(), &pin)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to create session pin")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *SessionPinHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, id, ok := parseSessionPinIDs(w, r)
+	if !ok {
+		return
+	}
+
+	pin, err := h.service.GetByID(r.Context(), courseSessionID, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session pin not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get session pin")
+		return
+	}
+	JSON(w, http.StatusOK, pin)
+}
+
+func (h *SessionPinHandler) Update(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, id, ok := parseSessionPinIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var updates models.SessionPinUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), courseSessionID, id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session pin not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update session pin")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *SessionPinHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	courseSessionID, id, ok := parseSessionPinIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), courseSessionID, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session pin not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete session pin")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseSessionPinIDs reads the course session and pin IDs from the URL, writing a 400 on failure
+func parseSessionPinIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	courseSessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid session id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "pinId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid pin id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return courseSessionID, id, true
+}
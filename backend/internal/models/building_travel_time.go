@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BuildingTravelTime is the number of minutes needed to get from one building to another.
+// It applies in both directions unless the reverse trip is recorded separately.
+type BuildingTravelTime struct {
+	FromBuildingID uuid.UUID  `json:"from_building_id"`
+	ToBuildingID   uuid.UUID  `json:"to_building_id"`
+	Minutes        int32      `json:"minutes"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewBuildingTravelTime(
+	fromBuildingID uuid.UUID,
+	toBuildingID uuid.UUID,
+	minutes int32,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *BuildingTravelTime {
+	return &BuildingTravelTime{
+		FromBuildingID: fromBuildingID,
+		ToBuildingID:   toBuildingID,
+		Minutes:        minutes,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+func (t *BuildingTravelTime) Validate() error {
+	if t.FromBuildingID == uuid.Nil || t.ToBuildingID == uuid.Nil {
+		return errors.New("both building ids are required")
+	}
+
+	if t.FromBuildingID == t.ToBuildingID {
+		return errors.New("travel time must be between two different buildings")
+	}
+
+	if t.Minutes < 0 {
+		return errors.New("travel time cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cohort is a group of students who take the same courses, so those courses must never overlap
+type Cohort struct {
+	ID        uuid.UUID   `json:"id"`
+	Name      string      `json:"name"`
+	CourseIDs []uuid.UUID `json:"course_ids"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+func NewCohort(
+	id uuid.UUID,
+	name string,
+	courseIDs []uuid.UUID,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *Cohort {
+	return &Cohort{
+		ID:        id,
+		Name:      name,
+		CourseIDs: courseIDs,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (c *Cohort) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	return validateCourseIDs(c.CourseIDs)
+}
+
+// CohortUpdate represents partial update fields for a Cohort.
+// When CourseIDs is set it replaces the cohort's full course list.
+type CohortUpdate struct {
+	Name      *string      `json:"name,omitempty"`
+	CourseIDs *[]uuid.UUID `json:"course_ids,omitempty"`
+}
+
+func (u *CohortUpdate) Validate() error {
+	if u.Name != nil && strings.TrimSpace(*u.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+
+	if u.CourseIDs != nil {
+		return validateCourseIDs(*u.CourseIDs)
+	}
+
+	return nil
+}
+
+func validateCourseIDs(courseIDs []uuid.UUID) error {
+	seen := make(map[uuid.UUID]bool, len(courseIDs))
+	for _, id := range courseIDs {
+		if id == uuid.Nil {
+			return errors.New("course id is required")
+		}
+		if seen[id] {
+			return errors.New("course ids must be unique")
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
@@ -9,23 +9,29 @@ import (
 )
 
 type Course struct {
-	ID        uuid.UUID  `json:"id"`
-	Name      string     `json:"name"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Enrollment int32      `json:"enrollment"` // expected number of students
+	Priority   int32      `json:"priority"`   // higher is scheduled first by the priority weight strategy
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
 }
 
 func NewCourse(
 	id uuid.UUID,
 	name string,
+	enrollment int32,
+	priority int32,
 	createdAt *time.Time,
 	updatedAt *time.Time,
 ) *Course {
 	return &Course{
-		ID:        id,
-		Name:      name,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		ID:         id,
+		Name:       name,
+		Enrollment: enrollment,
+		Priority:   priority,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
 	}
 }
 
@@ -34,12 +40,22 @@ func (c *Course) Validate() error {
 		return errors.New("name is required")
 	}
 
+	if c.Enrollment < 0 {
+		return errors.New("enrollment cannot be negative")
+	}
+
+	if c.Priority < 0 {
+		return errors.New("priority cannot be negative")
+	}
+
 	return nil
 }
 
 // CourseUpdate represents partial update fields for a course.
 type CourseUpdate struct {
-	Name *string `json:"name,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	Enrollment *int32  `json:"enrollment,omitempty"`
+	Priority   *int32  `json:"priority,omitempty"`
 }
 
 func (u *CourseUpdate) Validate() error {
@@ -47,5 +63,13 @@ func (u *CourseUpdate) Validate() error {
 		return errors.New("name cannot be empty")
 	}
 
+	if u.Enrollment != nil && *u.Enrollment < 0 {
+		return errors.New("enrollment cannot be negative")
+	}
+
+	if u.Priority != nil && *u.Priority < 0 {
+		return errors.New("priority cannot be negative")
+	}
+
 	return nil
 }
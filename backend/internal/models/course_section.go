@@ -0,0 +1,84 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CourseSection is one of several parallel offerings of a course. Every section takes all of the
+// course's sessions and is scheduled on its own, e.g. "Calculus A" and "Calculus B".
+type CourseSection struct {
+	ID           uuid.UUID  `json:"id"`
+	CourseID     uuid.UUID  `json:"course_id"`
+	Name         string     `json:"name"`
+	Capacity     *int32     `json:"capacity,omitempty"`      // overrides the enrollment of every course session when set
+	InstructorID *uuid.UUID `json:"instructor_id,omitempty"` // teaches every course session in place of the assigned instructors when set
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewCourseSection(
+	id uuid.UUID,
+	courseID uuid.UUID,
+	name string,
+	capacity *int32,
+	instructorID *uuid.UUID,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *CourseSection {
+	return &CourseSection{
+		ID:           id,
+		CourseID:     courseID,
+		Name:         name,
+		Capacity:     capacity,
+		InstructorID: instructorID,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+	}
+}
+
+func (s *CourseSection) Validate() error {
+	if s.CourseID == uuid.Nil {
+		return errors.New("course id is required")
+	}
+
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if s.Capacity != nil && *s.Capacity < 0 {
+		return errors.New("capacity cannot be negative")
+	}
+
+	if s.InstructorID != nil && *s.InstructorID == uuid.Nil {
+		return errors.New("instructor id cannot be empty")
+	}
+
+	return nil
+}
+
+// CourseSectionUpdate represents partial update fields for a CourseSection.
+type CourseSectionUpdate struct {
+	Name         *string    `json:"name,omitempty"`
+	Capacity     *int32     `json:"capacity,omitempty"`
+	InstructorID *uuid.UUID `json:"instructor_id,omitempty"`
+}
+
+func (u *CourseSectionUpdate) Validate() error {
+	if u.Name != nil && strings.TrimSpace(*u.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+
+	if u.Capacity != nil && *u.Capacity < 0 {
+		return errors.New("capacity cannot be negative")
+	}
+
+	if u.InstructorID != nil && *u.InstructorID == uuid.Nil {
+		return errors.New("instructor id cannot be empty")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,15 +16,87 @@ var validSessionTypes = map[string]bool{
 	"tutorial": true,
 }
 
+// SessionWindow is a weekly time window for a course session, on one day or, without a day, on every day
+type SessionWindow struct {
+	Day       *int32 `json:"day,omitempty"`    // 0-6 (0 = Monday, 6 = Sunday); nil for every day
+	StartTime int32  `json:"start_time"`       // minutes from midnight
+	EndTime   int32  `json:"end_time"`         // minutes from midnight
+	Weight    *int32 `json:"weight,omitempty"` // preferred windows only: how strongly the window is favoured; nil for 1
+}
+
+func (w *SessionWindow) Validate() error {
+	day := int32(0)
+	if w.Day != nil {
+		day = *w.Day
+	}
+
+	return validateWeeklyWindow(day, w.StartTime, w.EndTime)
+}
+
+// PreferenceWeight returns the window's weight, 1 when unset
+func (w *SessionWindow) PreferenceWeight() int {
+	if w.Weight == nil {
+		return 1
+	}
+
+	return int(*w.Weight)
+}
+
+// Covers reports whether the window contains [startTime, endTime) on the given day
+func (w *SessionWindow) Covers(day, startTime, endTime int) bool {
+	return (w.Day == nil || int(*w.Day) == day) && int(w.StartTime) <= startTime && endTime <= int(w.EndTime)
+}
+
+// SpreadRules control how the meetings of a course are spread across the week. Unset fields
+// fall back to the scheduler config.
+type SpreadRules struct {
+	MinDaysBetween *int32    `json:"min_days_between,omitempty"` // fewest days between meetings on different days
+	MaxPerDay      *int32    `json:"max_per_day,omitempty"`      // most meetings of the course on one day
+	DayPatterns    [][]int32 `json:"day_patterns,omitempty"`     // preferred days, e.g. [[0, 2, 4], [1, 3]] for Mon/Wed/Fri or Tue/Thu
+}
+
+func (r *SpreadRules) Validate() error {
+	if r.MinDaysBetween != nil && (*r.MinDaysBetween < 0 || *r.MinDaysBetween > 6) {
+		return errors.New("min_days_between must be between 0 and 6")
+	}
+
+	if r.MaxPerDay != nil && *r.MaxPerDay <= 0 {
+		return errors.New("max_per_day must be greater than 0")
+	}
+
+	for _, pattern := range r.DayPatterns {
+		if len(pattern) == 0 {
+			return errors.New("day pattern cannot be empty")
+		}
+
+		for i, day := range pattern {
+			if day < 0 || day > 6 {
+				return errors.New("day pattern days must be between 0 (Monday) and 6 (Sunday)")
+			}
+			if slices.Contains(pattern[:i], day) {
+				return errors.New("day pattern cannot repeat a day")
+			}
+		}
+	}
+
+	return nil
+}
+
 type CourseSession struct {
-	ID               uuid.UUID  `json:"id"`
-	CourseID         uuid.UUID  `json:"course_id"`
-	RequiredRoom     string     `json:"required_room"`
-	Type             string     `json:"type"` // enum.course_session_type
-	Duration         *int32     `json:"duration"`
-	NumberOfSessions *int32     `json:"number_of_sessions"`
-	CreatedAt        *time.Time `json:"created_at,omitempty"`
-	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	ID               uuid.UUID       `json:"id"`
+	CourseID         uuid.UUID       `json:"course_id"`
+	RequiredRoom     string          `json:"required_room"`
+	Type             string          `json:"type"` // enum.course_session_type
+	Duration         *int32          `json:"duration"`
+	NumberOfSessions *int32          `json:"number_of_sessions"`
+	Enrollment       *int32          `json:"enrollment,omitempty"`        // overrides the course enrollment when set
+	AllowedWindows   []SessionWindow `json:"allowed_windows,omitempty"`   // hard: meetings only take place inside these when set
+	PreferredWindows []SessionWindow `json:"preferred_windows,omitempty"` // soft: meetings are favoured inside these
+	Spread           *SpreadRules    `json:"spread,omitempty"`            // overrides the scheduler's spreading rules when set
+	SectionID        uuid.UUID       `json:"section_id,omitzero"`         // the section a scheduler's copy of the session is for; never stored
+	CourseIDs        []uuid.UUID     `json:"course_ids,omitempty"`        // every course a scheduler's combined session group serves; never stored
+	CreatedAt        *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time      `json:"updated_at,omitempty"`
 }
 
 func NewCourseSession(
@@ -33,6 +106,10 @@ func NewCourseSession(
 	sessionType string,
 	duration *int32,
 	numberOfSessions *int32,
+	enrollment *int32,
+	allowedWindows []SessionWindow,
+	preferredWindows []SessionWindow,
+	spread *SpreadRules,
 	createdAt *time.Time,
 	updatedAt *time.Time,
 ) *CourseSession {
@@ -43,6 +120,10 @@ func NewCourseSession(
 		Type:             sessionType,
 		Duration:         duration,
 		NumberOfSessions: numberOfSessions,
+		Enrollment:       enrollment,
+		AllowedWindows:   allowedWindows,
+		PreferredWindows: preferredWindows,
+		Spread:           spread,
 		CreatedAt:        createdAt,
 		UpdatedAt:        updatedAt,
 	}
@@ -73,15 +154,63 @@ func (c *CourseSession) Validate() error {
 		return errors.New("number of sessions must be greater than 0")
 	}
 
-	return nil
+	if c.Enrollment != nil && *c.Enrollment < 0 {
+		return errors.New("enrollment cannot be negative")
+	}
+
+	if c.Spread != nil {
+		if err := c.Spread.Validate(); err != nil {
+			return fmt.Errorf("invalid spread rules: %w", err)
+		}
+	}
+
+	return validateSessionWindows(c.AllowedWindows, c.PreferredWindows)
+}
+
+// InAllowedWindows reports whether a meeting at [startTime, endTime) on the given day lies inside
+// one of the allowed windows, or whether the session has none
+func (c *CourseSession) InAllowedWindows(day, startTime, endTime int) bool {
+	return len(c.AllowedWindows) == 0 || slices.ContainsFunc(c.AllowedWindows, func(w SessionWindow) bool {
+		return w.Covers(day, startTime, endTime)
+	})
+}
+
+// Courses returns the courses the session's meetings count against: every course a combined
+// session group serves, or the session's own course
+func (c *CourseSession) Courses() []uuid.UUID {
+	if len(c.CourseIDs) > 0 {
+		return c.CourseIDs
+	}
+
+	return []uuid.UUID{c.CourseID}
+}
+
+// PreferenceMiss measures how far a meeting at [startTime, endTime) on the given day falls short of
+// the session's heaviest preferred window: the heaviest weight less that of the heaviest window
+// covering the meeting, or the heaviest weight when none does. With every weight at 1 it is 1
+// outside the preferred windows and 0 inside. Sessions without preferred windows never miss.
+func (c *CourseSession) PreferenceMiss(day, startTime, endTime int) int {
+	heaviest, covering := 0, 0
+	for _, w := range c.PreferredWindows {
+		heaviest = max(heaviest, w.PreferenceWeight())
+		if w.Covers(day, startTime, endTime) {
+			covering = max(covering, w.PreferenceWeight())
+		}
+	}
+
+	return heaviest - covering
 }
 
 // CourseSessionUpdate represents partial update fields for a CourseSession.
 type CourseSessionUpdate struct {
-	RequiredRoom     *string `json:"required_room,omitempty"`
-	Type             *string `json:"type,omitempty"`
-	Duration         *int32  `json:"duration,omitempty"`
-	NumberOfSessions *int32  `json:"number_of_sessions,omitempty"`
+	RequiredRoom     *string          `json:"required_room,omitempty"`
+	Type             *string          `json:"type,omitempty"`
+	Duration         *int32           `json:"duration,omitempty"`
+	NumberOfSessions *int32           `json:"number_of_sessions,omitempty"`
+	Enrollment       *int32           `json:"enrollment,omitempty"`
+	AllowedWindows   *[]SessionWindow `json:"allowed_windows,omitempty"`   // replaces every allowed window; empty clears them
+	PreferredWindows *[]SessionWindow `json:"preferred_windows,omitempty"` // replaces every preferred window; empty clears them
+	Spread           *SpreadRules     `json:"spread,omitempty"`            // replaces the spreading rules; {} clears them
 }
 
 func (u *CourseSessionUpdate) Validate() error {
@@ -101,5 +230,46 @@ func (u *CourseSessionUpdate) Validate() error {
 		return errors.New("number of sessions must be greater than 0")
 	}
 
+	if u.Enrollment != nil && *u.Enrollment < 0 {
+		return errors.New("enrollment cannot be negative")
+	}
+
+	if u.Spread != nil {
+		if err := u.Spread.Validate(); err != nil {
+			return fmt.Errorf("invalid spread rules: %w", err)
+		}
+	}
+
+	var allowed, preferred []SessionWindow
+	if u.AllowedWindows != nil {
+		allowed = *u.AllowedWindows
+	}
+	if u.PreferredWindows != nil {
+		preferred = *u.PreferredWindows
+	}
+
+	return validateSessionWindows(allowed, preferred)
+}
+
+// validateSessionWindows checks the allowed and preferred windows of a course session
+func validateSessionWindows(allowed, preferred []SessionWindow) error {
+	for _, w := range allowed {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("invalid allowed window: %w", err)
+		}
+		if w.Weight != nil {
+			return errors.New("invalid allowed window: weight only applies to preferred windows")
+		}
+	}
+
+	for _, w := range preferred {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("invalid preferred window: %w", err)
+		}
+		if w.Weight != nil && *w.Weight <= 0 {
+			return errors.New("invalid preferred window: weight must be greater than 0")
+		}
+	}
+
 	return nil
 }
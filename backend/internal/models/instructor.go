@@ -0,0 +1,78 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Instructor struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Email     *string    `json:"email,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewInstructor(
+	id uuid.UUID,
+	name string,
+	email *string,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *Instructor {
+	return &Instructor{
+		ID:        id,
+		Name:      name,
+		Email:     email,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (i *Instructor) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if i.Email != nil && !strings.Contains(*i.Email, "@") {
+		return errors.New("email is invalid")
+	}
+
+	return nil
+}
+
+// InstructorUpdate represents partial update fields for an Instructor.
+type InstructorUpdate struct {
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+}
+
+func (u *InstructorUpdate) Validate() error {
+	if u.Name != nil && strings.TrimSpace(*u.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+
+	if u.Email != nil && !strings.Contains(*u.Email, "@") {
+		return errors.New("email is invalid")
+	}
+
+	return nil
+}
+
+// InstructorAssignment links an instructor to a course session they teach
+type InstructorAssignment struct {
+	CourseSessionID uuid.UUID  `json:"course_session_id"`
+	InstructorID    uuid.UUID  `json:"instructor_id"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+}
+
+func NewInstructorAssignment(courseSessionID uuid.UUID, instructorID uuid.UUID, createdAt *time.Time) *InstructorAssignment {
+	return &InstructorAssignment{
+		CourseSessionID: courseSessionID,
+		InstructorID:    instructorID,
+		CreatedAt:       createdAt,
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Instructor availability kinds
+const (
+	AvailabilityUnavailable = "unavailable" // hard constraint: never schedule the instructor in this window
+	AvailabilityPreferred   = "preferred"   // soft constraint: favour this window when there is a choice
+)
+
+var validAvailabilityKinds = map[string]bool{
+	AvailabilityUnavailable: true,
+	AvailabilityPreferred:   true,
+}
+
+// InstructorAvailability is a weekly window when an instructor is unavailable or prefers to teach
+type InstructorAvailability struct {
+	ID           uuid.UUID  `json:"id"`
+	InstructorID uuid.UUID  `json:"instructor_id"`
+	Kind         string     `json:"kind"`       // enum.availability_kind
+	Day          int32      `json:"day"`        // 0-6 (0 = Monday, 6 = Sunday)
+	StartTime    int32      `json:"start_time"` // minutes from midnight
+	EndTime      int32      `json:"end_time"`   // minutes from midnight
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewInstructorAvailability(
+	id uuid.UUID,
+	instructorID uuid.UUID,
+	kind string,
+	day int32,
+	startTime int32,
+	endTime int32,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *InstructorAvailability {
+	return &InstructorAvailability{
+		ID:           id,
+		InstructorID: instructorID,
+		Kind:         kind,
+		Day:          day,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+	}
+}
+
+func (a *InstructorAvailability) Validate() error {
+	if a.InstructorID == uuid.Nil {
+		return errors.New("instructor id is required")
+	}
+
+	if !validAvailabilityKinds[a.Kind] {
+		return fmt.Errorf("invalid availability kind: %s", a.Kind)
+	}
+
+	return validateWeeklyWindow(a.Day, a.StartTime, a.EndTime)
+}
+
+// InstructorAvailabilityUpdate represents partial update fields for an InstructorAvailability.
+type InstructorAvailabilityUpdate struct {
+	Kind      *string `json:"kind,omitempty"`
+	Day       *int32  `json:"day,omitempty"`
+	StartTime *int32  `json:"start_time,omitempty"`
+	EndTime   *int32  `json:"end_time,omitempty"`
+}
+
+func (u *InstructorAvailabilityUpdate) Validate() error {
+	if u.Kind != nil && !validAvailabilityKinds[*u.Kind] {
+		return fmt.Errorf("invalid availability kind: %s", *u.Kind)
+	}
+
+	return validateWeeklyWindowUpdate(u.Day, u.StartTime, u.EndTime)
+}
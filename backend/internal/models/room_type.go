@@ -7,20 +7,25 @@ import (
 )
 
 type RoomType struct {
-	Name      string     `json:"name"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Name string `json:"name"`
+
+	// Substitutes are room types a session requiring this type may fall back to, most preferred first
+	Substitutes []string   `json:"substitutes"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
 func NewRoomType(
 	name string,
+	substitutes []string,
 	createdAt *time.Time,
 	updatedAt *time.Time,
 ) *RoomType {
 	return &RoomType{
-		Name:      name,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		Name:        name,
+		Substitutes: substitutes,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
 	}
 }
 
@@ -29,12 +34,14 @@ func (r *RoomType) Validate() error {
 		return errors.New("name is required")
 	}
 
-	return nil
+	return validateSubstitutes(r.Name, r.Substitutes)
 }
 
 // UpdateRoomType
+// When Substitutes is set it replaces the room type's full substitute list.
 type UpdateRoomType struct {
-	Name *string `json:"name,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Substitutes *[]string `json:"substitutes,omitempty"`
 }
 
 func (u *UpdateRoomType) Validate() error {
@@ -42,5 +49,31 @@ func (u *UpdateRoomType) Validate() error {
 		return errors.New("name cannot be empty")
 	}
 
+	if u.Substitutes != nil {
+		name := ""
+		if u.Name != nil {
+			name = *u.Name
+		}
+		return validateSubstitutes(name, *u.Substitutes)
+	}
+
+	return nil
+}
+
+func validateSubstitutes(name string, substitutes []string) error {
+	seen := make(map[string]bool, len(substitutes))
+	for _, substitute := range substitutes {
+		if strings.TrimSpace(substitute) == "" {
+			return errors.New("substitute is required")
+		}
+		if substitute == name {
+			return errors.New("room type cannot substitute for itself")
+		}
+		if seen[substitute] {
+			return errors.New("substitutes must be unique")
+		}
+		seen[substitute] = true
+	}
+
 	return nil
 }
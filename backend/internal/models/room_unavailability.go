@@ -0,0 +1,103 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MinutesPerDay is the exclusive upper bound for times expressed in minutes from midnight
+const MinutesPerDay = 24 * 60
+
+// RoomUnavailability is a weekly window when a room cannot be booked
+type RoomUnavailability struct {
+	ID        uuid.UUID  `json:"id"`
+	RoomID    uuid.UUID  `json:"room_id"`
+	Day       int32      `json:"day"`        // 0-6 (0 = Monday, 6 = Sunday)
+	StartTime int32      `json:"start_time"` // minutes from midnight
+	EndTime   int32      `json:"end_time"`   // minutes from midnight
+	Reason    *string    `json:"reason,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewRoomUnavailability(
+	id uuid.UUID,
+	roomID uuid.UUID,
+	day int32,
+	startTime int32,
+	endTime int32,
+	reason *string,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *RoomUnavailability {
+	return &RoomUnavailability{
+		ID:        id,
+		RoomID:    roomID,
+		Day:       day,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Reason:    reason,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (u *RoomUnavailability) Validate() error {
+	if u.RoomID == uuid.Nil {
+		return errors.New("room id is required")
+	}
+
+	return validateWeeklyWindow(u.Day, u.StartTime, u.EndTime)
+}
+
+// RoomUnavailabilityUpdate represents partial update fields for a RoomUnavailability.
+type RoomUnavailabilityUpdate struct {
+	Day       *int32  `json:"day,omitempty"`
+	StartTime *int32  `json:"start_time,omitempty"`
+	EndTime   *int32  `json:"end_time,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
+func (u *RoomUnavailabilityUpdate) Validate() error {
+	return validateWeeklyWindowUpdate(u.Day, u.StartTime, u.EndTime)
+}
+
+// validateWeeklyWindow checks a day of the week and a time range in minutes from midnight
+func validateWeeklyWindow(day, startTime, endTime int32) error {
+	if day < 0 || day > 6 {
+		return errors.New("day must be between 0 and 6")
+	}
+
+	if startTime < 0 || endTime > MinutesPerDay {
+		return errors.New("time range must be within the day")
+	}
+
+	if startTime >= endTime {
+		return errors.New("start time must be before end time")
+	}
+
+	return nil
+}
+
+// validateWeeklyWindowUpdate checks the fields of a partial weekly window update that are set
+func validateWeeklyWindowUpdate(day, startTime, endTime *int32) error {
+	if day != nil && (*day < 0 || *day > 6) {
+		return errors.New("day must be between 0 and 6")
+	}
+
+	if startTime != nil && (*startTime < 0 || *startTime >= MinutesPerDay) {
+		return errors.New("start time must be within the day")
+	}
+
+	if endTime != nil && (*endTime <= 0 || *endTime > MinutesPerDay) {
+		return errors.New("end time must be within the day")
+	}
+
+	if startTime != nil && endTime != nil && *startTime >= *endTime {
+		return errors.New("start time must be before end time")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,11 +11,19 @@ import (
 
 // ScheduledSession represents a single scheduled session within a schedule
 type ScheduledSession struct {
-	CourseID  uuid.UUID `json:"course_id"`
-	RoomID    uuid.UUID `json:"room_id"`
-	Day       int       `json:"day"`        // 0-6 (0 = Monday, 6 = Sunday)
-	StartTime int       `json:"start_time"` // minutes from midnight
-	EndTime   int       `json:"end_time"`   // minutes from midnight
+	CourseID        uuid.UUID `json:"course_id"`
+	CourseSessionID uuid.UUID `json:"course_session_id"`   // the course session this meeting belongs to
+	SectionID       uuid.UUID `json:"section_id,omitzero"` // the course section this meeting belongs to, if the course has sections
+	RoomID          uuid.UUID `json:"room_id"`
+	Day             int       `json:"day"`                   // 0-6 (0 = Monday, 6 = Sunday)
+	StartTime       int       `json:"start_time"`            // minutes from midnight
+	EndTime         int       `json:"end_time"`              // minutes from midnight
+	Substituted     bool      `json:"substituted,omitempty"` // the room is a substitute for the session's required room type
+
+	// GroupID is the session group this meeting is held for, if its course session is combined with
+	// others; CourseIDs then lists every course the meeting serves
+	GroupID   uuid.UUID   `json:"group_id,omitzero"`
+	CourseIDs []uuid.UUID `json:"course_ids,omitempty"`
 }
 
 // Schedule represents a complete schedule with all sessions
@@ -101,3 +110,17 @@ func (ss *ScheduledSession) Validate() error {
 
 	return nil
 }
+
+// Equal reports whether two scheduled sessions describe the same meeting in the same place
+func (ss ScheduledSession) Equal(other ScheduledSession) bool {
+	return ss.CourseID == other.CourseID &&
+		ss.CourseSessionID == other.CourseSessionID &&
+		ss.SectionID == other.SectionID &&
+		ss.RoomID == other.RoomID &&
+		ss.Day == other.Day &&
+		ss.StartTime == other.StartTime &&
+		ss.EndTime == other.EndTime &&
+		ss.Substituted == other.Substituted &&
+		ss.GroupID == other.GroupID &&
+		slices.Equal(ss.CourseIDs, other.CourseIDs)
+}
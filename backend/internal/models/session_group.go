@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionGroup combines course sessions of cross-listed courses, such as an undergraduate and a
+// graduate course taught together, into one meeting held once in one room. The first course
+// session leads the group; the others must match its duration, meetings and required room type.
+type SessionGroup struct {
+	ID               uuid.UUID   `json:"id"`
+	Name             string      `json:"name"`
+	CourseSessionIDs []uuid.UUID `json:"course_session_ids"`
+	CreatedAt        *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time  `json:"updated_at,omitempty"`
+}
+
+func NewSessionGroup(
+	id uuid.UUID,
+	name string,
+	courseSessionIDs []uuid.UUID,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *SessionGroup {
+	return &SessionGroup{
+		ID:               id,
+		Name:             name,
+		CourseSessionIDs: courseSessionIDs,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+	}
+}
+
+func (g *SessionGroup) Validate() error {
+	if strings.TrimSpace(g.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	return validateGroupMembers(g.CourseSessionIDs)
+}
+
+// SessionGroupUpdate represents partial update fields for a SessionGroup.
+// When CourseSessionIDs is set it replaces the group's full member list.
+type SessionGroupUpdate struct {
+	Name             *string      `json:"name,omitempty"`
+	CourseSessionIDs *[]uuid.UUID `json:"course_session_ids,omitempty"`
+}
+
+func (u *SessionGroupUpdate) Validate() error {
+	if u.Name != nil && strings.TrimSpace(*u.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+
+	if u.CourseSessionIDs != nil {
+		return validateGroupMembers(*u.CourseSessionIDs)
+	}
+
+	return nil
+}
+
+func validateGroupMembers(courseSessionIDs []uuid.UUID) error {
+	if len(courseSessionIDs) < 2 {
+		return errors.New("a session group combines at least two course sessions")
+	}
+
+	seen := make(map[uuid.UUID]bool, len(courseSessionIDs))
+	for _, id := range courseSessionIDs {
+		if id == uuid.Nil {
+			return errors.New("course session id is required")
+		}
+		if seen[id] {
+			return errors.New("course session ids must be unique")
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
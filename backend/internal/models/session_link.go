@@ -0,0 +1,93 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session link kinds, each placing the other session relative to the anchor session
+const (
+	SessionLinkBefore       = "before"        // the anchor's first meeting of the week ends before any meeting of the other starts
+	SessionLinkSameDay      = "same_day"      // every meeting of the other is on a day the anchor meets
+	SessionLinkDifferentDay = "different_day" // no meeting of the other is on a day the anchor meets
+	SessionLinkConsecutive  = "consecutive"   // every meeting of the other starts as a meeting of the anchor ends, after the minimum break
+	SessionLinkSameRoom     = "same_room"     // every meeting of the other is in a room the anchor uses
+)
+
+var validSessionLinkKinds = map[string]bool{
+	SessionLinkBefore:       true,
+	SessionLinkSameDay:      true,
+	SessionLinkDifferentDay: true,
+	SessionLinkConsecutive:  true,
+	SessionLinkSameRoom:     true,
+}
+
+// SessionLink is a rule placing one course session relative to another,
+// e.g. "the lab must come after the week's first lecture"
+type SessionLink struct {
+	ID              uuid.UUID  `json:"id"`
+	CourseSessionID uuid.UUID  `json:"course_session_id"` // the anchor session
+	OtherSessionID  uuid.UUID  `json:"other_session_id"`  // the session placed relative to the anchor
+	Kind            string     `json:"kind"`              // enum.session_link_kind
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewSessionLink(
+	id uuid.UUID,
+	courseSessionID uuid.UUID,
+	otherSessionID uuid.UUID,
+	kind string,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *SessionLink {
+	return &SessionLink{
+		ID:              id,
+		CourseSessionID: courseSessionID,
+		OtherSessionID:  otherSessionID,
+		Kind:            kind,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	}
+}
+
+func (l *SessionLink) Validate() error {
+	if l.CourseSessionID == uuid.Nil {
+		return errors.New("course session id is required")
+	}
+
+	if l.OtherSessionID == uuid.Nil {
+		return errors.New("other session id is required")
+	}
+
+	if l.CourseSessionID == l.OtherSessionID {
+		return errors.New("a session cannot be linked to itself")
+	}
+
+	if !validSessionLinkKinds[l.Kind] {
+		return fmt.Errorf("invalid session link kind: %s", l.Kind)
+	}
+
+	return nil
+}
+
+// SessionLinkUpdate represents partial update fields for a SessionLink.
+type SessionLinkUpdate struct {
+	OtherSessionID *uuid.UUID `json:"other_session_id,omitempty"`
+	Kind           *string    `json:"kind,omitempty"`
+}
+
+func (u *SessionLinkUpdate) Validate() error {
+	if u.OtherSessionID != nil && *u.OtherSessionID == uuid.Nil {
+		return errors.New("other session id cannot be empty")
+	}
+
+	if u.Kind != nil && !validSessionLinkKinds[*u.Kind] {
+		return fmt.Errorf("invalid session link kind: %s", *u.Kind)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionPin fixes one meeting of a course session to a room, day and start time.
+// The meeting lasts the course session's duration.
+type SessionPin struct {
+	ID              uuid.UUID  `json:"id"`
+	CourseSessionID uuid.UUID  `json:"course_session_id"`
+	RoomID          uuid.UUID  `json:"room_id"`
+	Day             int32      `json:"day"`        // 0-6 (0 = Monday, 6 = Sunday)
+	StartTime       int32      `json:"start_time"` // minutes from midnight
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewSessionPin(
+	id uuid.UUID,
+	courseSessionID uuid.UUID,
+	roomID uuid.UUID,
+	day int32,
+	startTime int32,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *SessionPin {
+	return &SessionPin{
+		ID:              id,
+		CourseSessionID: courseSessionID,
+		RoomID:          roomID,
+		Day:             day,
+		StartTime:       startTime,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	}
+}
+
+func (p *SessionPin) Validate() error {
+	if p.CourseSessionID == uuid.Nil {
+		return errors.New("course session id is required")
+	}
+
+	if p.RoomID == uuid.Nil {
+		return errors.New("room id is required")
+	}
+
+	if p.Day < 0 || p.Day > 6 {
+		return errors.New("day must be between 0 and 6")
+	}
+
+	if p.StartTime < 0 || p.StartTime >= MinutesPerDay {
+		return errors.New("start time must be within the day")
+	}
+
+	return nil
+}
+
+// SessionPinUpdate represents partial update fields for a SessionPin.
+type SessionPinUpdate struct {
+	RoomID    *uuid.UUID `json:"room_id,omitempty"`
+	Day       *int32     `json:"day,omitempty"`
+	StartTime *int32     `json:"start_time,omitempty"`
+}
+
+func (u *SessionPinUpdate) Validate() error {
+	if u.RoomID != nil && *u.RoomID == uuid.Nil {
+		return errors.New("room id cannot be empty")
+	}
+
+	if u.Day != nil && (*u.Day < 0 || *u.Day > 6) {
+		return errors.New("day must be between 0 and 6")
+	}
+
+	if u.StartTime != nil && (*u.StartTime < 0 || *u.StartTime >= MinutesPerDay) {
+		return errors.New("start time must be within the day")
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ BuildingTravelTimeRepositoryInterface = (*BuildingTravelTimeRepository)(nil)
+
+type BuildingTravelTimeRepositoryInterface interface {
+	Set(ctx context.Context, travelTime *models.BuildingTravelTime) (*models.BuildingTravelTime, error)
+	GetByID(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) (*models.BuildingTravelTime, error)
+	GetByBuildingID(ctx context.Context, fromBuildingID uuid.UUID) ([]*models.BuildingTravelTime, error)
+	List(ctx context.Context) ([]*models.BuildingTravelTime, error)
+	Delete(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error
+}
+
+type BuildingTravelTimeRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewBuildingTravelTimeRepository(db *sql.DB, logger *zap.Logger) *BuildingTravelTimeRepository {
+	return &BuildingTravelTimeRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Set records the travel time between two buildings, replacing any existing value
+func (r *BuildingTravelTimeRepository) Set(ctx context.Context, travelTime *models.BuildingTravelTime) (*models.BuildingTravelTime, error) {
+	if travelTime == nil {
+		return nil, errors.New("building travel time cannot be nil")
+	}
+
+	if err := travelTime.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	upsertStmt := table.BuildingTravelTimes.
+		INSERT(table.BuildingTravelTimes.AllColumns.Except(table.BuildingTravelTimes.CreatedAt, table.BuildingTravelTimes.UpdatedAt)).
+		MODEL(travelTime).
+		ON_CONFLICT(table.BuildingTravelTimes.FromBuildingID, table.BuildingTravelTimes.ToBuildingID).
+		DO_UPDATE(SET(table.BuildingTravelTimes.Minutes.SET(table.BuildingTravelTimes.EXCLUDED.Minutes))).
+		RETURNING(table.BuildingTravelTimes.AllColumns)
+
+	var dest model.BuildingTravelTimes
+	if err := upsertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to set building travel time", zap.Error(err))
+		return nil, fmt.Errorf("failed to set building travel time: %w", err)
+	}
+
+	return toBuildingTravelTime(dest), nil
+}
+
+func (r *BuildingTravelTimeRepository) GetByID(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) (*models.BuildingTravelTime, error) {
+	stmt := table.BuildingTravelTimes.
+		SELECT(table.BuildingTravelTimes.AllColumns).
+		WHERE(
+			table.BuildingTravelTimes.FromBuildingID.EQ(UUID(fromBuildingID)).
+				AND(table.BuildingTravelTimes.ToBuildingID.EQ(UUID(toBuildingID))),
+		)
+
+	var dest model.BuildingTravelTimes
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get building travel time", zap.Error(err), zap.String("from_building_id", fromBuildingID.String()), zap.String("to_building_id", toBuildingID.String()))
+		return nil, fmt.Errorf("failed to get building travel time: %w", err)
+	}
+
+	return toBuildingTravelTime(dest), nil
+}
+
+func (r *BuildingTravelTimeRepository) GetByBuildingID(ctx context.Context, fromBuildingID uuid.UUID) ([]*models.BuildingTravelTime, error) {
+	stmt := table.BuildingTravelTimes.
+		SELECT(table.BuildingTravelTimes.AllColumns).
+		WHERE(table.BuildingTravelTimes.FromBuildingID.EQ(UUID(fromBuildingID))).
+		ORDER_BY(table.BuildingTravelTimes.Minutes.ASC())
+
+	var dest []model.BuildingTravelTimes
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get building travel times by building id", zap.Error(err), zap.String("from_building_id", fromBuildingID.String()))
+		return nil, fmt.Errorf("failed to get building travel times: %w", err)
+	}
+
+	result := make([]*models.BuildingTravelTime, len(dest))
+	for i, d := range dest {
+		result[i] = toBuildingTravelTime(d)
+	}
+
+	return result, nil
+}
+
+func (r *BuildingTravelTimeRepository) List(ctx context.Context) ([]*models.BuildingTravelTime, error) {
+	stmt := table.BuildingTravelTimes.
+		SELECT(table.BuildingTravelTimes.AllColumns).
+		ORDER_BY(table.BuildingTravelTimes.FromBuildingID.ASC(), table.BuildingTravelTimes.ToBuildingID.ASC())
+
+	var dest []model.BuildingTravelTimes
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list building travel times", zap.Error(err))
+		return nil, fmt.Errorf("failed to list building travel times: %w", err)
+	}
+
+	result := make([]*models.BuildingTravelTime, len(dest))
+	for i, d := range dest {
+		result[i] = toBuildingTravelTime(d)
+	}
+
+	return result, nil
+}
+
+func (r *BuildingTravelTimeRepository) Delete(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error {
+	deleteStmt := table.BuildingTravelTimes.
+		DELETE().
+		WHERE(
+			table.BuildingTravelTimes.FromBuildingID.EQ(UUID(fromBuildingID)).
+				AND(table.BuildingTravelTimes.ToBuildingID.EQ(UUID(toBuildingID))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete building travel time", zap.Error(err))
+		return fmt.Errorf("failed to delete building travel time: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete building travel time: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func toBuildingTravelTime(d model.BuildingTravelTimes) *models.BuildingTravelTime {
+	return models.NewBuildingTravelTime(d.FromBuildingID, d.ToBuildingID, d.Minutes, d.CreatedAt, d.UpdatedAt)
+}
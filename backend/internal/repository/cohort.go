@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ CohortRepositoryInterface = (*CohortRepository)(nil)
+
+type CohortRepositoryInterface interface {
+	Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error)
+	CreateBatch(ctx context.Context, cohorts []*models.Cohort) ([]*models.Cohort, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error)
+	List(ctx context.Context) ([]*models.Cohort, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error)
+}
+
+type CohortRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewCohortRepository(db *sql.DB, logger *zap.Logger) *CohortRepository {
+	return &CohortRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *CohortRepository) Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error) {
+	if cohort == nil {
+		return nil, errors.New("cohort cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	created, err := r.create(ctx, tx, cohort)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *CohortRepository) CreateBatch(ctx context.Context, cohorts []*models.Cohort) ([]*models.Cohort, error) {
+	if len(cohorts) < 1 {
+		return nil, errors.New("at least one cohort is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var newCohorts []*models.Cohort
+	for _, cohort := range cohorts {
+		if cohort == nil {
+			return nil, errors.New("cohort cannot be nil")
+		}
+
+		created, err := r.create(ctx, tx, cohort)
+		if err != nil {
+			return nil, err
+		}
+
+		newCohorts = append(newCohorts, created)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return newCohorts, nil
+}
+
+func (r *CohortRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error) {
+	stmt := table.Cohorts.
+		SELECT(table.Cohorts.AllColumns).
+		WHERE(table.Cohorts.ID.EQ(UUID(id)))
+
+	var dest model.Cohorts
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get cohort", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get cohort: %w", err)
+	}
+
+	courseIDs, err := r.courseIDs(ctx, r.db, table.CohortCourses.CohortID.EQ(UUID(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewCohort(dest.ID, dest.Name, courseIDs[dest.ID], dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+func (r *CohortRepository) List(ctx context.Context) ([]*models.Cohort, error) {
+	stmt := table.Cohorts.
+		SELECT(table.Cohorts.AllColumns).
+		ORDER_BY(table.Cohorts.Name.ASC())
+
+	var dest []model.Cohorts
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list cohorts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list cohorts: %w", err)
+	}
+
+	courseIDs, err := r.courseIDs(ctx, r.db, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cohorts := make([]*models.Cohort, len(dest))
+	for i, d := range dest {
+		cohorts[i] = models.NewCohort(d.ID, d.Name, courseIDs[d.ID], d.CreatedAt, d.UpdatedAt)
+	}
+
+	return cohorts, nil
+}
+
+func (r *CohortRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.Cohorts.
+		DELETE().
+		WHERE(table.Cohorts.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete cohort", zap.Error(err))
+		return fmt.Errorf("failed to delete cohort: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete cohort: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *CohortRepository) Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if updates.Name == nil && updates.CourseIDs == nil {
+		return nil, errors.New("no fields to update")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var stmt Statement
+	if updates.Name != nil {
+		stmt = table.Cohorts.
+			UPDATE(table.Cohorts.Name).
+			MODEL(updates).
+			WHERE(table.Cohorts.ID.EQ(UUID(id))).
+			RETURNING(table.Cohorts.AllColumns)
+	} else {
+		// Only the course list changes, but the cohort must still exist
+		stmt = table.Cohorts.
+			SELECT(table.Cohorts.AllColumns).
+			WHERE(table.Cohorts.ID.EQ(UUID(id))).
+			FOR(UPDATE())
+	}
+
+	var dest model.Cohorts
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update cohort", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update cohort: %w", err)
+	}
+
+	if updates.CourseIDs != nil {
+		if err := r.replaceCourses(ctx, tx, id, *updates.CourseIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	courseIDs, err := r.courseIDs(ctx, tx, table.CohortCourses.CohortID.EQ(UUID(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return models.NewCohort(dest.ID, dest.Name, courseIDs[dest.ID], dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+// create inserts a cohort and its course links within the given transaction
+func (r *CohortRepository) create(ctx context.Context, tx *sql.Tx, cohort *models.Cohort) (*models.Cohort, error) {
+	if err := cohort.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.Cohorts.
+		INSERT(table.Cohorts.ID, table.Cohorts.Name).
+		MODEL(cohort).
+		RETURNING(table.Cohorts.AllColumns)
+
+	var dest model.Cohorts
+	if err := insertStmt.QueryContext(ctx, tx, &dest); err != nil {
+		r.logger.Error("failed to create cohort", zap.Error(err))
+		return nil, fmt.Errorf("failed to create cohort: %w", err)
+	}
+
+	if err := r.replaceCourses(ctx, tx, dest.ID, cohort.CourseIDs); err != nil {
+		return nil, err
+	}
+
+	return models.NewCohort(dest.ID, dest.Name, cohort.CourseIDs, dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+// replaceCourses swaps the cohort's course links for the given list
+func (r *CohortRepository) replaceCourses(ctx context.Context, tx *sql.Tx, cohortID uuid.UUID, courseIDs []uuid.UUID) error {
+	deleteStmt := table.CohortCourses.
+		DELETE().
+		WHERE(table.CohortCourses.CohortID.EQ(UUID(cohortID)))
+
+	if _, err := deleteStmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to clear cohort courses", zap.Error(err), zap.String("cohort_id", cohortID.String()))
+		return fmt.Errorf("failed to update cohort courses: %w", err)
+	}
+
+	if len(courseIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]model.CohortCourses, len(courseIDs))
+	for i, courseID := range courseIDs {
+		rows[i] = model.CohortCourses{CohortID: cohortID, CourseID: courseID}
+	}
+
+	insertStmt := table.CohortCourses.
+		INSERT(table.CohortCourses.CohortID, table.CohortCourses.CourseID).
+		MODELS(rows)
+
+	if _, err := insertStmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to link cohort courses", zap.Error(err), zap.String("cohort_id", cohortID.String()))
+		return fmt.Errorf("failed to update cohort courses: %w", err)
+	}
+
+	return nil
+}
+
+// courseIDs loads course links grouped by cohort, optionally filtered by condition
+func (r *CohortRepository) courseIDs(ctx context.Context, db qrm.Queryable, condition BoolExpression) (map[uuid.UUID][]uuid.UUID, error) {
+	stmt := table.CohortCourses.
+		SELECT(table.CohortCourses.AllColumns).
+		ORDER_BY(table.CohortCourses.CohortID.ASC(), table.CohortCourses.CourseID.ASC())
+
+	if condition != nil {
+		stmt = stmt.WHERE(condition)
+	}
+
+	var dest []model.CohortCourses
+	if err := stmt.QueryContext(ctx, db, &dest); err != nil {
+		r.logger.Error("failed to list cohort courses", zap.Error(err))
+		return nil, fmt.Errorf("failed to list cohort courses: %w", err)
+	}
+
+	result := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range dest {
+		result[d.CohortID] = append(result[d.CohortID], d.CourseID)
+	}
+
+	return result, nil
+}
@@ -60,7 +60,7 @@ func (c *CourseRepository) Create(ctx context.Context, course *models.Course) (*
 		return nil, fmt.Errorf("failed to create course: %w", err)
 	}
 
-	return models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	return models.NewCourse(dest.ID, dest.Name, dest.Enrollment, dest.Priority, dest.CreatedAt, dest.UpdatedAt), nil
 }
 
 func (c *CourseRepository) Delete(ctx context.Context, id uuid.UUID) error {
@@ -121,7 +121,7 @@ func (c *CourseRepository) CreateBatch(ctx context.Context, courses []*models.Co
 			return nil, fmt.Errorf("failed to create batch courses: %w", err)
 		}
 
-		newCourses = append(newCourses, models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt))
+		newCourses = append(newCourses, models.NewCourse(dest.ID, dest.Name, dest.Enrollment, dest.Priority, dest.CreatedAt, dest.UpdatedAt))
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -148,7 +148,7 @@ func (c *CourseRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.C
 		return nil, fmt.Errorf("failed to get course by id: %w", err)
 	}
 
-	return models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	return models.NewCourse(dest.ID, dest.Name, dest.Enrollment, dest.Priority, dest.CreatedAt, dest.UpdatedAt), nil
 }
 
 func (c *CourseRepository) List(ctx context.Context) ([]models.Course, error) {
@@ -167,10 +167,12 @@ func (c *CourseRepository) List(ctx context.Context) ([]models.Course, error) {
 	courses := make([]models.Course, len(dest))
 	for i, d := range dest {
 		courses[i] = models.Course{
-			ID:        d.ID,
-			Name:      d.Name,
-			CreatedAt: d.CreatedAt,
-			UpdatedAt: d.UpdatedAt,
+			ID:         d.ID,
+			Name:       d.Name,
+			Enrollment: d.Enrollment,
+			Priority:   d.Priority,
+			CreatedAt:  d.CreatedAt,
+			UpdatedAt:  d.UpdatedAt,
 		}
 	}
 
@@ -190,6 +192,12 @@ func (c *CourseRepository) Update(ctx context.Context, id uuid.UUID, updates *mo
 	if updates.Name != nil {
 		columns = append(columns, table.Courses.Name)
 	}
+	if updates.Enrollment != nil {
+		columns = append(columns, table.Courses.Enrollment)
+	}
+	if updates.Priority != nil {
+		columns = append(columns, table.Courses.Priority)
+	}
 
 	if len(columns) == 0 {
 		return nil, errors.New("no fields to update")
@@ -211,6 +219,6 @@ func (c *CourseRepository) Update(ctx context.Context, id uuid.UUID, updates *mo
 		return nil, fmt.Errorf("failed to update courses: %w", err)
 	}
 
-	return models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	return models.NewCourse(dest.ID, dest.Name, dest.Enrollment, dest.Priority, dest.CreatedAt, dest.UpdatedAt), nil
 
 }
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ CourseSectionRepositoryInterface = (*CourseSectionRepository)(nil)
+
+type CourseSectionRepositoryInterface interface {
+	Create(ctx context.Context, section *models.CourseSection) (*models.CourseSection, error)
+	GetByID(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (*models.CourseSection, error)
+	GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSection, error)
+	List(ctx context.Context) ([]*models.CourseSection, error)
+	Delete(ctx context.Context, courseID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates *models.CourseSectionUpdate) (*models.CourseSection, error)
+}
+
+type CourseSectionRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewCourseSectionRepository(db *sql.DB, logger *zap.Logger) *CourseSectionRepository {
+	return &CourseSectionRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *CourseSectionRepository) Create(ctx context.Context, section *models.CourseSection) (*models.CourseSection, error) {
+	if section == nil {
+		return nil, errors.New("course section cannot be nil")
+	}
+
+	if err := section.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.CourseSections.
+		INSERT(table.CourseSections.AllColumns.Except(table.CourseSections.CreatedAt, table.CourseSections.UpdatedAt)).
+		MODEL(section).
+		RETURNING(table.CourseSections.AllColumns)
+
+	var dest model.CourseSections
+	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to create course section", zap.Error(err))
+		return nil, fmt.Errorf("failed to create course section: %w", err)
+	}
+
+	return toCourseSection(dest), nil
+}
+
+func (r *CourseSectionRepository) GetByID(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (*models.CourseSection, error) {
+	stmt := table.CourseSections.
+		SELECT(table.CourseSections.AllColumns).
+		WHERE(
+			table.CourseSections.ID.EQ(UUID(id)).
+				AND(table.CourseSections.CourseID.EQ(UUID(courseID))),
+		)
+
+	var dest model.CourseSections
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get course section", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get course sections: %w", err)
+	}
+
+	return toCourseSection(dest), nil
+}
+
+func (r *CourseSectionRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSection, error) {
+	stmt := table.CourseSections.
+		SELECT(table.CourseSections.AllColumns).
+		WHERE(table.CourseSections.CourseID.EQ(UUID(courseID))).
+		ORDER_BY(table.CourseSections.Name.ASC())
+
+	var dest []model.CourseSections
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get course sections by course id", zap.Error(err), zap.String("course_id", courseID.String()))
+		return nil, fmt.Errorf("failed to get course sections: %w", err)
+	}
+
+	result := make([]*models.CourseSection, len(dest))
+	for i, d := range dest {
+		result[i] = toCourseSection(d)
+	}
+
+	return result, nil
+}
+
+func (r *CourseSectionRepository) List(ctx context.Context) ([]*models.CourseSection, error) {
+	stmt := table.CourseSections.
+		SELECT(table.CourseSections.AllColumns).
+		ORDER_BY(
+			table.CourseSections.CourseID.ASC(),
+			table.CourseSections.Name.ASC(),
+		)
+
+	var dest []model.CourseSections
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list course sections", zap.Error(err))
+		return nil, fmt.Errorf("failed to list course sections: %w", err)
+	}
+
+	result := make([]*models.CourseSection, len(dest))
+	for i, d := range dest {
+		result[i] = toCourseSection(d)
+	}
+
+	return result, nil
+}
+
+func (r *CourseSectionRepository) Delete(ctx context.Context, courseID uuid.UUID, id uuid.UUID) error {
+	deleteStmt := table.CourseSections.
+		DELETE().
+		WHERE(
+			table.CourseSections.ID.EQ(UUID(id)).
+				AND(table.CourseSections.CourseID.EQ(UUID(courseID))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete course section", zap.Error(err))
+		return fmt.Errorf("failed to delete course section: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete course section: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *CourseSectionRepository) Update(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates *models.CourseSectionUpdate) (*models.CourseSection, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.Name != nil {
+		columns = append(columns, table.CourseSections.Name)
+	}
+	if updates.Capacity != nil {
+		columns = append(columns, table.CourseSections.Capacity)
+	}
+	if updates.InstructorID != nil {
+		columns = append(columns, table.CourseSections.InstructorID)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	updateStmt := table.CourseSections.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(
+			table.CourseSections.ID.EQ(UUID(id)).
+				AND(table.CourseSections.CourseID.EQ(UUID(courseID))),
+		).
+		RETURNING(table.CourseSections.AllColumns)
+
+	var dest model.CourseSections
+	err := updateStmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update course section", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update course section: %w", err)
+	}
+
+	return toCourseSection(dest), nil
+}
+
+func toCourseSection(d model.CourseSections) *models.CourseSection {
+	return models.NewCourseSection(d.ID, d.CourseID, d.Name, d.Capacity, d.InstructorID, d.CreatedAt, d.UpdatedAt)
+}
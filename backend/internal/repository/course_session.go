@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -39,6 +40,20 @@ func NewCourseSessionRepository(db *sql.DB, logger *zap.Logger) *CourseSessionRe
 	}
 }
 
+// courseSessionDBModel is used for inserting with JSONB time windows and spreading rules
+type courseSessionDBModel struct {
+	ID               uuid.UUID `sql:"primary_key"`
+	CourseID         uuid.UUID
+	RequiredRoom     string
+	Type             string
+	Duration         *int32
+	NumberOfSessions *int32
+	Enrollment       *int32
+	AllowedWindows   string  // JSONB as string
+	PreferredWindows string  // JSONB as string
+	SpreadRules      *string // JSONB as string; NULL when the session has no rules of its own
+}
+
 func (r *CourseSessionRepository) Create(ctx context.Context, session *models.CourseSession) (*models.CourseSession, error) {
 	if session == nil {
 		return nil, errors.New("session cannot be nil")
@@ -49,9 +64,14 @@ func (r *CourseSessionRepository) Create(ctx context.Context, session *models.Co
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	dbModel, err := r.toDBModel(session)
+	if err != nil {
+		return nil, err
+	}
+
 	insertStmt := table.CourseSessions.
 		INSERT(table.CourseSessions.AllColumns.Except(table.CourseSessions.CreatedAt, table.CourseSessions.UpdatedAt)).
-		MODEL(session).
+		MODEL(dbModel).
 		RETURNING(table.CourseSessions.AllColumns)
 
 	var dest model.CourseSessions
@@ -60,16 +80,7 @@ func (r *CourseSessionRepository) Create(ctx context.Context, session *models.Co
 		return nil, fmt.Errorf("failed to create course session: %w", err)
 	}
 
-	return models.NewCourseSession(
-		dest.ID,
-		dest.CourseID,
-		dest.RequiredRoom,
-		string(dest.Type),
-		dest.Duration,
-		dest.NumberOfSessions,
-		dest.CreatedAt,
-		dest.UpdatedAt,
-	), nil
+	return r.destToCourseSession(&dest)
 }
 
 func (r *CourseSessionRepository) CreateBatch(ctx context.Context, sessions []*models.CourseSession) ([]*models.CourseSession, error) {
@@ -95,9 +106,14 @@ func (r *CourseSessionRepository) CreateBatch(ctx context.Context, sessions []*m
 			return nil, fmt.Errorf("validation failed: %w", err)
 		}
 
+		dbModel, err := r.toDBModel(session)
+		if err != nil {
+			return nil, err
+		}
+
 		insertStmt := table.CourseSessions.
 			INSERT(table.CourseSessions.AllColumns.Except(table.CourseSessions.CreatedAt, table.CourseSessions.UpdatedAt)).
-			MODEL(session).
+			MODEL(dbModel).
 			RETURNING(table.CourseSessions.AllColumns)
 
 		var dest model.CourseSessions
@@ -106,16 +122,11 @@ func (r *CourseSessionRepository) CreateBatch(ctx context.Context, sessions []*m
 			return nil, fmt.Errorf("failed to create course session: %w", err)
 		}
 
-		newSessions = append(newSessions, models.NewCourseSession(
-			dest.ID,
-			dest.CourseID,
-			dest.RequiredRoom,
-			string(dest.Type),
-			dest.Duration,
-			dest.NumberOfSessions,
-			dest.CreatedAt,
-			dest.UpdatedAt,
-		))
+		newSession, err := r.destToCourseSession(&dest)
+		if err != nil {
+			return nil, err
+		}
+		newSessions = append(newSessions, newSession)
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -142,16 +153,7 @@ func (r *CourseSessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*m
 		return nil, fmt.Errorf("failed to get course session: %w", err)
 	}
 
-	return models.NewCourseSession(
-		dest.ID,
-		dest.CourseID,
-		dest.RequiredRoom,
-		string(dest.Type),
-		dest.Duration,
-		dest.NumberOfSessions,
-		dest.CreatedAt,
-		dest.UpdatedAt,
-	), nil
+	return r.destToCourseSession(&dest)
 }
 
 func (r *CourseSessionRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSession, error) {
@@ -169,17 +171,12 @@ func (r *CourseSessionRepository) GetByCourseID(ctx context.Context, courseID uu
 	}
 
 	sessions := make([]*models.CourseSession, len(dest))
-	for i, d := range dest {
-		sessions[i] = models.NewCourseSession(
-			d.ID,
-			d.CourseID,
-			d.RequiredRoom,
-			string(d.Type),
-			d.Duration,
-			d.NumberOfSessions,
-			d.CreatedAt,
-			d.UpdatedAt,
-		)
+	for i := range dest {
+		session, err := r.destToCourseSession(&dest[i])
+		if err != nil {
+			return nil, err
+		}
+		sessions[i] = session
 	}
 
 	return sessions, nil
@@ -199,17 +196,12 @@ func (r *CourseSessionRepository) List(ctx context.Context) ([]*models.CourseSes
 	}
 
 	sessions := make([]*models.CourseSession, len(dest))
-	for i, d := range dest {
-		sessions[i] = models.NewCourseSession(
-			d.ID,
-			d.CourseID,
-			d.RequiredRoom,
-			string(d.Type),
-			d.Duration,
-			d.NumberOfSessions,
-			d.CreatedAt,
-			d.UpdatedAt,
-		)
+	for i := range dest {
+		session, err := r.destToCourseSession(&dest[i])
+		if err != nil {
+			return nil, err
+		}
+		sessions[i] = session
 	}
 
 	return sessions, nil
@@ -249,6 +241,23 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 	}
 
 	var columns ColumnList
+	updateModel := struct {
+		RequiredRoom     *string
+		Type             *string
+		Duration         *int32
+		NumberOfSessions *int32
+		Enrollment       *int32
+		AllowedWindows   *string // JSONB as string
+		PreferredWindows *string // JSONB as string
+		SpreadRules      *string // JSONB as string
+	}{
+		RequiredRoom:     updates.RequiredRoom,
+		Type:             updates.Type,
+		Duration:         updates.Duration,
+		NumberOfSessions: updates.NumberOfSessions,
+		Enrollment:       updates.Enrollment,
+	}
+
 	if updates.RequiredRoom != nil {
 		columns = append(columns, table.CourseSessions.RequiredRoom)
 	}
@@ -261,6 +270,33 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 	if updates.NumberOfSessions != nil {
 		columns = append(columns, table.CourseSessions.NumberOfSessions)
 	}
+	if updates.Enrollment != nil {
+		columns = append(columns, table.CourseSessions.Enrollment)
+	}
+	if updates.AllowedWindows != nil {
+		columns = append(columns, table.CourseSessions.AllowedWindows)
+		windowsJSON, err := r.marshalWindows(*updates.AllowedWindows)
+		if err != nil {
+			return nil, err
+		}
+		updateModel.AllowedWindows = &windowsJSON
+	}
+	if updates.PreferredWindows != nil {
+		columns = append(columns, table.CourseSessions.PreferredWindows)
+		windowsJSON, err := r.marshalWindows(*updates.PreferredWindows)
+		if err != nil {
+			return nil, err
+		}
+		updateModel.PreferredWindows = &windowsJSON
+	}
+	if updates.Spread != nil {
+		columns = append(columns, table.CourseSessions.SpreadRules)
+		spreadJSON, err := r.marshalSpread(updates.Spread)
+		if err != nil {
+			return nil, err
+		}
+		updateModel.SpreadRules = spreadJSON
+	}
 
 	if len(columns) == 0 {
 		return nil, errors.New("no fields to update")
@@ -268,7 +304,7 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 
 	updateStmt := table.CourseSessions.
 		UPDATE(columns).
-		MODEL(updates).
+		MODEL(updateModel).
 		WHERE(table.CourseSessions.ID.EQ(UUID(id))).
 		RETURNING(table.CourseSessions.AllColumns)
 
@@ -283,6 +319,91 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 		return nil, fmt.Errorf("failed to update course session: %w", err)
 	}
 
+	return r.destToCourseSession(&dest)
+}
+
+// toDBModel converts a domain model to its database form, serializing the time windows and spreading rules
+func (r *CourseSessionRepository) toDBModel(session *models.CourseSession) (courseSessionDBModel, error) {
+	allowed, err := r.marshalWindows(session.AllowedWindows)
+	if err != nil {
+		return courseSessionDBModel{}, err
+	}
+
+	preferred, err := r.marshalWindows(session.PreferredWindows)
+	if err != nil {
+		return courseSessionDBModel{}, err
+	}
+
+	spread, err := r.marshalSpread(session.Spread)
+	if err != nil {
+		return courseSessionDBModel{}, err
+	}
+
+	return courseSessionDBModel{
+		ID:               session.ID,
+		CourseID:         session.CourseID,
+		RequiredRoom:     session.RequiredRoom,
+		Type:             session.Type,
+		Duration:         session.Duration,
+		NumberOfSessions: session.NumberOfSessions,
+		Enrollment:       session.Enrollment,
+		AllowedWindows:   allowed,
+		PreferredWindows: preferred,
+		SpreadRules:      spread,
+	}, nil
+}
+
+// marshalWindows serializes time windows to a JSON array, empty when there are none
+func (r *CourseSessionRepository) marshalWindows(windows []models.SessionWindow) (string, error) {
+	if windows == nil {
+		windows = []models.SessionWindow{}
+	}
+
+	windowsJSON, err := json.Marshal(windows)
+	if err != nil {
+		r.logger.Error("failed to marshal time windows", zap.Error(err))
+		return "", fmt.Errorf("failed to marshal time windows: %w", err)
+	}
+
+	return string(windowsJSON), nil
+}
+
+// marshalSpread serializes spreading rules to a JSON object, or nil when no rule is set
+func (r *CourseSessionRepository) marshalSpread(spread *models.SpreadRules) (*string, error) {
+	if spread == nil || (spread.MinDaysBetween == nil && spread.MaxPerDay == nil && len(spread.DayPatterns) == 0) {
+		return nil, nil
+	}
+
+	spreadJSON, err := json.Marshal(spread)
+	if err != nil {
+		r.logger.Error("failed to marshal spread rules", zap.Error(err))
+		return nil, fmt.Errorf("failed to marshal spread rules: %w", err)
+	}
+
+	result := string(spreadJSON)
+	return &result, nil
+}
+
+// destToCourseSession converts a database model to a domain model
+func (r *CourseSessionRepository) destToCourseSession(dest *model.CourseSessions) (*models.CourseSession, error) {
+	var allowed, preferred []models.SessionWindow
+	if err := json.Unmarshal([]byte(dest.AllowedWindows), &allowed); err != nil {
+		r.logger.Error("failed to unmarshal allowed windows", zap.Error(err))
+		return nil, fmt.Errorf("failed to unmarshal allowed windows: %w", err)
+	}
+	if err := json.Unmarshal([]byte(dest.PreferredWindows), &preferred); err != nil {
+		r.logger.Error("failed to unmarshal preferred windows", zap.Error(err))
+		return nil, fmt.Errorf("failed to unmarshal preferred windows: %w", err)
+	}
+
+	var spread *models.SpreadRules
+	if dest.SpreadRules != nil {
+		if err := json.Unmarshal([]byte(*dest.SpreadRules), &spread); err != nil {
+			r.logger.Error("failed to unmarshal spread rules", zap.Error(err))
+			return nil, fmt.Errorf("failed to unmarshal spread rules: %w", err)
+		}
+	}
+
 	return models.NewCourseSession(
 		dest.ID,
 		dest.CourseID,
@@ -290,6 +411,10 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 		string(dest.Type),
 		dest.Duration,
 		dest.NumberOfSessions,
+		dest.Enrollment,
+		allowed,
+		preferred,
+		spread,
 		dest.CreatedAt,
 		dest.UpdatedAt,
 	), nil
@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ InstructorRepositoryInterface = (*InstructorRepository)(nil)
+
+type InstructorRepositoryInterface interface {
+	Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error)
+	CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error)
+	GetBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*models.Instructor, error)
+	List(ctx context.Context) ([]*models.Instructor, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error)
+	Assign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) (*models.InstructorAssignment, error)
+	Unassign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) error
+	ListAssignments(ctx context.Context) ([]*models.InstructorAssignment, error)
+}
+
+type InstructorRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewInstructorRepository(db *sql.DB, logger *zap.Logger) *InstructorRepository {
+	return &InstructorRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *InstructorRepository) Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error) {
+	if instructor == nil {
+		return nil, errors.New("instructor cannot be nil")
+	}
+
+	if err := instructor.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.Instructors.
+		INSERT(table.Instructors.AllColumns.Except(table.Instructors.CreatedAt, table.Instructors.UpdatedAt)).
+		MODEL(instructor).
+		RETURNING(table.Instructors.AllColumns)
+
+	var dest model.Instructors
+	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to create instructor", zap.Error(err))
+		return nil, fmt.Errorf("failed to create instructor: %w", err)
+	}
+
+	return models.NewInstructor(dest.ID, dest.Name, dest.Email, dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+func (r *InstructorRepository) CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error) {
+	if len(instructors) < 1 {
+		return nil, errors.New("at least one instructor is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var newInstructors []*models.Instructor
+	for _, instructor := range instructors {
+		if instructor == nil {
+			return nil, errors.New("instructor cannot be nil")
+		}
+
+		if err := instructor.Validate(); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+
+		insertStmt := table.Instructors.
+			INSERT(table.Instructors.AllColumns.Except(table.Instructors.CreatedAt, table.Instructors.UpdatedAt)).
+			MODEL(instructor).
+			RETURNING(table.Instructors.AllColumns)
+
+		var dest model.Instructors
+		if err := insertStmt.QueryContext(ctx, tx, &dest); err != nil {
+			r.logger.Error("failed to create instructor", zap.Error(err))
+			return nil, fmt.Errorf("failed to create instructor: %w", err)
+		}
+
+		newInstructors = append(newInstructors, models.NewInstructor(dest.ID, dest.Name, dest.Email, dest.CreatedAt, dest.UpdatedAt))
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return newInstructors, nil
+}
+
+func (r *InstructorRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error) {
+	stmt := table.Instructors.
+		SELECT(table.Instructors.AllColumns).
+		WHERE(table.Instructors.ID.EQ(UUID(id)))
+
+	var dest model.Instructors
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get instructor", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get instructor: %w", err)
+	}
+
+	return models.NewInstructor(dest.ID, dest.Name, dest.Email, dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+func (r *InstructorRepository) GetBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*models.Instructor, error) {
+	stmt := SELECT(table.Instructors.AllColumns).
+		FROM(
+			table.Instructors.INNER_JOIN(
+				table.CourseSessionInstructors,
+				table.CourseSessionInstructors.InstructorID.EQ(table.Instructors.ID),
+			),
+		).
+		WHERE(table.CourseSessionInstructors.CourseSessionID.EQ(UUID(sessionID))).
+		ORDER_BY(table.Instructors.Name.ASC())
+
+	var dest []model.Instructors
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get instructors by session id", zap.Error(err), zap.String("session_id", sessionID.String()))
+		return nil, fmt.Errorf("failed to get instructors: %w", err)
+	}
+
+	instructors := make([]*models.Instructor, len(dest))
+	for i, d := range dest {
+		instructors[i] = models.NewInstructor(d.ID, d.Name, d.Email, d.CreatedAt, d.UpdatedAt)
+	}
+
+	return instructors, nil
+}
+
+func (r *InstructorRepository) List(ctx context.Context) ([]*models.Instructor, error) {
+	stmt := table.Instructors.
+		SELECT(table.Instructors.AllColumns).
+		ORDER_BY(table.Instructors.Name.ASC())
+
+	var dest []model.Instructors
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list instructors", zap.Error(err))
+		return nil, fmt.Errorf("failed to list instructors: %w", err)
+	}
+
+	instructors := make([]*models.Instructor, len(dest))
+	for i, d := range dest {
+		instructors[i] = models.NewInstructor(d.ID, d.Name, d.Email, d.CreatedAt, d.UpdatedAt)
+	}
+
+	return instructors, nil
+}
+
+func (r *InstructorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.Instructors.
+		DELETE().
+		WHERE(table.Instructors.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete instructor", zap.Error(err))
+		return fmt.Errorf("failed to delete instructor: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete instructor: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *InstructorRepository) Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.Name != nil {
+		columns = append(columns, table.Instructors.Name)
+	}
+	if updates.Email != nil {
+		columns = append(columns, table.Instructors.Email)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	updateStmt := table.Instructors.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(table.Instructors.ID.EQ(UUID(id))).
+		RETURNING(table.Instructors.AllColumns)
+
+	var dest model.Instructors
+	err := updateStmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update instructor", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update instructor: %w", err)
+	}
+
+	return models.NewInstructor(dest.ID, dest.Name, dest.Email, dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+func (r *InstructorRepository) Assign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) (*models.InstructorAssignment, error) {
+	assignment := model.CourseSessionInstructors{
+		CourseSessionID: sessionID,
+		InstructorID:    instructorID,
+	}
+
+	insertStmt := table.CourseSessionInstructors.
+		INSERT(table.CourseSessionInstructors.CourseSessionID, table.CourseSessionInstructors.InstructorID).
+		MODEL(assignment).
+		RETURNING(table.CourseSessionInstructors.AllColumns)
+
+	var dest model.CourseSessionInstructors
+	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to assign instructor", zap.Error(err),
+			zap.String("session_id", sessionID.String()), zap.String("instructor_id", instructorID.String()))
+		return nil, fmt.Errorf("failed to assign instructor: %w", err)
+	}
+
+	return models.NewInstructorAssignment(dest.CourseSessionID, dest.InstructorID, dest.CreatedAt), nil
+}
+
+func (r *InstructorRepository) Unassign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) error {
+	deleteStmt := table.CourseSessionInstructors.
+		DELETE().
+		WHERE(
+			table.CourseSessionInstructors.CourseSessionID.EQ(UUID(sessionID)).
+				AND(table.CourseSessionInstructors.InstructorID.EQ(UUID(instructorID))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to unassign instructor", zap.Error(err))
+		return fmt.Errorf("failed to unassign instructor: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to unassign instructor: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *InstructorRepository) ListAssignments(ctx context.Context) ([]*models.InstructorAssignment, error) {
+	stmt := table.CourseSessionInstructors.
+		SELECT(table.CourseSessionInstructors.AllColumns).
+		ORDER_BY(table.CourseSessionInstructors.CourseSessionID.ASC(), table.CourseSessionInstructors.InstructorID.ASC())
+
+	var dest []model.CourseSessionInstructors
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list instructor assignments", zap.Error(err))
+		return nil, fmt.Errorf("failed to list instructor assignments: %w", err)
+	}
+
+	assignments := make([]*models.InstructorAssignment, len(dest))
+	for i, d := range dest {
+		assignments[i] = models.NewInstructorAssignment(d.CourseSessionID, d.InstructorID, d.CreatedAt)
+	}
+
+	return assignments, nil
+}
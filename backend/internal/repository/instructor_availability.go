@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ InstructorAvailabilityRepositoryInterface = (*InstructorAvailabilityRepository)(nil)
+
+type InstructorAvailabilityRepositoryInterface interface {
+	Create(ctx context.Context, availability *models.InstructorAvailability) (*models.InstructorAvailability, error)
+	GetByID(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) (*models.InstructorAvailability, error)
+	GetByInstructorID(ctx context.Context, instructorID uuid.UUID) ([]*models.InstructorAvailability, error)
+	List(ctx context.Context) ([]*models.InstructorAvailability, error)
+	Delete(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, instructorID uuid.UUID, id uuid.UUID, updates *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error)
+}
+
+type InstructorAvailabilityRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewInstructorAvailabilityRepository(db *sql.DB, logger *zap.Logger) *InstructorAvailabilityRepository {
+	return &InstructorAvailabilityRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *InstructorAvailabilityRepository) Create(ctx context.Context, availability *models.InstructorAvailability) (*models.InstructorAvailability, error) {
+	if availability == nil {
+		return nil, errors.New("instructor availability cannot be nil")
+	}
+
+	if err := availability.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.InstructorAvailability.
+		INSERT(table.InstructorAvailability.AllColumns.Except(table.InstructorAvailability.CreatedAt, table.InstructorAvailability.UpdatedAt)).
+		MODEL(availability).
+		RETURNING(table.InstructorAvailability.AllColumns)
+
+	var dest model.InstructorAvailability
+	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to create instructor availability", zap.Error(err))
+		return nil, fmt.Errorf("failed to create instructor availability: %w", err)
+	}
+
+	return toInstructorAvailability(dest), nil
+}
+
+func (r *InstructorAvailabilityRepository) GetByID(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) (*models.InstructorAvailability, error) {
+	stmt := table.InstructorAvailability.
+		SELECT(table.InstructorAvailability.AllColumns).
+		WHERE(
+			table.InstructorAvailability.ID.EQ(UUID(id)).
+				AND(table.InstructorAvailability.InstructorID.EQ(UUID(instructorID))),
+		)
+
+	var dest model.InstructorAvailability
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get instructor availability", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get instructor availability: %w", err)
+	}
+
+	return toInstructorAvailability(dest), nil
+}
+
+func (r *InstructorAvailabilityRepository) GetByInstructorID(ctx context.Context, instructorID uuid.UUID) ([]*models.InstructorAvailability, error) {
+	stmt := table.InstructorAvailability.
+		SELECT(table.InstructorAvailability.AllColumns).
+		WHERE(table.InstructorAvailability.InstructorID.EQ(UUID(instructorID))).
+		ORDER_BY(table.InstructorAvailability.Day.ASC(), table.InstructorAvailability.StartTime.ASC())
+
+	var dest []model.InstructorAvailability
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get instructor availability by instructor id", zap.Error(err), zap.String("instructor_id", instructorID.String()))
+		return nil, fmt.Errorf("failed to get instructor availability: %w", err)
+	}
+
+	result := make([]*models.InstructorAvailability, len(dest))
+	for i, d := range dest {
+		result[i] = toInstructorAvailability(d)
+	}
+
+	return result, nil
+}
+
+func (r *InstructorAvailabilityRepository) List(ctx context.Context) ([]*models.InstructorAvailability, error) {
+	stmt := table.InstructorAvailability.
+		SELECT(table.InstructorAvailability.AllColumns).
+		ORDER_BY(
+			table.InstructorAvailability.InstructorID.ASC(),
+			table.InstructorAvailability.Day.ASC(),
+			table.InstructorAvailability.StartTime.ASC(),
+		)
+
+	var dest []model.InstructorAvailability
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list instructor availability", zap.Error(err))
+		return nil, fmt.Errorf("failed to list instructor availability: %w", err)
+	}
+
+	result := make([]*models.InstructorAvailability, len(dest))
+	for i, d := range dest {
+		result[i] = toInstructorAvailability(d)
+	}
+
+	return result, nil
+}
+
+func (r *InstructorAvailabilityRepository) Delete(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) error {
+	deleteStmt := table.InstructorAvailability.
+		DELETE().
+		WHERE(
+			table.InstructorAvailability.ID.EQ(UUID(id)).
+				AND(table.InstructorAvailability.InstructorID.EQ(UUID(instructorID))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete instructor availability", zap.Error(err))
+		return fmt.Errorf("failed to delete instructor availability: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete instructor availability: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *InstructorAvailabilityRepository) Update(ctx context.Context, instructorID uuid.UUID, id uuid.UUID, updates *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.Kind != nil {
+		columns = append(columns, table.InstructorAvailability.Kind)
+	}
+	if updates.Day != nil {
+		columns = append(columns, table.InstructorAvailability.Day)
+	}
+	if updates.StartTime != nil {
+		columns = append(columns, table.InstructorAvailability.StartTime)
+	}
+	if updates.EndTime != nil {
+		columns = append(columns, table.InstructorAvailability.EndTime)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	updateStmt := table.InstructorAvailability.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(
+			table.InstructorAvailability.ID.EQ(UUID(id)).
+				AND(table.InstructorAvailability.InstructorID.EQ(UUID(instructorID))),
+		).
+		RETURNING(table.InstructorAvailability.AllColumns)
+
+	var dest model.InstructorAvailability
+	err := updateStmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update instructor availability", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update instructor availability: %w", err)
+	}
+
+	return toInstructorAvailability(dest), nil
+}
+
+func toInstructorAvailability(d model.InstructorAvailability) *models.InstructorAvailability {
+	return models.NewInstructorAvailability(d.ID, d.InstructorID, string(d.Kind), d.Day, d.StartTime, d.EndTime, d.CreatedAt, d.UpdatedAt)
+}
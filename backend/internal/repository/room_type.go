@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
 	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
@@ -42,22 +43,25 @@ func (r *RoomTypeRepository) Create(ctx context.Context, roomType *models.RoomTy
 		return nil, errors.New("roomType cannot be nil")
 	}
 
-	if err := roomType.Validate(); err != nil {
-		return nil, fmt.Errorf("validation failed: %w", err)
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	insertStmt := table.RoomTypes.
-		INSERT(table.RoomTypes.Name).
-		MODEL(roomType).
-		RETURNING(table.RoomTypes.AllColumns)
+	defer tx.Rollback()
 
-	var dest model.RoomTypes
-	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
-		r.logger.Error("failed to create room type", zap.Error(err))
-		return nil, fmt.Errorf("failed to create room type: %w", err)
+	created, err := r.create(ctx, tx, roomType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return models.NewRoomType(dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	return created, nil
 }
 
 func (r *RoomTypeRepository) CreateBatch(ctx context.Context, roomTypes []*models.RoomType) ([]*models.RoomType, error) {
@@ -77,6 +81,7 @@ func (r *RoomTypeRepository) CreateBatch(ctx context.Context, roomTypes []*model
 
 	defer tx.Rollback()
 
+	// Insert every room type before any substitutes, so a batch may name its own types as substitutes
 	var newRoomTypes []*models.RoomType
 	for _, roomType := range roomTypes {
 
@@ -98,7 +103,13 @@ func (r *RoomTypeRepository) CreateBatch(ctx context.Context, roomTypes []*model
 			return nil, fmt.Errorf("failed to create room type: %w", err)
 		}
 
-		newRoomTypes = append(newRoomTypes, models.NewRoomType(dest.Name, dest.CreatedAt, dest.UpdatedAt))
+		newRoomTypes = append(newRoomTypes, models.NewRoomType(dest.Name, roomType.Substitutes, dest.CreatedAt, dest.UpdatedAt))
+	}
+
+	for _, roomType := range newRoomTypes {
+		if err := r.replaceSubstitutes(ctx, tx, roomType.Name, roomType.Substitutes); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -142,25 +153,40 @@ func (r *RoomTypeRepository) Update(ctx context.Context, name string, updates *m
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
-	var columns ColumnList
-	if updates.Name != nil {
-		columns = append(columns, table.RoomTypes.Name)
+	if updates.Name == nil && updates.Substitutes == nil {
+		return nil, errors.New("no fields to update")
 	}
 
-	if len(columns) == 0 {
-		return nil, errors.New("no fields to update")
+	// The room type keeps its current name, which its substitutes cannot include either
+	if updates.Substitutes != nil && updates.Name == nil && slices.Contains(*updates.Substitutes, name) {
+		return nil, errors.New("validation failed: room type cannot substitute for itself")
 	}
 
-	updateStmt := table.RoomTypes.
-		UPDATE(columns).
-		MODEL(updates).
-		WHERE(table.RoomTypes.Name.EQ(String(name))).
-		RETURNING(table.RoomTypes.AllColumns)
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
 
-	var dest model.RoomTypes
-	err := updateStmt.QueryContext(ctx, r.db, &dest)
+	defer tx.Rollback()
 
-	if err != nil {
+	var stmt Statement
+	if updates.Name != nil {
+		stmt = table.RoomTypes.
+			UPDATE(table.RoomTypes.Name).
+			MODEL(updates).
+			WHERE(table.RoomTypes.Name.EQ(String(name))).
+			RETURNING(table.RoomTypes.AllColumns)
+	} else {
+		// Only the substitute list changes, but the room type must still exist
+		stmt = table.RoomTypes.
+			SELECT(table.RoomTypes.AllColumns).
+			WHERE(table.RoomTypes.Name.EQ(String(name))).
+			FOR(UPDATE())
+	}
+
+	var dest model.RoomTypes
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
 		if errors.Is(err, qrm.ErrNoRows) {
 			return nil, ErrNotFound
 		}
@@ -168,7 +194,23 @@ func (r *RoomTypeRepository) Update(ctx context.Context, name string, updates *m
 		return nil, fmt.Errorf("failed to update room type: %w", err)
 	}
 
-	return models.NewRoomType(dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	if updates.Substitutes != nil {
+		if err := r.replaceSubstitutes(ctx, tx, dest.Name, *updates.Substitutes); err != nil {
+			return nil, err
+		}
+	}
+
+	substitutes, err := r.substitutes(ctx, tx, table.RoomTypeSubstitutes.RoomType.EQ(String(dest.Name)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return models.NewRoomType(dest.Name, substitutes[dest.Name], dest.CreatedAt, dest.UpdatedAt), nil
 }
 
 func (r *RoomTypeRepository) GetByName(ctx context.Context, name string) (*models.RoomType, error) {
@@ -187,7 +229,12 @@ func (r *RoomTypeRepository) GetByName(ctx context.Context, name string) (*model
 		return nil, fmt.Errorf("failed to get room type: %w", err)
 	}
 
-	return models.NewRoomType(dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	substitutes, err := r.substitutes(ctx, r.db, table.RoomTypeSubstitutes.RoomType.EQ(String(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewRoomType(dest.Name, substitutes[dest.Name], dest.CreatedAt, dest.UpdatedAt), nil
 }
 
 func (r *RoomTypeRepository) List(ctx context.Context) ([]*models.RoomType, error) {
@@ -203,10 +250,95 @@ func (r *RoomTypeRepository) List(ctx context.Context) ([]*models.RoomType, erro
 		return nil, fmt.Errorf("failed to list room types: %w", err)
 	}
 
+	substitutes, err := r.substitutes(ctx, r.db, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	roomTypes := make([]*models.RoomType, len(dest))
 	for i, d := range dest {
-		roomTypes[i] = models.NewRoomType(d.Name, d.CreatedAt, d.UpdatedAt)
+		roomTypes[i] = models.NewRoomType(d.Name, substitutes[d.Name], d.CreatedAt, d.UpdatedAt)
 	}
 
 	return roomTypes, nil
 }
+
+// create inserts a room type and its substitutes within the given transaction
+func (r *RoomTypeRepository) create(ctx context.Context, tx *sql.Tx, roomType *models.RoomType) (*models.RoomType, error) {
+	if err := roomType.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.RoomTypes.
+		INSERT(table.RoomTypes.Name).
+		MODEL(roomType).
+		RETURNING(table.RoomTypes.AllColumns)
+
+	var dest model.RoomTypes
+	if err := insertStmt.QueryContext(ctx, tx, &dest); err != nil {
+		r.logger.Error("failed to create room type", zap.Error(err))
+		return nil, fmt.Errorf("failed to create room type: %w", err)
+	}
+
+	if err := r.replaceSubstitutes(ctx, tx, dest.Name, roomType.Substitutes); err != nil {
+		return nil, err
+	}
+
+	return models.NewRoomType(dest.Name, roomType.Substitutes, dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+// replaceSubstitutes swaps the room type's substitutes for the given list, ranked in its order
+func (r *RoomTypeRepository) replaceSubstitutes(ctx context.Context, tx *sql.Tx, name string, substitutes []string) error {
+	deleteStmt := table.RoomTypeSubstitutes.
+		DELETE().
+		WHERE(table.RoomTypeSubstitutes.RoomType.EQ(String(name)))
+
+	if _, err := deleteStmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to clear room type substitutes", zap.Error(err), zap.String("name", name))
+		return fmt.Errorf("failed to update room type substitutes: %w", err)
+	}
+
+	if len(substitutes) == 0 {
+		return nil
+	}
+
+	rows := make([]model.RoomTypeSubstitutes, len(substitutes))
+	for i, substitute := range substitutes {
+		rows[i] = model.RoomTypeSubstitutes{RoomType: name, Substitute: substitute, Rank: int32(i)}
+	}
+
+	insertStmt := table.RoomTypeSubstitutes.
+		INSERT(table.RoomTypeSubstitutes.RoomType, table.RoomTypeSubstitutes.Substitute, table.RoomTypeSubstitutes.Rank).
+		MODELS(rows)
+
+	if _, err := insertStmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to link room type substitutes", zap.Error(err), zap.String("name", name))
+		return fmt.Errorf("failed to update room type substitutes: %w", err)
+	}
+
+	return nil
+}
+
+// substitutes loads substitutes grouped by room type in preference order, optionally filtered by condition
+func (r *RoomTypeRepository) substitutes(ctx context.Context, db qrm.Queryable, condition BoolExpression) (map[string][]string, error) {
+	stmt := table.RoomTypeSubstitutes.
+		SELECT(table.RoomTypeSubstitutes.AllColumns).
+		ORDER_BY(table.RoomTypeSubstitutes.RoomType.ASC(), table.RoomTypeSubstitutes.Rank.ASC())
+
+	if condition != nil {
+		stmt = stmt.WHERE(condition)
+	}
+
+	var dest []model.RoomTypeSubstitutes
+	if err := stmt.QueryContext(ctx, db, &dest); err != nil {
+		r.logger.Error("failed to list room type substitutes", zap.Error(err))
+		return nil, fmt.Errorf("failed to list room type substitutes: %w", err)
+	}
+
+	result := make(map[string][]string)
+	for _, d := range dest {
+		result[d.RoomType] = append(result[d.RoomType], d.Substitute)
+	}
+
+	return result, nil
+}
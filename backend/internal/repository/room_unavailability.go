@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ RoomUnavailabilityRepositoryInterface = (*RoomUnavailabilityRepository)(nil)
+
+type RoomUnavailabilityRepositoryInterface interface {
+	Create(ctx context.Context, unavailability *models.RoomUnavailability) (*models.RoomUnavailability, error)
+	GetByID(ctx context.Context, roomID uuid.UUID, id uuid.UUID) (*models.RoomUnavailability, error)
+	GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomUnavailability, error)
+	List(ctx context.Context) ([]*models.RoomUnavailability, error)
+	Delete(ctx context.Context, roomID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, roomID uuid.UUID, id uuid.UUID, updates *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error)
+}
+
+type RoomUnavailabilityRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewRoomUnavailabilityRepository(db *sql.DB, logger *zap.Logger) *RoomUnavailabilityRepository {
+	return &RoomUnavailabilityRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *RoomUnavailabilityRepository) Create(ctx context.Context, unavailability *models.RoomUnavailability) (*models.RoomUnavailability, error) {
+	if unavailability == nil {
+		return nil, errors.New("room unavailability cannot be nil")
+	}
+
+	if err := unavailability.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.RoomUnavailability.
+		INSERT(table.RoomUnavailability.AllColumns.Except(table.RoomUnavailability.CreatedAt, table.RoomUnavailability.UpdatedAt)).
+		MODEL(unavailability).
+		RETURNING(table.RoomUnavailability.AllColumns)
+
+	var dest model.RoomUnavailability
+	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to create room unavailability", zap.Error(err))
+		return nil, fmt.Errorf("failed to create room unavailability: %w", err)
+	}
+
+	return toRoomUnavailability(dest), nil
+}
+
+func (r *RoomUnavailabilityRepository) GetByID(ctx context.Context, roomID uuid.UUID, id uuid.UUID) (*models.RoomUnavailability, error) {
+	stmt := table.RoomUnavailability.
+		SELECT(table.RoomUnavailability.AllColumns).
+		WHERE(
+			table.RoomUnavailability.ID.EQ(UUID(id)).
+				AND(table.RoomUnavailability.RoomID.EQ(UUID(roomID))),
+		)
+
+	var dest model.RoomUnavailability
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get room unavailability", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get room unavailability: %w", err)
+	}
+
+	return toRoomUnavailability(dest), nil
+}
+
+func (r *RoomUnavailabilityRepository) GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomUnavailability, error) {
+	stmt := table.RoomUnavailability.
+		SELECT(table.RoomUnavailability.AllColumns).
+		WHERE(table.RoomUnavailability.RoomID.EQ(UUID(roomID))).
+		ORDER_BY(table.RoomUnavailability.Day.ASC(), table.RoomUnavailability.StartTime.ASC())
+
+	var dest []model.RoomUnavailability
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get room unavailability by room id", zap.Error(err), zap.String("room_id", roomID.String()))
+		return nil, fmt.Errorf("failed to get room unavailability: %w", err)
+	}
+
+	result := make([]*models.RoomUnavailability, len(dest))
+	for i, d := range dest {
+		result[i] = toRoomUnavailability(d)
+	}
+
+	return result, nil
+}
+
+func (r *RoomUnavailabilityRepository) List(ctx context.Context) ([]*models.RoomUnavailability, error) {
+	stmt := table.RoomUnavailability.
+		SELECT(table.RoomUnavailability.AllColumns).
+		ORDER_BY(
+			table.RoomUnavailability.RoomID.ASC(),
+			table.RoomUnavailability.Day.ASC(),
+			table.RoomUnavailability.StartTime.ASC(),
+		)
+
+	var dest []model.RoomUnavailability
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list room unavailability", zap.Error(err))
+		return nil, fmt.Errorf("failed to list room unavailability: %w", err)
+	}
+
+	result := make([]*models.RoomUnavailability, len(dest))
+	for i, d := range dest {
+		result[i] = toRoomUnavailability(d)
+	}
+
+	return result, nil
+}
+
+func (r *RoomUnavailabilityRepository) Delete(ctx context.Context, roomID uuid.UUID, id uuid.UUID) error {
+	deleteStmt := table.RoomUnavailability.
+		DELETE().
+		WHERE(
+			table.RoomUnavailability.ID.EQ(UUID(id)).
+				AND(table.RoomUnavailability.RoomID.EQ(UUID(roomID))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete room unavailability", zap.Error(err))
+		return fmt.Errorf("failed to delete room unavailability: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete room unavailability: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *RoomUnavailabilityRepository) Update(ctx context.Context, roomID uuid.UUID, id uuid.UUID, updates *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.Day != nil {
+		columns = append(columns, table.RoomUnavailability.Day)
+	}
+	if updates.StartTime != nil {
+		columns = append(columns, table.RoomUnavailability.StartTime)
+	}
+	if updates.EndTime != nil {
+		columns = append(columns, table.RoomUnavailability.EndTime)
+	}
+	if updates.Reason != nil {
+		columns = append(columns, table.RoomUnavailability.Reason)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	updateStmt := table.RoomUnavailability.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(
+			table.RoomUnavailability.ID.EQ(UUID(id)).
+				AND(table.RoomUnavailability.RoomID.EQ(UUID(roomID))),
+		).
+		RETURNING(table.RoomUnavailability.AllColumns)
+
+	var dest model.RoomUnavailability
+	err := updateStmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update room unavailability", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update room unavailability: %w", err)
+	}
+
+	return toRoomUnavailability(dest), nil
+}
+
+func toRoomUnavailability(d model.RoomUnavailability) *models.RoomUnavailability {
+	return models.NewRoomUnavailability(d.ID, d.RoomID, d.Day, d.StartTime, d.EndTime, d.Reason, d.CreatedAt, d.UpdatedAt)
+}
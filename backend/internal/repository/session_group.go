@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ SessionGroupRepositoryInterface = (*SessionGroupRepository)(nil)
+
+type SessionGroupRepositoryInterface interface {
+	Create(ctx context.Context, group *models.SessionGroup) (*models.SessionGroup, error)
+	CreateBatch(ctx context.Context, groups []*models.SessionGroup) ([]*models.SessionGroup, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.SessionGroup, error)
+	List(ctx context.Context) ([]*models.SessionGroup, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.SessionGroupUpdate) (*models.SessionGroup, error)
+}
+
+type SessionGroupRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewSessionGroupRepository(db *sql.DB, logger *zap.Logger) *SessionGroupRepository {
+	return &SessionGroupRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *SessionGroupRepository) Create(ctx context.Context, group *models.SessionGroup) (*models.SessionGroup, error) {
+	if group == nil {
+		return nil, errors.New("session group cannot be nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	created, err := r.create(ctx, tx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *SessionGroupRepository) CreateBatch(ctx context.Context, groups []*models.SessionGroup) ([]*models.SessionGroup, error) {
+	if len(groups) < 1 {
+		return nil, errors.New("at least one session group is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var newGroups []*models.SessionGroup
+	for _, group := range groups {
+		if group == nil {
+			return nil, errors.New("session group cannot be nil")
+		}
+
+		created, err := r.create(ctx, tx, group)
+		if err != nil {
+			return nil, err
+		}
+
+		newGroups = append(newGroups, created)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return newGroups, nil
+}
+
+func (r *SessionGroupRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SessionGroup, error) {
+	stmt := table.SessionGroups.
+		SELECT(table.SessionGroups.AllColumns).
+		WHERE(table.SessionGroups.ID.EQ(UUID(id)))
+
+	var dest model.SessionGroups
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get session group", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get session group: %w", err)
+	}
+
+	courseSessionIDs, err := r.courseSessionIDs(ctx, r.db, table.SessionGroupMembers.GroupID.EQ(UUID(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewSessionGroup(dest.ID, dest.Name, courseSessionIDs[dest.ID], dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+func (r *SessionGroupRepository) List(ctx context.Context) ([]*models.SessionGroup, error) {
+	stmt := table.SessionGroups.
+		SELECT(table.SessionGroups.AllColumns).
+		ORDER_BY(table.SessionGroups.Name.ASC())
+
+	var dest []model.SessionGroups
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list groups", zap.Error(err))
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	courseSessionIDs, err := r.courseSessionIDs(ctx, r.db, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*models.SessionGroup, len(dest))
+	for i, d := range dest {
+		groups[i] = models.NewSessionGroup(d.ID, d.Name, courseSessionIDs[d.ID], d.CreatedAt, d.UpdatedAt)
+	}
+
+	return groups, nil
+}
+
+func (r *SessionGroupRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.SessionGroups.
+		DELETE().
+		WHERE(table.SessionGroups.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete session group", zap.Error(err))
+		return fmt.Errorf("failed to delete session group: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete session group: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *SessionGroupRepository) Update(ctx context.Context, id uuid.UUID, updates *models.SessionGroupUpdate) (*models.SessionGroup, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if updates.Name == nil && updates.CourseSessionIDs == nil {
+		return nil, errors.New("no fields to update")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var stmt Statement
+	if updates.Name != nil {
+		stmt = table.SessionGroups.
+			UPDATE(table.SessionGroups.Name).
+			MODEL(updates).
+			WHERE(table.SessionGroups.ID.EQ(UUID(id))).
+			RETURNING(table.SessionGroups.AllColumns)
+	} else {
+		// Only the member list changes, but the session group must still exist
+		stmt = table.SessionGroups.
+			SELECT(table.SessionGroups.AllColumns).
+			WHERE(table.SessionGroups.ID.EQ(UUID(id))).
+			FOR(UPDATE())
+	}
+
+	var dest model.SessionGroups
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update session group", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update session group: %w", err)
+	}
+
+	if updates.CourseSessionIDs != nil {
+		if err := r.replaceMembers(ctx, tx, id, *updates.CourseSessionIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	courseSessionIDs, err := r.courseSessionIDs(ctx, tx, table.SessionGroupMembers.GroupID.EQ(UUID(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return models.NewSessionGroup(dest.ID, dest.Name, courseSessionIDs[dest.ID], dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+// create inserts a session group and its members within the given transaction
+func (r *SessionGroupRepository) create(ctx context.Context, tx *sql.Tx, group *models.SessionGroup) (*models.SessionGroup, error) {
+	if err := group.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.SessionGroups.
+		INSERT(table.SessionGroups.ID, table.SessionGroups.Name).
+		MODEL(group).
+		RETURNING(table.SessionGroups.AllColumns)
+
+	var dest model.SessionGroups
+	if err := insertStmt.QueryContext(ctx, tx, &dest); err != nil {
+		r.logger.Error("failed to create session group", zap.Error(err))
+		return nil, fmt.Errorf("failed to create session group: %w", err)
+	}
+
+	if err := r.replaceMembers(ctx, tx, dest.ID, group.CourseSessionIDs); err != nil {
+		return nil, err
+	}
+
+	return models.NewSessionGroup(dest.ID, dest.Name, group.CourseSessionIDs, dest.CreatedAt, dest.UpdatedAt), nil
+}
+
+// replaceMembers swaps the session group's members for the given list, keeping their order
+func (r *SessionGroupRepository) replaceMembers(ctx context.Context, tx *sql.Tx, groupID uuid.UUID, courseSessionIDs []uuid.UUID) error {
+	deleteStmt := table.SessionGroupMembers.
+		DELETE().
+		WHERE(table.SessionGroupMembers.GroupID.EQ(UUID(groupID)))
+
+	if _, err := deleteStmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to clear session group members", zap.Error(err), zap.String("group_id", groupID.String()))
+		return fmt.Errorf("failed to update session group members: %w", err)
+	}
+
+	rows := make([]model.SessionGroupMembers, len(courseSessionIDs))
+	for i, courseSessionID := range courseSessionIDs {
+		rows[i] = model.SessionGroupMembers{GroupID: groupID, CourseSessionID: courseSessionID, Position: int32(i)}
+	}
+
+	insertStmt := table.SessionGroupMembers.
+		INSERT(table.SessionGroupMembers.GroupID, table.SessionGroupMembers.CourseSessionID, table.SessionGroupMembers.Position).
+		MODELS(rows)
+
+	if _, err := insertStmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to link session group members", zap.Error(err), zap.String("group_id", groupID.String()))
+		return fmt.Errorf("failed to update session group members: %w", err)
+	}
+
+	return nil
+}
+
+// courseSessionIDs loads members in order grouped by session group, optionally filtered by condition
+func (r *SessionGroupRepository) courseSessionIDs(ctx context.Context, db qrm.Queryable, condition BoolExpression) (map[uuid.UUID][]uuid.UUID, error) {
+	stmt := table.SessionGroupMembers.
+		SELECT(table.SessionGroupMembers.AllColumns).
+		ORDER_BY(table.SessionGroupMembers.GroupID.ASC(), table.SessionGroupMembers.Position.ASC())
+
+	if condition != nil {
+		stmt = stmt.WHERE(condition)
+	}
+
+	var dest []model.SessionGroupMembers
+	if err := stmt.QueryContext(ctx, db, &dest); err != nil {
+		r.logger.Error("failed to list session group members", zap.Error(err))
+		return nil, fmt.Errorf("failed to list session group members: %w", err)
+	}
+
+	result := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range dest {
+		result[d.GroupID] = append(result[d.GroupID], d.CourseSessionID)
+	}
+
+	return result, nil
+}
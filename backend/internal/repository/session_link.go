@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ SessionLinkRepositoryInterface = (*SessionLinkRepository)(nil)
+
+type SessionLinkRepositoryInterface interface {
+	Create(ctx context.Context, link *models.SessionLink) (*models.SessionLink, error)
+	GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionLink, error)
+	GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionLink, error)
+	List(ctx context.Context) ([]*models.SessionLink, error)
+	Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionLinkUpdate) (*models.SessionLink, error)
+}
+
+type SessionLinkRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewSessionLinkRepository(db *sql.DB, logger *zap.Logger) *SessionLinkRepository {
+	return &SessionLinkRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *SessionLinkRepository) Create(ctx context.Context, link *models.SessionLink) (*models.SessionLink, error) {
+	if link == nil {
+		return nil, errors.New("session link cannot be nil")
+	}
+
+	if err := link.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.SessionLinks.
+		INSERT(table.SessionLinks.AllColumns.Except(table.SessionLinks.CreatedAt, table.SessionLinks.UpdatedAt)).
+		MODEL(link).
+		RETURNING(table.SessionLinks.AllColumns)
+
+	var dest model.SessionLinks
+	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to create session link", zap.Error(err))
+		return nil, fmt.Errorf("failed to create session link: %w", err)
+	}
+
+	return toSessionLink(dest), nil
+}
+
+func (r *SessionLinkRepository) GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionLink, error) {
+	stmt := table.SessionLinks.
+		SELECT(table.SessionLinks.AllColumns).
+		WHERE(
+			table.SessionLinks.ID.EQ(UUID(id)).
+				AND(table.SessionLinks.CourseSessionID.EQ(UUID(courseSessionID))),
+		)
+
+	var dest model.SessionLinks
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get session link", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get session links: %w", err)
+	}
+
+	return toSessionLink(dest), nil
+}
+
+func (r *SessionLinkRepository) GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionLink, error) {
+	stmt := table.SessionLinks.
+		SELECT(table.SessionLinks.AllColumns).
+		WHERE(table.SessionLinks.CourseSessionID.EQ(UUID(courseSessionID))).
+		ORDER_BY(table.SessionLinks.OtherSessionID.ASC(), table.SessionLinks.Kind.ASC())
+
+	var dest []model.SessionLinks
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get session links by course session id", zap.Error(err), zap.String("course_session_id", courseSessionID.String()))
+		return nil, fmt.Errorf("failed to get session links: %w", err)
+	}
+
+	result := make([]*models.SessionLink, len(dest))
+	for i, d := range dest {
+		result[i] = toSessionLink(d)
+	}
+
+	return result, nil
+}
+
+func (r *SessionLinkRepository) List(ctx context.Context) ([]*models.SessionLink, error) {
+	stmt := table.SessionLinks.
+		SELECT(table.SessionLinks.AllColumns).
+		ORDER_BY(
+			table.SessionLinks.CourseSessionID.ASC(),
+			table.SessionLinks.OtherSessionID.ASC(),
+			table.SessionLinks.Kind.ASC(),
+		)
+
+	var dest []model.SessionLinks
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list session links", zap.Error(err))
+		return nil, fmt.Errorf("failed to list session links: %w", err)
+	}
+
+	result := make([]*models.SessionLink, len(dest))
+	for i, d := range dest {
+		result[i] = toSessionLink(d)
+	}
+
+	return result, nil
+}
+
+func (r *SessionLinkRepository) Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error {
+	deleteStmt := table.SessionLinks.
+		DELETE().
+		WHERE(
+			table.SessionLinks.ID.EQ(UUID(id)).
+				AND(table.SessionLinks.CourseSessionID.EQ(UUID(courseSessionID))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete session link", zap.Error(err))
+		return fmt.Errorf("failed to delete session link: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete session link: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *SessionLinkRepository) Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionLinkUpdate) (*models.SessionLink, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.OtherSessionID != nil {
+		columns = append(columns, table.SessionLinks.OtherSessionID)
+	}
+	if updates.Kind != nil {
+		columns = append(columns, table.SessionLinks.Kind)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	updateStmt := table.SessionLinks.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(
+			table.SessionLinks.ID.EQ(UUID(id)).
+				AND(table.SessionLinks.CourseSessionID.EQ(UUID(courseSessionID))),
+		).
+		RETURNING(table.SessionLinks.AllColumns)
+
+	var dest model.SessionLinks
+	err := updateStmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update session link", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update session link: %w", err)
+	}
+
+	return toSessionLink(dest), nil
+}
+
+func toSessionLink(d model.SessionLinks) *models.SessionLink {
+	return models.NewSessionLink(d.ID, d.CourseSessionID, d.OtherSessionID, string(d.Kind), d.CreatedAt, d.UpdatedAt)
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ SessionPinRepositoryInterface = (*SessionPinRepository)(nil)
+
+type SessionPinRepositoryInterface interface {
+	Create(ctx context.Context, pin *models.SessionPin) (*models.SessionPin, error)
+	GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionPin, error)
+	GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionPin, error)
+	List(ctx context.Context) ([]*models.SessionPin, error)
+	Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionPinUpdate) (*models.SessionPin, error)
+}
+
+type SessionPinRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewSessionPinRepository(db *sql.DB, logger *zap.Logger) *SessionPinRepository {
+	return &SessionPinRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *SessionPinRepository) Create(ctx context.Context, pin *models.SessionPin) (*models.SessionPin, error) {
+	if pin == nil {
+		return nil, errors.New("session pin cannot be nil")
+	}
+
+	if err := pin.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.SessionPins.
+		INSERT(table.SessionPins.AllColumns.Except(table.SessionPins.CreatedAt, table.SessionPins.UpdatedAt)).
+		MODEL(pin).
+		RETURNING(table.SessionPins.AllColumns)
+
+	var dest model.SessionPins
+	if err := insertStmt.QueryContext(ctx, r.db, &dest); err != nil {
+		r.logger.Error("failed to create session pin", zap.Error(err))
+		return nil, fmt.Errorf("failed to create session pin: %w", err)
+	}
+
+	return toSessionPin(dest), nil
+}
+
+func (r *SessionPinRepository) GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionPin, error) {
+	stmt := table.SessionPins.
+		SELECT(table.SessionPins.AllColumns).
+		WHERE(
+			table.SessionPins.ID.EQ(UUID(id)).
+				AND(table.SessionPins.CourseSessionID.EQ(UUID(courseSessionID))),
+		)
+
+	var dest model.SessionPins
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get session pin", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get session pins: %w", err)
+	}
+
+	return toSessionPin(dest), nil
+}
+
+func (r *SessionPinRepository) GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionPin, error) {
+	stmt := table.SessionPins.
+		SELECT(table.SessionPins.AllColumns).
+		WHERE(table.SessionPins.CourseSessionID.EQ(UUID(courseSessionID))).
+		ORDER_BY(table.SessionPins.Day.ASC(), table.SessionPins.StartTime.ASC())
+
+	var dest []model.SessionPins
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get session pins by course session id", zap.Error(err), zap.String("course_session_id", courseSessionID.String()))
+		return nil, fmt.Errorf("failed to get session pins: %w", err)
+	}
+
+	result := make([]*models.SessionPin, len(dest))
+	for i, d := range dest {
+		result[i] = toSessionPin(d)
+	}
+
+	return result, nil
+}
+
+func (r *SessionPinRepository) List(ctx context.Context) ([]*models.SessionPin, error) {
+	stmt := table.SessionPins.
+		SELECT(table.SessionPins.AllColumns).
+		ORDER_BY(
+			table.SessionPins.CourseSessionID.ASC(),
+			table.SessionPins.Day.ASC(),
+			table.SessionPins.StartTime.ASC(),
+		)
+
+	var dest []model.SessionPins
+	err := stmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list session pins", zap.Error(err))
+		return nil, fmt.Errorf("failed to list session pins: %w", err)
+	}
+
+	result := make([]*models.SessionPin, len(dest))
+	for i, d := range dest {
+		result[i] = toSessionPin(d)
+	}
+
+	return result, nil
+}
+
+func (r *SessionPinRepository) Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error {
+	deleteStmt := table.SessionPins.
+		DELETE().
+		WHERE(
+			table.SessionPins.ID.EQ(UUID(id)).
+				AND(table.SessionPins.CourseSessionID.EQ(UUID(courseSessionID))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, r.db)
+	if err != nil {
+		r.logger.Error("failed to delete session pin", zap.Error(err))
+		return fmt.Errorf("failed to delete session pin: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete session pin: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *SessionPinRepository) Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionPinUpdate) (*models.SessionPin, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.RoomID != nil {
+		columns = append(columns, table.SessionPins.RoomID)
+	}
+	if updates.Day != nil {
+		columns = append(columns, table.SessionPins.Day)
+	}
+	if updates.StartTime != nil {
+		columns = append(columns, table.SessionPins.StartTime)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	updateStmt := table.SessionPins.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(
+			table.SessionPins.ID.EQ(UUID(id)).
+				AND(table.SessionPins.CourseSessionID.EQ(UUID(courseSessionID))),
+		).
+		RETURNING(table.SessionPins.AllColumns)
+
+	var dest model.SessionPins
+	err := updateStmt.QueryContext(ctx, r.db, &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update session pin", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update session pin: %w", err)
+	}
+
+	return toSessionPin(dest), nil
+}
+
+func toSessionPin(d model.SessionPins) *models.SessionPin {
+	return models.NewSessionPin(d.ID, d.CourseSessionID, d.RoomID, d.Day, d.StartTime, d.CreatedAt, d.UpdatedAt)
+}
@@ -0,0 +1,266 @@
+// Package annealing improves a starting timetable with simulated annealing.
+//
+// The search starts from another scheduler's output (normally the greedy scheduler) and
+// repeatedly tries a small change: moving a meeting, swapping two meetings or changing a
+// meeting's room. Changes that break a hard constraint are never kept. Changes that make the
+// timetable worse are kept with a probability that shrinks as the temperature cools, which
+// lets the search back out of early decisions the greedy pass could not revisit.
+package annealing
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/problem"
+)
+
+var _ scheduler.Scheduler = (*AnnealingScheduler)(nil)
+
+// DefaultConfig returns settings that suit a department-sized timetable
+func DefaultConfig() *Config {
+	return &Config{
+		InitialTemperature: 20,
+		CoolingRate:        0.9995,
+		MaxIterations:      20000,
+	}
+}
+
+// Config tunes the annealing search
+type Config struct {
+	// InitialTemperature controls how readily worse timetables are accepted at the start
+	InitialTemperature float64
+
+	// CoolingRate multiplies the temperature after every iteration (between 0 and 1)
+	CoolingRate float64
+
+	// MaxIterations caps the number of changes tried
+	MaxIterations int
+
+	// TimeLimit stops the search early when set. Unlike a canceled context or a passed deadline,
+	// running out of time is a normal end to the search and leaves Output.Status empty.
+	TimeLimit time.Duration
+
+	// Seed makes runs reproducible; the same input and seed give the same timetable
+	Seed int64
+}
+
+// Neighbourhoods tried by the search
+const (
+	moveSession = iota
+	swapSessions
+	changeRoom
+	neighbourhoods
+)
+
+// change sets one meeting's placement
+type change struct {
+	session   int
+	placement problem.Placement
+}
+
+type AnnealingScheduler struct {
+	Initial scheduler.Scheduler
+	Config  *Config
+}
+
+// NewAnnealingScheduler improves on the timetables produced by initial.
+// A nil config uses DefaultConfig.
+func NewAnnealingScheduler(initial scheduler.Scheduler, config *Config) scheduler.Scheduler {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	return &AnnealingScheduler{
+		Initial: initial,
+		Config:  config,
+	}
+}
+
+// Generate improves on the initial scheduler's timetable. When ctx is done, the initial scheduler
+// or the search stops and the best timetable found so far is returned.
+func (s *AnnealingScheduler) Generate(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+	initial, err := s.Initial.Generate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := problem.New(input)
+	current := p.FromOutput(initial)
+	cost := p.Cost(current)
+	broken := p.BrokenLinks(current)
+
+	best := current.Clone()
+	bestCost := cost
+
+	// The initial scheduler has reported its own progress
+	reported := best.Placed()
+
+	rng := rand.New(rand.NewSource(s.Config.Seed))
+	temperature := s.Config.InitialTemperature
+
+	var deadline time.Time
+	if s.Config.TimeLimit > 0 {
+		deadline = time.Now().Add(s.Config.TimeLimit)
+	}
+
+	var status scheduler.Status
+	for iteration := 0; iteration < s.Config.MaxIterations && len(current) > 0; iteration++ {
+		// Checking the clock every iteration is needlessly slow
+		if iteration%100 == 0 {
+			if ctx.Err() != nil {
+				status = scheduler.StopStatus(ctx)
+				break
+			}
+			if !deadline.IsZero() && time.Now().After(deadline) {
+				break
+			}
+		}
+
+		changes := s.propose(p, current, rng)
+		temperature *= s.Config.CoolingRate
+		if len(changes) == 0 {
+			continue
+		}
+
+		previous := s.apply(current, changes)
+
+		// Unplacing an anchor's meetings can leave the meetings linked to it stranded
+		if p.BrokenLinks(current) > broken {
+			s.apply(current, previous)
+			continue
+		}
+
+		newCost := p.Cost(current)
+		delta := newCost - cost
+
+		if delta <= 0 || (temperature > 0 && rng.Float64() < math.Exp(-float64(delta)/temperature)) {
+			cost = newCost
+			if cost < bestCost {
+				if current.Placed() > reported {
+					reported = current.Placed()
+					input.ReportProgress(reported, len(current))
+				}
+				best = current.Clone()
+				bestCost = cost
+			}
+			continue
+		}
+
+		s.apply(current, previous)
+	}
+
+	// The starting timetable depends on the seed the initial scheduler broke ties with
+	output := p.Output(best)
+	output.Seed = initial.Seed
+	output.Status = status
+
+	return output, nil
+}
+
+// propose picks a random neighbouring timetable and returns the changes that reach it,
+// or nil when the chosen change would break a hard constraint
+func (s *AnnealingScheduler) propose(p *problem.Problem, a problem.Assignment, rng *rand.Rand) []change {
+	switch rng.Intn(neighbourhoods) {
+	case moveSession:
+		return s.proposeMove(p, a, rng)
+	case swapSessions:
+		return s.proposeSwap(p, a, rng)
+	default:
+		return s.proposeRoomChange(p, a, rng)
+	}
+}
+
+// proposeMove places a meeting somewhere new, unplacing whatever it would clash with.
+// Unplaced meetings are chosen half the time so failures get plenty of attention.
+func (s *AnnealingScheduler) proposeMove(p *problem.Problem, a problem.Assignment, rng *rand.Rand) []change {
+	i := rng.Intn(len(a))
+	if unplaced := unplacedSessions(a); len(unplaced) > 0 && rng.Intn(2) == 0 {
+		i = unplaced[rng.Intn(len(unplaced))]
+	}
+
+	pl := p.RandomPlacement(i, rng)
+	if !p.Fits(i, pl) {
+		return nil
+	}
+
+	changes := []change{{session: i, placement: pl}}
+	for _, j := range p.Conflicts(a, i, pl) {
+		// Pinned meetings are never displaced
+		if p.Sessions[j].Pin != nil {
+			return nil
+		}
+		changes = append(changes, change{session: j, placement: problem.Unplaced})
+	}
+
+	return changes
+}
+
+// proposeSwap exchanges the rooms and times of two placed meetings
+func (s *AnnealingScheduler) proposeSwap(p *problem.Problem, a problem.Assignment, rng *rand.Rand) []change {
+	i, j := rng.Intn(len(a)), rng.Intn(len(a))
+	if i == j || !a[i].Placed() || !a[j].Placed() {
+		return nil
+	}
+
+	if !p.Fits(i, a[j]) || !p.Fits(j, a[i]) {
+		return nil
+	}
+
+	changes := []change{{session: i, placement: a[j]}, {session: j, placement: a[i]}}
+
+	// Check each side against the timetable as it would be after the swap
+	previous := s.apply(a, changes)
+	valid := len(p.Conflicts(a, i, a[i])) == 0 && len(p.Conflicts(a, j, a[j])) == 0
+	s.apply(a, previous)
+
+	if !valid {
+		return nil
+	}
+
+	return changes
+}
+
+// proposeRoomChange moves a placed meeting to another suitable room at the same time
+func (s *AnnealingScheduler) proposeRoomChange(p *problem.Problem, a problem.Assignment, rng *rand.Rand) []change {
+	i := rng.Intn(len(a))
+	rooms := p.Sessions[i].Rooms
+	if !a[i].Placed() || len(rooms) < 2 {
+		return nil
+	}
+
+	pl := a[i]
+	pl.Room = rooms[rng.Intn(len(rooms))]
+	if pl.Room == a[i].Room || !p.Fits(i, pl) || len(p.Conflicts(a, i, pl)) > 0 {
+		return nil
+	}
+
+	return []change{{session: i, placement: pl}}
+}
+
+// apply makes the changes and returns the changes that undo them
+func (s *AnnealingScheduler) apply(a problem.Assignment, changes []change) []change {
+	undo := make([]change, len(changes))
+
+	for k, c := range changes {
+		undo[len(changes)-1-k] = change{session: c.session, placement: a[c.session]}
+		a[c.session] = c.placement
+	}
+
+	return undo
+}
+
+// unplacedSessions returns the meetings that are not in the timetable
+func unplacedSessions(a problem.Assignment) []int {
+	var unplaced []int
+
+	for i, pl := range a {
+		if !pl.Placed() {
+			unplaced = append(unplaced, i)
+		}
+	}
+
+	return unplaced
+}
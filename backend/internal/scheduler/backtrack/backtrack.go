@@ -0,0 +1,315 @@
+// Package backtrack is an exact scheduler for small problems.
+//
+// It searches every combination of rooms, days and start times with backtracking, keeping the
+// remaining choices for each unplaced meeting up to date as meetings are placed (forward
+// checking) and always placing the meeting with the fewest choices left next. Given enough time
+// it either places every session or proves that no timetable can. Start times are taken from the
+// same grid as the other search-based schedulers (PreferredSlotDuration, or every 15 minutes),
+// so a proof of infeasibility holds for that grid.
+package backtrack
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/problem"
+)
+
+var _ scheduler.Scheduler = (*BacktrackScheduler)(nil)
+
+// DefaultTimeLimit bounds the search when no limit is configured
+const DefaultTimeLimit = 10 * time.Second
+
+// Config tunes the backtracking search
+type Config struct {
+	// TimeLimit bounds the search; the best partial timetable is returned when it runs out, as it
+	// is when the context passed to Generate is done first
+	TimeLimit time.Duration
+}
+
+type BacktrackScheduler struct {
+	Config *Config
+}
+
+// NewBacktrackScheduler returns an exact scheduler. A nil config or zero time limit uses DefaultTimeLimit.
+func NewBacktrackScheduler(config *Config) scheduler.Scheduler {
+	if config == nil {
+		config = &Config{}
+	}
+
+	return &BacktrackScheduler{
+		Config: config,
+	}
+}
+
+// search holds the state of one run
+type search struct {
+	ctx     context.Context
+	input   *scheduler.Input
+	p       *problem.Problem
+	current problem.Assignment
+	domains [][]problem.Placement // remaining choices per meeting
+	nodes   int
+	stopped bool
+
+	best       problem.Assignment
+	bestPlaced int
+}
+
+func (b *BacktrackScheduler) Generate(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+	if err := scheduler.ValidatePins(input); err != nil {
+		return nil, err
+	}
+
+	timeLimit := b.Config.TimeLimit
+	if timeLimit <= 0 {
+		timeLimit = DefaultTimeLimit
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeLimit)
+	defer cancel()
+
+	p := problem.New(input)
+	s := &search{
+		ctx:     ctx,
+		input:   input,
+		p:       p,
+		current: make(problem.Assignment, len(p.Sessions)),
+		domains: make([][]problem.Placement, len(p.Sessions)),
+	}
+
+	// Meetings with nowhere to go at all are left out; the rest can still be placed
+	infeasible := false
+	var open []int
+	for i := range p.Sessions {
+		s.current[i] = problem.Unplaced
+		s.domains[i] = p.Domain(i)
+
+		if len(s.domains[i]) == 0 {
+			infeasible = true
+			continue
+		}
+		open = append(open, i)
+	}
+	s.best = s.current.Clone()
+	input.ReportProgress(0, len(p.Sessions))
+
+	complete := s.solve(open, 0)
+
+	output := p.Output(s.best)
+	switch {
+	case infeasible:
+		output.Status = scheduler.StatusInfeasible
+	case complete:
+		output.Status = scheduler.StatusOptimal
+	case s.stopped:
+		output.Status = scheduler.StopStatus(ctx)
+	default:
+		output.Status = scheduler.StatusInfeasible
+	}
+
+	// A partial timetable may hold meetings placed before their anchor was complete
+	scheduler.EnforceLinks(input, output)
+
+	return output, nil
+}
+
+// solve places the open meetings, returning true once all of them are placed
+func (s *search) solve(open []int, placed int) bool {
+	if len(open) == 0 {
+		return true
+	}
+
+	// Checking the context on every node is needlessly slow
+	s.nodes++
+	if s.nodes%256 == 0 && s.ctx.Err() != nil {
+		s.stopped = true
+	}
+	if s.stopped {
+		return false
+	}
+
+	k := s.mostConstrained(open)
+	i := open[k]
+	rest := slices.Delete(slices.Clone(open), k, k+1)
+
+	for _, pl := range s.orderValues(i) {
+		if !s.symmetryAllows(i, pl) {
+			continue
+		}
+
+		s.current[i] = pl
+		s.record(placed + 1)
+
+		trail, ok := s.forwardCheck(i, pl, rest)
+		if ok && s.solve(rest, placed+1) {
+			return true
+		}
+		s.restore(trail)
+		s.current[i] = problem.Unplaced
+
+		if s.stopped {
+			return false
+		}
+	}
+
+	return false
+}
+
+// record keeps the current timetable when it places more meetings than any seen so far,
+// including placements that forward checking is about to reject
+func (s *search) record(placed int) {
+	if placed > s.bestPlaced {
+		s.best = s.current.Clone()
+		s.bestPlaced = placed
+		s.input.ReportProgress(placed, len(s.p.Sessions))
+	}
+}
+
+// mostConstrained picks the open meeting with the fewest remaining choices,
+// breaking ties by the number of other open meetings it shares people with
+func (s *search) mostConstrained(open []int) int {
+	best := 0
+	bestDegree := -1
+
+	for k, i := range open {
+		size, bestSize := len(s.domains[i]), len(s.domains[open[best]])
+		if size > bestSize {
+			continue
+		}
+
+		degree := 0
+		for _, j := range open {
+			if j != i && s.p.Related(i, j) {
+				degree++
+			}
+		}
+
+		if size < bestSize || degree > bestDegree {
+			best, bestDegree = k, degree
+		}
+	}
+
+	return best
+}
+
+// orderValues tries placements that keep instructors in their preferred windows, spread a
+// course across the week and keep to its day patterns first
+func (s *search) orderValues(i int) []problem.Placement {
+	values := slices.Clone(s.domains[i])
+
+	penalty := func(pl problem.Placement) int {
+		penalty := problem.PreferenceCost*s.p.PreferenceViolations(i, pl) +
+			problem.SameDayCost*s.p.SameDaySiblings(s.current, i, pl.Day)
+		if s.p.OffPattern(s.current, i, pl.Day) {
+			penalty += problem.PatternCost
+		}
+		return penalty
+	}
+	slices.SortStableFunc(values, func(a, b problem.Placement) int {
+		return cmp.Compare(penalty(a), penalty(b))
+	})
+
+	return values
+}
+
+// symmetryAllows keeps interchangeable meetings of the same course session in a fixed order,
+// so the search never tries the same timetable with their placements swapped. Pinned meetings
+// are not interchangeable with the rest.
+func (s *search) symmetryAllows(i int, pl problem.Placement) bool {
+	session := s.p.Sessions[i].CourseSession
+	if s.p.Sessions[i].Pin != nil {
+		return true
+	}
+
+	for j, other := range s.p.Sessions {
+		if j == i || other.CourseSession != session || other.Pin != nil || !s.current[j].Placed() {
+			continue
+		}
+
+		order := comparePlacements(pl, s.current[j])
+		if (j < i && order <= 0) || (j > i && order >= 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// domainChange records a domain before forward checking narrowed it
+type domainChange struct {
+	session int
+	domain  []problem.Placement
+}
+
+// forwardCheck removes choices that clash with meeting i at pl from the open meetings, those on
+// pl's day for meetings its course's cap no longer lets join, and once pl completes the anchor of
+// a link, the choices the link rules out for the meetings following it.
+// It reports false as soon as any open meeting is left with no choices or a placed one breaks a link.
+func (s *search) forwardCheck(i int, pl problem.Placement, open []int) ([]domainChange, bool) {
+	var trail []domainChange
+
+	narrow := func(j int, keep func(problem.Placement) bool) bool {
+		kept := make([]problem.Placement, 0, len(s.domains[j]))
+		for _, candidate := range s.domains[j] {
+			if keep(candidate) {
+				kept = append(kept, candidate)
+			}
+		}
+
+		if len(kept) < len(s.domains[j]) {
+			trail = append(trail, domainChange{session: j, domain: s.domains[j]})
+			s.domains[j] = kept
+		}
+
+		return len(kept) > 0
+	}
+
+	for _, j := range open {
+		full := !s.p.DayAllows(s.current, j, pl.Day)
+		keep := func(candidate problem.Placement) bool {
+			return !s.p.Clashes(i, pl, j, candidate) && !(full && candidate.Day == pl.Day)
+		}
+		if !narrow(j, keep) {
+			return trail, false
+		}
+	}
+
+	// A link is only checked once every meeting of its anchor is placed, as one placed later
+	// could still satisfy it
+	for _, l := range s.p.Anchoring(i) {
+		if slices.ContainsFunc(l.Anchors, func(k int) bool { return slices.Contains(open, k) }) {
+			continue
+		}
+
+		for _, j := range l.Others {
+			switch {
+			case s.current[j].Placed():
+				if !s.p.LinkAllows(l, s.current, s.current[j]) {
+					return trail, false
+				}
+			case slices.Contains(open, j):
+				if !narrow(j, func(candidate problem.Placement) bool { return s.p.LinkAllows(l, s.current, candidate) }) {
+					return trail, false
+				}
+			}
+		}
+	}
+
+	return trail, true
+}
+
+// restore undoes forward checking
+func (s *search) restore(trail []domainChange) {
+	for k := len(trail) - 1; k >= 0; k-- {
+		s.domains[trail[k].session] = trail[k].domain
+	}
+}
+
+// comparePlacements orders placements by day, start time and room
+func comparePlacements(a, b problem.Placement) int {
+	return cmp.Or(cmp.Compare(a.Day, b.Day), cmp.Compare(a.Start, b.Start), cmp.Compare(a.Room, b.Room))
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+// FailureCode identifies a constraint that stops a session from being placed
+type FailureCode string
+
+const (
+	CodeNoRoomsOfType        FailureCode = "no_rooms_of_type"
+	CodeInsufficientCapacity FailureCode = "insufficient_capacity"
+	CodeDurationTooLong      FailureCode = "duration_exceeds_operating_hours"
+	CodeSlotsConsumed        FailureCode = "all_slots_consumed"
+	CodeAllowedWindows       FailureCode = "allowed_windows"
+	CodeInstructorConflict   FailureCode = "instructor_conflict"
+	CodeCohortClash          FailureCode = "cohort_clash"
+	CodeSpreadRule           FailureCode = "spread_rule"
+	CodeLinkConflict         FailureCode = "link_conflict"
+)
+
+// failureReasons gives the FailedSession.Reason reported for each code
+var failureReasons = map[FailureCode]string{
+	CodeNoRoomsOfType:        ReasonNoRoomsOfType,
+	CodeInsufficientCapacity: ReasonInsufficientCapacity,
+	CodeDurationTooLong:      ReasonDurationTooLong,
+	CodeSlotsConsumed:        ReasonNoTimeSlot,
+	CodeAllowedWindows:       ReasonAllowedWindows,
+	CodeInstructorConflict:   ReasonInstructorConflict,
+	CodeCohortClash:          ReasonCohortClash,
+	CodeSpreadRule:           ReasonSpreadRule,
+	CodeLinkConflict:         ReasonLinkConflict,
+}
+
+// Reason returns the human-readable failure reason for the code
+func (c FailureCode) Reason() string {
+	if reason, exists := failureReasons[c]; exists {
+		return reason
+	}
+
+	return ReasonNoTimeSlot
+}
+
+// Diagnosis explains why a session could not be placed
+type Diagnosis struct {
+	// Code is the constraint reported as the Reason, the first of Blocking
+	Code FailureCode `json:"code"`
+
+	// Blocking lists every constraint found to leave the session without a slot, most basic first
+	Blocking []FailureCode `json:"blocking"`
+
+	// RoomsOfType counts the rooms of the required type
+	RoomsOfType int `json:"rooms_of_type"`
+
+	// SubstituteRooms counts the rooms of the substitute types the session may fall back to
+	SubstituteRooms int `json:"substitute_rooms"`
+
+	// SuitableRooms counts the rooms of the required type or its substitutes that seat the
+	// expected enrollment
+	SuitableRooms int `json:"suitable_rooms"`
+
+	// Duration is the length of the session in minutes
+	Duration int `json:"duration"`
+
+	// FreeBlocks is the longest stretch free for a suitable room and everyone attending,
+	// per operating day, when the session gave up
+	FreeBlocks []*FreeBlock `json:"free_blocks,omitempty"`
+}
+
+// FreeBlock is the longest free stretch found on a day, in minutes
+type FreeBlock struct {
+	Day     Day `json:"day"`
+	Minutes int `json:"minutes"`
+}
@@ -0,0 +1,245 @@
+// Package genetic searches for a timetable with a genetic algorithm.
+//
+// Each individual holds one gene per meeting: the day, start time and room it takes place in.
+// Rooms are only ever drawn from the rooms suited to the meeting, so crossover and mutation never
+// produce a room of the wrong type. Fitness adds a heavy penalty for every broken hard constraint
+// to the weighted soft penalties shared by the other search-based schedulers; lower is better.
+// The fittest individual is made valid at the end by dropping meetings that clash or break a link
+// and re-placing them wherever they still fit.
+package genetic
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/problem"
+)
+
+var _ scheduler.Scheduler = (*GeneticScheduler)(nil)
+
+// tournamentSize is the number of individuals compared when picking a parent
+const tournamentSize = 3
+
+// DefaultConfig returns settings that suit a department-sized timetable
+func DefaultConfig() *Config {
+	return &Config{
+		PopulationSize: 60,
+		Generations:    200,
+		MutationRate:   0.05,
+	}
+}
+
+// Config tunes the genetic search
+type Config struct {
+	// PopulationSize is the number of timetables in each generation
+	PopulationSize int
+
+	// Generations caps the number of generations bred after the first
+	Generations int
+
+	// MutationRate is the chance of each gene being changed in a child (between 0 and 1)
+	MutationRate float64
+
+	// Seed makes runs reproducible; the same input and seed give the same timetable
+	Seed int64
+}
+
+type GeneticScheduler struct {
+	Config *Config
+}
+
+// NewGeneticScheduler returns a genetic algorithm scheduler. A nil config uses DefaultConfig.
+func NewGeneticScheduler(config *Config) scheduler.Scheduler {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	return &GeneticScheduler{
+		Config: config,
+	}
+}
+
+// individual is one candidate timetable and its fitness
+type individual struct {
+	genes      problem.Assignment
+	fitness    int
+	violations int
+}
+
+// Generate breeds the configured number of generations. When ctx is done it stops after the
+// current generation and returns the fittest timetable so far, made valid.
+func (g *GeneticScheduler) Generate(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+	if err := scheduler.ValidatePins(input); err != nil {
+		return nil, err
+	}
+
+	p := problem.New(input)
+	rng := rand.New(rand.NewSource(g.Config.Seed))
+	size := max(g.Config.PopulationSize, 1)
+
+	population := make([]individual, size)
+	for k := range population {
+		genes := make(problem.Assignment, len(p.Sessions))
+		for i := range genes {
+			genes[i] = p.RandomPlacement(i, rng)
+		}
+		population[k] = g.evaluate(p, genes)
+	}
+
+	best := fittest(population)
+	history := []int{best.fitness}
+	reported := best.kept()
+	input.ReportProgress(reported, len(p.Sessions))
+
+	var status scheduler.Status
+	for generation := 0; generation < g.Config.Generations; generation++ {
+		if ctx.Err() != nil {
+			status = scheduler.StopStatus(ctx)
+			break
+		}
+
+		// The fittest timetable always survives unchanged
+		next := []individual{best}
+		for len(next) < size {
+			mother := g.tournament(population, rng)
+			father := g.tournament(population, rng)
+			next = append(next, g.evaluate(p, g.breed(p, mother.genes, father.genes, rng)))
+		}
+
+		population = next
+		best = fittest(population)
+		history = append(history, best.fitness)
+		if best.kept() > reported {
+			reported = best.kept()
+			input.ReportProgress(reported, len(p.Sessions))
+		}
+	}
+
+	legal := g.legalise(p, best.genes)
+	input.ReportProgress(legal.Placed(), len(p.Sessions))
+
+	output := p.Output(legal)
+	output.FitnessHistory = history
+	output.Status = status
+
+	return output, nil
+}
+
+// evaluate scores genes; every broken hard constraint costs more than leaving a meeting out
+func (g *GeneticScheduler) evaluate(p *problem.Problem, genes problem.Assignment) individual {
+	violations := p.Violations(genes)
+
+	return individual{
+		genes:      genes,
+		fitness:    problem.ViolationCost*violations + p.Cost(genes),
+		violations: violations,
+	}
+}
+
+// kept estimates how many meetings legalising the individual keeps. Without links it is a lower
+// bound, as every meeting legalise drops breaks a hard constraint of its own; a meeting following
+// a link can also be dropped when only some of its anchor's meetings are kept.
+func (ind individual) kept() int {
+	return max(ind.genes.Placed()-ind.violations, 0)
+}
+
+// tournament picks the fittest of a few random individuals
+func (g *GeneticScheduler) tournament(population []individual, rng *rand.Rand) individual {
+	winner := population[rng.Intn(len(population))]
+
+	for range tournamentSize - 1 {
+		challenger := population[rng.Intn(len(population))]
+		if challenger.fitness < winner.fitness {
+			winner = challenger
+		}
+	}
+
+	return winner
+}
+
+// breed takes each gene from either parent (uniform crossover) and then mutates the child.
+// Parents hold genes for the same meetings in the same order, so every room stays suitable.
+func (g *GeneticScheduler) breed(p *problem.Problem, mother, father problem.Assignment, rng *rand.Rand) problem.Assignment {
+	child := make(problem.Assignment, len(mother))
+
+	for i := range child {
+		child[i] = mother[i]
+		if rng.Intn(2) == 0 {
+			child[i] = father[i]
+		}
+
+		if rng.Float64() < g.Config.MutationRate {
+			child[i] = mutate(p, i, child[i], rng)
+		}
+	}
+
+	return child
+}
+
+// mutate changes one of a gene's day, start time or room
+func mutate(p *problem.Problem, i int, pl problem.Placement, rng *rand.Rand) problem.Placement {
+	random := p.RandomPlacement(i, rng)
+	if !pl.Placed() || !random.Placed() {
+		return random
+	}
+
+	switch rng.Intn(3) {
+	case 0:
+		pl.Day = random.Day
+	case 1:
+		pl.Start = random.Start
+	default:
+		pl.Room = random.Room
+	}
+
+	return pl
+}
+
+// legalise turns genes into a valid timetable. Pinned meetings are kept first, then the rest in
+// order while they fit alongside those already kept; the others move to the first placement that
+// still fits, or stay out.
+func (g *GeneticScheduler) legalise(p *problem.Problem, genes problem.Assignment) problem.Assignment {
+	a := make(problem.Assignment, len(genes))
+	order := make([]int, 0, len(genes))
+	for i := range a {
+		a[i] = problem.Unplaced
+		if p.Sessions[i].Pin != nil {
+			order = append(order, i)
+		}
+	}
+	for i := range a {
+		if p.Sessions[i].Pin == nil {
+			order = append(order, i)
+		}
+	}
+
+	var dropped []int
+	for _, i := range order {
+		pl := genes[i]
+		if pl.Placed() && p.Fits(i, pl) && len(p.Conflicts(a, i, pl)) == 0 {
+			a[i] = pl
+			continue
+		}
+		dropped = append(dropped, i)
+	}
+
+	for _, i := range dropped {
+		for _, pl := range p.Domain(i) {
+			if len(p.Conflicts(a, i, pl)) == 0 {
+				a[i] = pl
+				break
+			}
+		}
+	}
+
+	return a
+}
+
+// fittest returns the individual with the lowest fitness, the earliest on ties
+func fittest(population []individual) individual {
+	return slices.MinFunc(population, func(a, b individual) int {
+		return a.fitness - b.fitness
+	})
+}
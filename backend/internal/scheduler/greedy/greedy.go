@@ -1,7 +1,13 @@
 package greedy
 
 import (
+	"cmp"
+	"context"
+	"math/rand"
 	"slices"
+	"strings"
+
+	"github.com/google/uuid"
 
 	"github.com/TerrenceMurray/course-scheduler/internal/models"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
@@ -14,108 +20,338 @@ type GreedyScheduler struct {
 	WeightStrategy weight.WeightStrategyInterface
 }
 
+// resourceConstraint is a set of people attending a session who can only be in one place at a time
+type resourceConstraint struct {
+	availability scheduler.Availability
+	ids          []uuid.UUID
+	code         scheduler.FailureCode // reported when this resource is what blocks the session
+	travel       *travelPlan           // nil when the resource never moves between buildings
+}
+
+// travelPlan remembers where each resource is booked so moves between buildings leave time to travel
+type travelPlan struct {
+	minutes  map[uuid.UUID]map[uuid.UUID]int // minutes[from][to]
+	bookings map[uuid.UUID][]booking
+}
+
+// sessionLinks are the links a session must satisfy, with where each anchor has been placed so far
+type sessionLinks struct {
+	links  []*models.SessionLink
+	placed map[uuid.UUID][]*models.ScheduledSession // course session -> its scheduled meetings
+}
+
+// booking is a placed session attended by a resource
+type booking struct {
+	day        int
+	start, end int
+	building   uuid.UUID
+}
+
 func NewGreedyScheduler(weightStrategy weight.WeightStrategyInterface) scheduler.Scheduler {
 	return &GreedyScheduler{
 		WeightStrategy: weightStrategy,
 	}
 }
 
-func (g *GreedyScheduler) Generate(input *scheduler.Input) (*scheduler.Output, error) {
+// Generate places the sessions one course at a time. When ctx is done it stops before the next
+// session and returns the meetings placed so far, reporting only the failures already found.
+func (g *GreedyScheduler) Generate(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 	// Use default config if not provided
 	config := input.Config
 	if config == nil {
 		config = scheduler.DefaultConfig()
 	}
 
-	// Initialize availability for all rooms based on config
-	availability := g.initAvailability(input.Rooms, config)
+	if err := config.RoomSelection.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := scheduler.ValidatePins(input); err != nil {
+		return nil, err
+	}
+
+	// Initialize availability for all rooms based on config, minus their blackout windows
+	availability := g.initAvailability(input.Rooms, input.RoomUnavailability, config)
+
+	// Track instructors and cohorts alongside rooms so nobody is booked twice at the same time
+	sessionInstructors := g.instructorsBySession(input.InstructorAssignments)
+	instructorAvailability := g.initResourceAvailability(g.instructorIDs(input.InstructorAssignments), config)
+	g.removeUnavailableWindows(instructorAvailability, input.InstructorAvailability)
+
+	// Preferred teaching windows are favoured but may be violated when nothing else fits
+	preferredWindows := g.preferredWindows(input.InstructorAvailability)
+
+	// Course sessions only meet inside their allowed windows; their preferred ones are favoured
+	// session by session
+	allowedSessionWindows := g.sessionWindows(input.CourseSessions, func(cs *models.CourseSession) []models.SessionWindow { return cs.AllowedWindows })
+
+	courseCohorts := g.cohortsByCourse(input.Cohorts)
+	cohortAvailability := g.initResourceAvailability(g.cohortIDs(input.Cohorts), config)
+
+	// Instructors and cohorts need time to walk between buildings
+	travelMinutes := g.travelMinutes(input.TravelTimes)
+	instructorTravel := &travelPlan{minutes: travelMinutes, bookings: make(map[uuid.UUID][]booking)}
+	cohortTravel := &travelPlan{minutes: travelMinutes, bookings: make(map[uuid.UUID][]booking)}
+
+	// Ties are broken in a seeded random order when a seed is configured
+	var rng *rand.Rand
+	if config.Seed != nil {
+		rng = rand.New(rand.NewSource(*config.Seed))
+	}
 
 	// Calculate and sort course weights (descending)
-	courseWeights := g.calculateWeights(input.Courses, input.CourseSessions)
-	g.sortWeightsByDescending(courseWeights)
+	courseWeights := g.calculateWeights(input)
+	g.sortWeightsByDescending(courseWeights, rng)
 
-	// Get sessions ordered by course weight
+	// Get sessions ordered by course weight, with linked sessions after their anchors
 	orderedSessions := g.getSessionsByWeightedCourses(courseWeights, input.CourseSessions)
+	orderedSessions = g.orderByLinks(orderedSessions, input.Links)
+	linksByOther := g.linksByOther(input.Links)
+
+	// Index courses so each session can resolve its expected enrollment
+	coursesByID := make(map[uuid.UUID]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID] = course
+		}
+	}
 
 	// Track days used per course (to spread sessions across days)
 	courseDaysUsed := make(map[string][]int)
 
+	// Track room bookings for the room selection policy
+	selectRooms := roomPolicies[config.RoomSelection]
+	usage := newRoomUsage()
+
 	var scheduledSessions []*models.ScheduledSession
 	var failedSessions []*scheduler.FailedSession
+	preferenceViolations := 0
+	placed := make(map[uuid.UUID][]*models.ScheduledSession)
+
+	// Reserve pinned placements before anything else so they consume availability
+	sessionsByID := make(map[uuid.UUID]*models.CourseSession, len(input.CourseSessions))
+	for _, session := range input.CourseSessions {
+		if session != nil {
+			sessionsByID[session.ID] = session
+		}
+	}
+	roomsByID := make(map[uuid.UUID]*models.Room, len(input.Rooms))
+	for _, room := range input.Rooms {
+		if room != nil {
+			roomsByID[room.ID] = room
+		}
+	}
+
+	pinned := make(map[uuid.UUID]int)
+	for _, pin := range input.Pins {
+		if pin == nil {
+			continue
+		}
+
+		session, room := sessionsByID[pin.CourseSessionID], roomsByID[pin.RoomID]
+		day, start := int(pin.Day), int(pin.StartTime)
+		end := start + int(*session.Duration)
+
+		resources := []resourceConstraint{
+			{availability: instructorAvailability, ids: sessionInstructors[session.ID], travel: instructorTravel},
+			{availability: cohortAvailability, ids: courseCohorts[session.CourseID], travel: cohortTravel},
+		}
+		preferences := []resourceConstraint{
+			{availability: preferredWindows, ids: g.instructorsWithPreferences(sessionInstructors[session.ID], preferredWindows)},
+		}
+
+		consumeEnd := end + config.MinBreakBetweenSessions
+		availability[room.ID.String()][day] = g.consumeSlot(availability[room.ID.String()][day], start, consumeEnd)
+		g.consumeResources(resources, day, start, consumeEnd)
+		g.bookResources(resources, day, start, end, room.Building)
+		preferenceViolations += g.countPreferenceViolations(preferences, day, start, end) + session.PreferenceMiss(day, start, end)
+
+		courseKeys := g.spreadKeys(session)
+		for _, key := range courseKeys {
+			courseDaysUsed[key] = append(courseDaysUsed[key], day)
+		}
+		usage.book(room, courseKeys, end-start)
+		pinned[session.ID]++
+
+		scheduled := &models.ScheduledSession{
+			CourseID:        session.CourseID,
+			CourseSessionID: session.ID,
+			SectionID:       session.SectionID,
+			RoomID:          room.ID,
+			Day:             day,
+			StartTime:       start,
+			EndTime:         end,
+			Substituted:     room.Type != session.RequiredRoom,
+		}
+		scheduledSessions = append(scheduledSessions, scheduled)
+		placed[session.ID] = append(placed[session.ID], scheduled)
+	}
+
+	total := input.Meetings()
+	input.ReportProgress(len(scheduledSessions), total)
 
 	// Schedule each session
+	var status scheduler.Status
 	for _, session := range orderedSessions {
-		sessionsToPlace := int(*session.NumberOfSessions)
-		courseKey := session.CourseID.String()
+		if ctx.Err() != nil {
+			status = scheduler.StopStatus(ctx)
+			break
+		}
+
+		sessionsToPlace := int(*session.NumberOfSessions) - pinned[session.ID]
+		if sessionsToPlace <= 0 {
+			continue
+		}
+		courseKeys := g.spreadKeys(session)
+
+		// Only rooms of the required type or its substitutes that can seat the expected enrollment are
+		// candidates, in tiers: the required type first, then each substitute in order of preference
+		enrollment := g.sessionEnrollment(session, coursesByID[session.CourseID])
+		var roomsOfType, candidateRooms []*models.Room
+		var tiers [][]*models.Room
+		for _, roomType := range scheduler.SubstituteChain(input.RoomTypes, session.RequiredRoom) {
+			ofType := g.roomsByType(input.Rooms, roomType)
+			rooms := g.roomsByCapacity(ofType, enrollment, rng)
+			roomsOfType = append(roomsOfType, ofType...)
+			candidateRooms = append(candidateRooms, rooms...)
+			if len(rooms) > 0 {
+				tiers = append(tiers, rooms)
+			}
+		}
+
+		// The session's allowed windows and everyone attending must be free, checked in this order
+		// when diagnosing failures
+		resources := []resourceConstraint{
+			{availability: allowedSessionWindows, ids: g.withWindows(session.ID, allowedSessionWindows), code: scheduler.CodeAllowedWindows},
+			{availability: instructorAvailability, ids: sessionInstructors[session.ID], code: scheduler.CodeInstructorConflict, travel: instructorTravel},
+			{availability: cohortAvailability, ids: courseCohorts[session.CourseID], code: scheduler.CodeCohortClash, travel: cohortTravel},
+		}
+
+		// Look inside every assigned instructor's and the session's own preferred windows first,
+		// the session's heaviest windows before lighter ones, then anywhere
+		preferences := []resourceConstraint{
+			{availability: preferredWindows, ids: g.instructorsWithPreferences(sessionInstructors[session.ID], preferredWindows)},
+		}
+		passes := g.preferencePasses(session, preferences)
 
-		// Initialize days used for this course if not exists
-		if _, exists := courseDaysUsed[courseKey]; !exists {
-			courseDaysUsed[courseKey] = []int{}
+		// Links narrow where the session may go relative to its anchors placed so far
+		links := sessionLinks{links: linksByOther[session.ID], placed: placed}
+		spread := !g.followsAnchorDays(links.links)
+		rules := config.SpreadFor(session)
+
+		if len(roomsOfType) > 0 && len(candidateRooms) == 0 {
+			failedSessions = append(failedSessions, g.failure(session, availability, roomsOfType, candidateRooms, config, resources, links))
+			continue
 		}
 
 		for sessionsToPlace > 0 {
-			// Sort days by availability for the required room type
-			candidateDays := g.sortDaysByAvailability(availability, input.Rooms, session.RequiredRoom, config)
 			sessionPlaced := false
 
-			for _, day := range candidateDays {
+			// Substitute room types are only tried once no room of the required type has a slot
+			for _, tierRooms := range tiers {
 				if sessionPlaced {
 					break
 				}
 
-				// Try to spread sessions across different days for the same course
-				// Skip this day if already used and there are enough remaining days
-				if slices.Contains(courseDaysUsed[courseKey], day) && sessionsToPlace <= (5-len(courseDaysUsed[courseKey])) {
-					continue
-				}
+				// Sort days by availability of the candidate rooms
+				candidateDays := g.sortDaysByAvailability(availability, tierRooms, config, rng)
+				candidateDays = g.patternFirst(candidateDays, rules.DayPatterns, int(*session.NumberOfSessions), placed[session.ID])
+				orderedRooms := selectRooms(tierRooms, input.Rooms, usage, courseKeys[0])
 
-				// Try each room of the required type
-				for _, room := range g.roomsByType(input.Rooms, session.RequiredRoom) {
-					start, found := g.findFirstAvailableSlot(availability[room.ID.String()][day], int(*session.Duration), config)
-
-					if found {
-						end := start + int(*session.Duration)
-
-						// Consume the slot (including break time after)
-						consumeEnd := end + config.MinBreakBetweenSessions
-						availability[room.ID.String()][day] = g.consumeSlot(availability[room.ID.String()][day], start, consumeEnd)
-						courseDaysUsed[courseKey] = append(courseDaysUsed[courseKey], day)
-
-						// Add to scheduled sessions
-						scheduledSessions = append(scheduledSessions, &models.ScheduledSession{
-							CourseID:  session.CourseID,
-							RoomID:    room.ID,
-							Day:       day,
-							StartTime: start,
-							EndTime:   end,
-						})
-
-						sessionsToPlace--
-						sessionPlaced = true
+				for _, pass := range passes {
+					if sessionPlaced {
 						break
 					}
+
+					for _, day := range candidateDays {
+						if sessionPlaced {
+							break
+						}
+
+						// Spread sessions of the same course across the week by its spreading rules,
+						// unless a link ties the session to the days its anchor meets. A combined
+						// session group counts against every course it serves.
+						if spread && slices.ContainsFunc(courseKeys, func(key string) bool {
+							return !g.spreadAllows(courseDaysUsed[key], day, sessionsToPlace, rules, len(config.Days()))
+						}) {
+							continue
+						}
+
+						// Try each candidate room in the order the room selection policy gives
+						for _, room := range orderedRooms {
+							// A slot must be free for the room and every attending instructor and cohort
+							ranges := g.freeRanges(availability[room.ID.String()][day], day, room.Building, resources)
+							if pass != nil {
+								ranges = g.freeRanges(ranges, day, room.Building, pass)
+							}
+							ranges = g.linkRanges(ranges, day, room, int(*session.Duration), links, config)
+
+							start, found := g.findFirstAvailableSlot(ranges, int(*session.Duration), config)
+
+							if found {
+								end := start + int(*session.Duration)
+
+								// Consume the slot (including break time after)
+								consumeEnd := end + config.MinBreakBetweenSessions
+								availability[room.ID.String()][day] = g.consumeSlot(availability[room.ID.String()][day], start, consumeEnd)
+								g.consumeResources(resources, day, start, consumeEnd)
+								g.bookResources(resources, day, start, end, room.Building)
+								for _, key := range courseKeys {
+									courseDaysUsed[key] = append(courseDaysUsed[key], day)
+								}
+								usage.book(room, courseKeys, end-start)
+								preferenceViolations += g.countPreferenceViolations(preferences, day, start, end) + session.PreferenceMiss(day, start, end)
+
+								// Add to scheduled sessions
+								scheduled := &models.ScheduledSession{
+									CourseID:        session.CourseID,
+									CourseSessionID: session.ID,
+									SectionID:       session.SectionID,
+									RoomID:          room.ID,
+									Day:             day,
+									StartTime:       start,
+									EndTime:         end,
+									Substituted:     room.Type != session.RequiredRoom,
+								}
+								scheduledSessions = append(scheduledSessions, scheduled)
+								placed[session.ID] = append(placed[session.ID], scheduled)
+								input.ReportProgress(len(scheduledSessions), total)
+
+								sessionsToPlace--
+								sessionPlaced = true
+								break
+							}
+						}
+					}
 				}
 			}
 
 			// If we tried all days and couldn't place the session, mark as failed
 			if !sessionPlaced {
-				failedSessions = append(failedSessions, &scheduler.FailedSession{
-					CourseSession: session,
-					Reason:        "no available time slot found",
-				})
+				failedSessions = append(failedSessions, g.failure(session, availability, roomsOfType, candidateRooms, config, resources, links))
 				break
 			}
 		}
 	}
 
-	return &scheduler.Output{
-		ScheduledSessions: scheduledSessions,
-		Failures:          failedSessions,
-	}, nil
+	output := &scheduler.Output{
+		ScheduledSessions:    scheduledSessions,
+		Failures:             failedSessions,
+		PreferenceViolations: preferenceViolations,
+		Status:               status,
+		Seed:                 config.Seed,
+	}
+
+	// Linked sessions are placed after their anchors, so only cycles of links and pinned
+	// meetings linked to sessions placed later can still break a link here
+	scheduler.EnforceLinks(input, output)
+
+	return output, nil
 }
 
-// initAvailability creates initial availability slots for all rooms based on config
-func (g *GreedyScheduler) initAvailability(rooms []*models.Room, config *scheduler.Config) scheduler.Availability {
+// initAvailability creates initial availability slots for all rooms from each operating day's
+// open hours and removes each room's weekly unavailability
+func (g *GreedyScheduler) initAvailability(rooms []*models.Room, unavailability []*models.RoomUnavailability, config *scheduler.Config) scheduler.Availability {
 	availability := make(scheduler.Availability)
 
 	for _, room := range rooms {
@@ -125,26 +361,263 @@ func (g *GreedyScheduler) initAvailability(rooms []*models.Room, config *schedul
 
 		availability[room.ID.String()] = make(map[int][]scheduler.TimeRange)
 
-		for _, day := range config.OperatingDays {
-			availability[room.ID.String()][int(day)] = []scheduler.TimeRange{config.OperatingHours}
+		for _, day := range config.Days() {
+			availability[room.ID.String()][int(day)] = config.Hours(day)
+		}
+	}
+
+	for _, blackout := range unavailability {
+		if blackout == nil {
+			continue
+		}
+
+		roomAvail, exists := availability[blackout.RoomID.String()]
+		if !exists {
+			continue
+		}
+
+		day := int(blackout.Day)
+		if ranges, open := roomAvail[day]; open {
+			roomAvail[day] = g.consumeSlot(ranges, int(blackout.StartTime), int(blackout.EndTime))
+		}
+	}
+
+	return availability
+}
+
+// initResourceAvailability creates initial availability slots for people (instructors, cohorts) based on config
+func (g *GreedyScheduler) initResourceAvailability(ids []uuid.UUID, config *scheduler.Config) scheduler.Availability {
+	availability := make(scheduler.Availability)
+
+	for _, id := range ids {
+		availability[id.String()] = make(map[int][]scheduler.TimeRange)
+
+		for _, day := range config.Days() {
+			availability[id.String()][int(day)] = config.Hours(day)
 		}
 	}
 
 	return availability
 }
 
+// removeUnavailableWindows removes instructors' hard unavailability from their starting availability
+func (g *GreedyScheduler) removeUnavailableWindows(availability scheduler.Availability, windows []*models.InstructorAvailability) {
+	for _, window := range windows {
+		if window == nil || window.Kind != models.AvailabilityUnavailable {
+			continue
+		}
+
+		instructorAvail, exists := availability[window.InstructorID.String()]
+		if !exists {
+			continue
+		}
+
+		day := int(window.Day)
+		if ranges, open := instructorAvail[day]; open {
+			instructorAvail[day] = g.consumeSlot(ranges, int(window.StartTime), int(window.EndTime))
+		}
+	}
+}
+
+// preferredWindows collects each instructor's preferred windows per day, sorted and merged
+func (g *GreedyScheduler) preferredWindows(windows []*models.InstructorAvailability) scheduler.Availability {
+	preferred := make(scheduler.Availability)
+
+	for _, window := range windows {
+		if window == nil || window.Kind != models.AvailabilityPreferred {
+			continue
+		}
+
+		key := window.InstructorID.String()
+		if _, exists := preferred[key]; !exists {
+			preferred[key] = make(map[int][]scheduler.TimeRange)
+		}
+
+		day := int(window.Day)
+		preferred[key][day] = append(preferred[key][day], scheduler.TimeRange{Start: int(window.StartTime), End: int(window.EndTime)})
+	}
+
+	for _, days := range preferred {
+		for day, ranges := range days {
+			days[day] = g.mergeRanges(ranges)
+		}
+	}
+
+	return preferred
+}
+
+// instructorsWithPreferences keeps the instructors that have recorded at least one preferred window
+func (g *GreedyScheduler) instructorsWithPreferences(instructorIDs []uuid.UUID, preferred scheduler.Availability) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(instructorIDs))
+
+	for _, id := range instructorIDs {
+		if _, exists := preferred[id.String()]; exists {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// countPreferenceViolations counts the instructors whose preferred windows do not cover the placed session
+func (g *GreedyScheduler) countPreferenceViolations(preferences []resourceConstraint, day, start, end int) int {
+	violations := 0
+
+	for _, preference := range preferences {
+		for _, id := range preference.ids {
+			covered := slices.ContainsFunc(preference.availability[id.String()][day], func(r scheduler.TimeRange) bool {
+				return r.Start <= start && end <= r.End
+			})
+			if !covered {
+				violations++
+			}
+		}
+	}
+
+	return violations
+}
+
+// sessionWindows collects the given windows of each course session per day, sorted and merged.
+// A window without a day applies on every day.
+func (g *GreedyScheduler) sessionWindows(sessions []*models.CourseSession, windowsOf func(*models.CourseSession) []models.SessionWindow) scheduler.Availability {
+	windows := make(scheduler.Availability)
+
+	for _, session := range sessions {
+		if session == nil || len(windowsOf(session)) == 0 {
+			continue
+		}
+
+		days := make(map[int][]scheduler.TimeRange)
+		for _, w := range windowsOf(session) {
+			r := scheduler.TimeRange{Start: int(w.StartTime), End: int(w.EndTime)}
+			if w.Day != nil {
+				days[int(*w.Day)] = append(days[int(*w.Day)], r)
+				continue
+			}
+			for day := range int(scheduler.Sunday) + 1 {
+				days[day] = append(days[day], r)
+			}
+		}
+
+		for day, ranges := range days {
+			days[day] = g.mergeRanges(ranges)
+		}
+		windows[session.ID.String()] = days
+	}
+
+	return windows
+}
+
+// preferencePasses lists the windows to look inside, in turn, before looking anywhere: the
+// instructors' preferred windows together with the session's preferred windows of each weight or
+// heavier, heaviest first. The last pass is nil and looks anywhere.
+func (g *GreedyScheduler) preferencePasses(session *models.CourseSession, preferences []resourceConstraint) [][]resourceConstraint {
+	var weights []int
+	for _, w := range session.PreferredWindows {
+		weights = append(weights, w.PreferenceWeight())
+	}
+	slices.Sort(weights)
+	weights = slices.Compact(weights)
+	slices.Reverse(weights)
+
+	var passes [][]resourceConstraint
+	for _, least := range weights {
+		windows := g.sessionWindows([]*models.CourseSession{session}, func(cs *models.CourseSession) []models.SessionWindow {
+			return slices.DeleteFunc(slices.Clone(cs.PreferredWindows), func(w models.SessionWindow) bool { return w.PreferenceWeight() < least })
+		})
+		pass := append(slices.Clone(preferences), resourceConstraint{availability: windows, ids: []uuid.UUID{session.ID}})
+		passes = append(passes, pass)
+	}
+
+	if len(passes) == 0 && slices.ContainsFunc(preferences, func(p resourceConstraint) bool { return len(p.ids) > 0 }) {
+		passes = append(passes, preferences)
+	}
+
+	return append(passes, nil)
+}
+
+// withWindows returns the course session as the only resource bound by the windows, or none
+// when it has no windows of that kind
+func (g *GreedyScheduler) withWindows(sessionID uuid.UUID, windows scheduler.Availability) []uuid.UUID {
+	if _, exists := windows[sessionID.String()]; !exists {
+		return nil
+	}
+
+	return []uuid.UUID{sessionID}
+}
+
+// instructorIDs returns the distinct instructors that appear in the assignments
+func (g *GreedyScheduler) instructorIDs(assignments []*models.InstructorAssignment) []uuid.UUID {
+	result := make([]uuid.UUID, 0)
+
+	for _, assignment := range assignments {
+		if assignment != nil && !slices.Contains(result, assignment.InstructorID) {
+			result = append(result, assignment.InstructorID)
+		}
+	}
+
+	return result
+}
+
+// instructorsBySession groups assigned instructor IDs by course session
+func (g *GreedyScheduler) instructorsBySession(assignments []*models.InstructorAssignment) map[uuid.UUID][]uuid.UUID {
+	result := make(map[uuid.UUID][]uuid.UUID)
+
+	for _, assignment := range assignments {
+		if assignment == nil {
+			continue
+		}
+
+		if !slices.Contains(result[assignment.CourseSessionID], assignment.InstructorID) {
+			result[assignment.CourseSessionID] = append(result[assignment.CourseSessionID], assignment.InstructorID)
+		}
+	}
+
+	return result
+}
+
+// cohortIDs returns the IDs of all cohorts
+func (g *GreedyScheduler) cohortIDs(cohorts []*models.Cohort) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(cohorts))
+
+	for _, cohort := range cohorts {
+		if cohort != nil {
+			result = append(result, cohort.ID)
+		}
+	}
+
+	return result
+}
+
+// cohortsByCourse groups cohort IDs by the courses they take
+func (g *GreedyScheduler) cohortsByCourse(cohorts []*models.Cohort) map[uuid.UUID][]uuid.UUID {
+	result := make(map[uuid.UUID][]uuid.UUID)
+
+	for _, cohort := range cohorts {
+		if cohort == nil {
+			continue
+		}
+
+		for _, courseID := range cohort.CourseIDs {
+			result[courseID] = append(result[courseID], cohort.ID)
+		}
+	}
+
+	return result
+}
+
 // calculateWeights computes the scheduling weight for each course
-func (g *GreedyScheduler) calculateWeights(courses []*models.Course, sessions []*models.CourseSession) []*weight.CourseWeight {
-	courseWeights := make([]*weight.CourseWeight, 0, len(courses))
+func (g *GreedyScheduler) calculateWeights(input *scheduler.Input) []*weight.CourseWeight {
+	courseWeights := make([]*weight.CourseWeight, 0, len(input.Courses))
 
-	for _, course := range courses {
+	for _, course := range input.Courses {
 		if course == nil {
 			continue
 		}
 
 		// Filter sessions for this course
 		courseSessions := make([]*models.CourseSession, 0)
-		for _, session := range sessions {
+		for _, session := range input.CourseSessions {
 			if session != nil && session.CourseID == course.ID {
 				courseSessions = append(courseSessions, session)
 			}
@@ -152,17 +625,22 @@ func (g *GreedyScheduler) calculateWeights(courses []*models.Course, sessions []
 
 		courseWeights = append(courseWeights, &weight.CourseWeight{
 			Course: course,
-			Weight: g.WeightStrategy.Calculate(courseSessions),
+			Weight: g.WeightStrategy.Calculate(course, courseSessions, input),
 		})
 	}
 
 	return courseWeights
 }
 
-// sortWeightsByDescending sorts course weights in descending order (highest weight first)
-func (g *GreedyScheduler) sortWeightsByDescending(weights []*weight.CourseWeight) {
-	slices.SortFunc(weights, func(a, b *weight.CourseWeight) int {
-		return b.Weight - a.Weight
+// sortWeightsByDescending sorts course weights in descending order (highest weight first).
+// Equal weights are ordered by course name, then ID, or shuffled by rng when it is set.
+func (g *GreedyScheduler) sortWeightsByDescending(weights []*weight.CourseWeight, rng *rand.Rand) {
+	shuffle(weights, rng)
+	slices.SortStableFunc(weights, func(a, b *weight.CourseWeight) int {
+		if order := b.Weight - a.Weight; order != 0 || rng != nil {
+			return order
+		}
+		return cmp.Or(strings.Compare(a.Course.Name, b.Course.Name), strings.Compare(a.Course.ID.String(), b.Course.ID.String()))
 	})
 }
 
@@ -181,26 +659,205 @@ func (g *GreedyScheduler) getSessionsByWeightedCourses(weights []*weight.CourseW
 	return ordered
 }
 
-// sortDaysByAvailability returns days sorted by total availability for a room type (descending)
-func (g *GreedyScheduler) sortDaysByAvailability(availability scheduler.Availability, rooms []*models.Room, roomType string, config *scheduler.Config) []int {
-	roomsOfType := g.roomsByType(rooms, roomType)
+// orderByLinks moves each linked session after its anchors so anchors are placed first, otherwise
+// keeping the given order. When links form a cycle, the first session left in it goes next.
+func (g *GreedyScheduler) orderByLinks(sessions []*models.CourseSession, links []*models.SessionLink) []*models.CourseSession {
+	if len(links) == 0 {
+		return sessions
+	}
+
+	anchors := make(map[uuid.UUID][]uuid.UUID)
+	for _, link := range links {
+		if link != nil {
+			anchors[link.OtherSessionID] = append(anchors[link.OtherSessionID], link.CourseSessionID)
+		}
+	}
+
+	pending := make(map[uuid.UUID]bool, len(sessions))
+	for _, session := range sessions {
+		pending[session.ID] = true
+	}
+
+	remaining := slices.Clone(sessions)
+	ordered := make([]*models.CourseSession, 0, len(sessions))
+	for len(remaining) > 0 {
+		next := slices.IndexFunc(remaining, func(session *models.CourseSession) bool {
+			return !slices.ContainsFunc(anchors[session.ID], func(id uuid.UUID) bool { return pending[id] })
+		})
+		if next < 0 {
+			next = 0
+		}
+
+		ordered = append(ordered, remaining[next])
+		delete(pending, remaining[next].ID)
+		remaining = slices.Delete(remaining, next, next+1)
+	}
+
+	return ordered
+}
+
+// linksByOther groups links by the session they place relative to an anchor
+func (g *GreedyScheduler) linksByOther(links []*models.SessionLink) map[uuid.UUID][]*models.SessionLink {
+	result := make(map[uuid.UUID][]*models.SessionLink)
+
+	for _, link := range links {
+		if link != nil {
+			result[link.OtherSessionID] = append(result[link.OtherSessionID], link)
+		}
+	}
+
+	return result
+}
+
+// followsAnchorDays reports whether a link keeps the session on its anchor's days,
+// where spreading it across the week would only get in the way
+func (g *GreedyScheduler) followsAnchorDays(links []*models.SessionLink) bool {
+	return slices.ContainsFunc(links, func(link *models.SessionLink) bool {
+		return link.Kind == models.SessionLinkSameDay || link.Kind == models.SessionLinkConsecutive
+	})
+}
+
+// linkRanges narrows free ranges in a room on a day to the start times the session's links allow.
+// Links whose anchor has not been placed allow anything.
+func (g *GreedyScheduler) linkRanges(ranges []scheduler.TimeRange, day int, room *models.Room, duration int, links sessionLinks, config *scheduler.Config) []scheduler.TimeRange {
+	for _, link := range links.links {
+		anchor := links.placed[link.CourseSessionID]
+		if len(anchor) == 0 {
+			continue
+		}
+
+		onDay := slices.ContainsFunc(anchor, func(a *models.ScheduledSession) bool { return a.Day == day })
+
+		switch link.Kind {
+		case models.SessionLinkBefore:
+			first := slices.MinFunc(anchor, func(a, b *models.ScheduledSession) int {
+				return cmp.Or(a.Day-b.Day, a.EndTime-b.EndTime)
+			})
+			if day < first.Day {
+				return nil
+			}
+			if day == first.Day {
+				ranges = g.consumeSlot(ranges, 0, first.EndTime)
+			}
+		case models.SessionLinkSameDay:
+			if !onDay {
+				return nil
+			}
+		case models.SessionLinkDifferentDay:
+			if onDay {
+				return nil
+			}
+		case models.SessionLinkConsecutive:
+			// Only the exact start after each of the anchor's meetings that day will do
+			var windows []scheduler.TimeRange
+			for _, a := range anchor {
+				if a.Day == day {
+					start := a.EndTime + config.MinBreakBetweenSessions
+					windows = append(windows, scheduler.TimeRange{Start: start, End: start + duration})
+				}
+			}
+			ranges = g.intersectRanges(ranges, g.mergeRanges(windows))
+		case models.SessionLinkSameRoom:
+			if !slices.ContainsFunc(anchor, func(a *models.ScheduledSession) bool { return a.RoomID == room.ID }) {
+				return nil
+			}
+		}
+	}
+
+	return ranges
+}
 
+// sortDaysByAvailability returns days sorted by total availability across the given rooms (descending).
+// Days with equal availability are ordered Monday first, or shuffled by rng when it is set.
+func (g *GreedyScheduler) sortDaysByAvailability(availability scheduler.Availability, rooms []*models.Room, config *scheduler.Config, rng *rand.Rand) []int {
 	// Convert operating days to int slice
-	days := make([]int, len(config.OperatingDays))
-	for i, day := range config.OperatingDays {
+	operatingDays := config.Days()
+	days := make([]int, len(operatingDays))
+	for i, day := range operatingDays {
 		days[i] = int(day)
 	}
 
-	slices.SortFunc(days, func(a, b int) int {
-		availA := g.getTotalAvailability(availability, roomsOfType, a)
-		availB := g.getTotalAvailability(availability, roomsOfType, b)
+	shuffle(days, rng)
+	slices.SortStableFunc(days, func(a, b int) int {
+		availA := g.getTotalAvailability(availability, rooms, a)
+		availB := g.getTotalAvailability(availability, rooms, b)
 		// Sort descending (most availability first)
-		return availB - availA
+		if order := availB - availA; order != 0 || rng != nil {
+			return order
+		}
+		return a - b
 	})
 
 	return days
 }
 
+// spreadAllows reports whether another meeting of a course may go on a day, given the days its
+// meetings already use. A day used already is only taken again when too few unused days are left
+// for the meetings still to place, and never beyond the per-day cap.
+func (g *GreedyScheduler) spreadAllows(used []int, day, remaining int, rules scheduler.Spread, days int) bool {
+	onDay := 0
+	distinct := make(map[int]bool, len(used))
+	for _, u := range used {
+		if u == day {
+			onDay++
+		}
+		if rules.MinDaysBetween > 0 && max(u-day, day-u) < rules.MinDaysBetween {
+			return false
+		}
+		distinct[u] = true
+	}
+
+	if onDay == 0 {
+		return true
+	}
+	if rules.MaxPerDay > 0 && onDay >= rules.MaxPerDay {
+		return false
+	}
+
+	return remaining > days-len(distinct)
+}
+
+// patternFirst moves the days of the first day pattern that suits the session to the front, keeping
+// the order of days otherwise. A pattern suits a session that meets once on each of its days and
+// includes the days the session already meets on.
+func (g *GreedyScheduler) patternFirst(days []int, patterns [][]scheduler.Day, meetings int, placed []*models.ScheduledSession) []int {
+	for _, pattern := range patterns {
+		if len(pattern) != meetings {
+			continue
+		}
+		if slices.ContainsFunc(placed, func(p *models.ScheduledSession) bool { return !slices.Contains(pattern, scheduler.Day(p.Day)) }) {
+			continue
+		}
+
+		rank := func(day int) int {
+			if slices.Contains(pattern, scheduler.Day(day)) {
+				return 0
+			}
+			return 1
+		}
+		slices.SortStableFunc(days, func(a, b int) int { return rank(a) - rank(b) })
+		break
+	}
+
+	return days
+}
+
+// spreadKeys groups the sessions spread across the week together: those of one course, or of
+// one section when the course has sections. A combined session group has a key for every
+// course it serves.
+func (g *GreedyScheduler) spreadKeys(session *models.CourseSession) []string {
+	var keys []string
+	for _, courseID := range session.Courses() {
+		key := courseID.String()
+		if session.SectionID != uuid.Nil {
+			key += "/" + session.SectionID.String()
+		}
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
 // roomsByType filters rooms by their type
 func (g *GreedyScheduler) roomsByType(rooms []*models.Room, roomType string) []*models.Room {
 	result := make([]*models.Room, 0)
@@ -214,6 +871,41 @@ func (g *GreedyScheduler) roomsByType(rooms []*models.Room, roomType string) []*
 	return result
 }
 
+// roomsByCapacity keeps rooms that can seat the enrollment, ordered by least wasted capacity first.
+// Rooms of equal capacity are ordered by name, then ID, or shuffled by rng when it is set.
+func (g *GreedyScheduler) roomsByCapacity(rooms []*models.Room, enrollment int, rng *rand.Rand) []*models.Room {
+	result := make([]*models.Room, 0, len(rooms))
+
+	for _, room := range rooms {
+		if int(room.Capacity) >= enrollment {
+			result = append(result, room)
+		}
+	}
+
+	shuffle(result, rng)
+	slices.SortStableFunc(result, func(a, b *models.Room) int {
+		if order := int(a.Capacity) - int(b.Capacity); order != 0 || rng != nil {
+			return order
+		}
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.ID.String(), b.ID.String()))
+	})
+
+	return result
+}
+
+// sessionEnrollment returns the expected enrollment for a session, preferring the session override
+func (g *GreedyScheduler) sessionEnrollment(session *models.CourseSession, course *models.Course) int {
+	if session.Enrollment != nil {
+		return int(*session.Enrollment)
+	}
+
+	if course != nil {
+		return int(course.Enrollment)
+	}
+
+	return 0
+}
+
 // getTotalAvailability calculates total available minutes for rooms on a given day
 func (g *GreedyScheduler) getTotalAvailability(availability scheduler.Availability, rooms []*models.Room, day int) int {
 	total := 0
@@ -269,6 +961,250 @@ func (g *GreedyScheduler) findFirstAvailableSlot(ranges []scheduler.TimeRange, d
 	return 0, false
 }
 
+// freeRanges narrows a room's free ranges to the times every attending resource is also free
+// and can reach the room's building from their other sessions that day
+func (g *GreedyScheduler) freeRanges(roomRanges []scheduler.TimeRange, day int, building uuid.UUID, resources []resourceConstraint) []scheduler.TimeRange {
+	ranges := roomRanges
+
+	for _, resource := range resources {
+		for _, id := range resource.ids {
+			ranges = g.intersectRanges(ranges, resource.availability[id.String()][day])
+
+			if resource.travel == nil {
+				continue
+			}
+
+			// Keep the trip to and from sessions in other buildings clear
+			for _, b := range resource.travel.bookings[id] {
+				if b.day != day || b.building == building {
+					continue
+				}
+
+				before := g.travelTime(resource.travel.minutes, building, b.building)
+				after := g.travelTime(resource.travel.minutes, b.building, building)
+				ranges = g.consumeSlot(ranges, b.start-before, b.end+after)
+			}
+		}
+	}
+
+	return ranges
+}
+
+// bookResources records where every attending resource will be so later sessions can allow for travel
+func (g *GreedyScheduler) bookResources(resources []resourceConstraint, day, start, end int, building uuid.UUID) {
+	for _, resource := range resources {
+		if resource.travel == nil {
+			continue
+		}
+
+		for _, id := range resource.ids {
+			resource.travel.bookings[id] = append(resource.travel.bookings[id], booking{day: day, start: start, end: end, building: building})
+		}
+	}
+}
+
+// travelMinutes indexes travel times by origin and destination building
+func (g *GreedyScheduler) travelMinutes(travelTimes []*models.BuildingTravelTime) map[uuid.UUID]map[uuid.UUID]int {
+	minutes := make(map[uuid.UUID]map[uuid.UUID]int)
+
+	for _, t := range travelTimes {
+		if t == nil {
+			continue
+		}
+
+		if _, exists := minutes[t.FromBuildingID]; !exists {
+			minutes[t.FromBuildingID] = make(map[uuid.UUID]int)
+		}
+		minutes[t.FromBuildingID][t.ToBuildingID] = int(t.Minutes)
+	}
+
+	return minutes
+}
+
+// travelTime returns the minutes needed to get from one building to another,
+// falling back to the reverse trip when only that direction is recorded
+func (g *GreedyScheduler) travelTime(minutes map[uuid.UUID]map[uuid.UUID]int, from, to uuid.UUID) int {
+	if m, exists := minutes[from][to]; exists {
+		return m
+	}
+
+	return minutes[to][from]
+}
+
+// consumeResources removes a time slot from the availability of every attending resource
+func (g *GreedyScheduler) consumeResources(resources []resourceConstraint, day, start, end int) {
+	for _, resource := range resources {
+		for _, id := range resource.ids {
+			key := id.String()
+			resource.availability[key][day] = g.consumeSlot(resource.availability[key][day], start, end)
+		}
+	}
+}
+
+// failure reports a session that could not be placed, with a diagnosis of why
+func (g *GreedyScheduler) failure(session *models.CourseSession, availability scheduler.Availability, roomsOfType, rooms []*models.Room, config *scheduler.Config, resources []resourceConstraint, links sessionLinks) *scheduler.FailedSession {
+	diagnosis := g.diagnose(availability, session.RequiredRoom, roomsOfType, rooms, int(*session.Duration), config, resources, links)
+
+	return &scheduler.FailedSession{
+		CourseSession: session,
+		Reason:        diagnosis.Code.Reason(),
+		Diagnosis:     diagnosis,
+	}
+}
+
+// diagnose works out which constraints leave a session without a slot. Rooms and operating hours
+// are checked first; only when a room has a slot on its own are the attending resources added, each
+// alone and then one at a time together, and then the session's links. A session that fits everyone
+// and its links somewhere was only kept off those days by its course's spreading rules.
+// roomsOfType holds the rooms of the required type and of every substitute.
+func (g *GreedyScheduler) diagnose(availability scheduler.Availability, requiredRoom string, roomsOfType, rooms []*models.Room, duration int, config *scheduler.Config, resources []resourceConstraint, links sessionLinks) *scheduler.Diagnosis {
+	d := &scheduler.Diagnosis{
+		SuitableRooms: len(rooms),
+		Duration:      duration,
+	}
+	for _, room := range roomsOfType {
+		if room.Type == requiredRoom {
+			d.RoomsOfType++
+		} else {
+			d.SubstituteRooms++
+		}
+	}
+
+	switch {
+	case len(roomsOfType) == 0:
+		d.Blocking = append(d.Blocking, scheduler.CodeNoRoomsOfType)
+	case len(rooms) == 0:
+		d.Blocking = append(d.Blocking, scheduler.CodeInsufficientCapacity)
+	}
+
+	if duration > config.LongestOpening() {
+		d.Blocking = append(d.Blocking, scheduler.CodeDurationTooLong)
+	}
+
+	if len(d.Blocking) == 0 && !g.hasSlot(availability, rooms, duration, config, nil, sessionLinks{}) {
+		d.Blocking = append(d.Blocking, scheduler.CodeSlotsConsumed)
+	}
+
+	if len(d.Blocking) == 0 {
+		for _, resource := range resources {
+			if len(resource.ids) > 0 && !g.hasSlot(availability, rooms, duration, config, []resourceConstraint{resource}, sessionLinks{}) {
+				d.Blocking = append(d.Blocking, resource.code)
+			}
+		}
+	}
+
+	if len(d.Blocking) == 0 {
+		for i, resource := range resources {
+			if len(resource.ids) > 0 && !g.hasSlot(availability, rooms, duration, config, resources[:i+1], sessionLinks{}) {
+				d.Blocking = append(d.Blocking, resource.code)
+				break
+			}
+		}
+	}
+
+	if len(d.Blocking) == 0 && len(links.links) > 0 && !g.hasSlot(availability, rooms, duration, config, resources, links) {
+		d.Blocking = append(d.Blocking, scheduler.CodeLinkConflict)
+	}
+
+	if len(d.Blocking) == 0 {
+		d.Blocking = append(d.Blocking, scheduler.CodeSpreadRule)
+	}
+	d.Code = d.Blocking[0]
+
+	for _, day := range config.Days() {
+		d.FreeBlocks = append(d.FreeBlocks, &scheduler.FreeBlock{
+			Day:     day,
+			Minutes: g.largestFreeBlock(availability, rooms, int(day), resources),
+		})
+	}
+
+	return d
+}
+
+// largestFreeBlock returns the longest stretch on a day that any of the rooms and every resource share
+func (g *GreedyScheduler) largestFreeBlock(availability scheduler.Availability, rooms []*models.Room, day int, resources []resourceConstraint) int {
+	largest := 0
+
+	for _, room := range rooms {
+		for _, r := range g.freeRanges(availability[room.ID.String()][day], day, room.Building, resources) {
+			largest = max(largest, r.End-r.Start)
+		}
+	}
+
+	return largest
+}
+
+// hasSlot reports whether any of the rooms has a slot of the given duration on any day
+// that is also free for the given resources and allowed by the given links
+func (g *GreedyScheduler) hasSlot(availability scheduler.Availability, rooms []*models.Room, duration int, config *scheduler.Config, resources []resourceConstraint, links sessionLinks) bool {
+	for _, room := range rooms {
+		for _, day := range config.Days() {
+			ranges := g.freeRanges(availability[room.ID.String()][int(day)], int(day), room.Building, resources)
+			ranges = g.linkRanges(ranges, int(day), room, duration, links, config)
+			if _, found := g.findFirstAvailableSlot(ranges, duration, config); found {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// intersectRanges returns the time ranges covered by both a and b
+// Both inputs are expected to be sorted and non-overlapping, as produced by consumeSlot
+func (g *GreedyScheduler) intersectRanges(a, b []scheduler.TimeRange) []scheduler.TimeRange {
+	result := make([]scheduler.TimeRange, 0)
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		start := max(a[i].Start, b[j].Start)
+		end := min(a[i].End, b[j].End)
+		if start < end {
+			result = append(result, scheduler.TimeRange{Start: start, End: end})
+		}
+
+		// Advance whichever range finishes first
+		if a[i].End < b[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return result
+}
+
+// mergeRanges sorts ranges by start time and merges any that overlap or touch
+func (g *GreedyScheduler) mergeRanges(ranges []scheduler.TimeRange) []scheduler.TimeRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b scheduler.TimeRange) int {
+		return a.Start - b.Start
+	})
+
+	result := make([]scheduler.TimeRange, 0, len(sorted))
+	for _, r := range sorted {
+		if n := len(result); n > 0 && r.Start <= result[n-1].End {
+			result[n-1].End = max(result[n-1].End, r.End)
+			continue
+		}
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// shuffle puts items in a random order drawn from rng, so a stable sort that follows breaks ties
+// at random; it leaves items as they are when rng is nil
+func shuffle[T any](items []T, rng *rand.Rand) {
+	if rng == nil {
+		return
+	}
+
+	rng.Shuffle(len(items), func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+}
+
 // consumeSlot removes a time slot from availability, splitting ranges as needed
 func (g *GreedyScheduler) consumeSlot(ranges []scheduler.TimeRange, start, end int) []scheduler.TimeRange {
 	result := make([]scheduler.TimeRange, 0)
@@ -0,0 +1,88 @@
+package greedy
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// roomPolicy orders a session's candidate rooms, given smallest adequate room first, into the
+// order they are tried. rooms is every room in the input, in the order listed.
+type roomPolicy func(candidates, rooms []*models.Room, usage *roomUsage, courseKey string) []*models.Room
+
+// roomPolicies holds the ordering behind each room selection policy
+var roomPolicies = map[scheduler.RoomSelection]roomPolicy{
+	"":                                   bestFit,
+	scheduler.RoomSelectionBestFit:       bestFit,
+	scheduler.RoomSelectionFirstFit:      firstFit,
+	scheduler.RoomSelectionLeastUtilised: leastUtilised,
+	scheduler.RoomSelectionSameBuilding:  sameBuilding,
+}
+
+// roomUsage records what has been booked so far for the policies that look at it
+type roomUsage struct {
+	minutes   map[uuid.UUID]int             // room -> minutes booked
+	buildings map[string]map[uuid.UUID]bool // spread key -> buildings the course meets in
+}
+
+func newRoomUsage() *roomUsage {
+	return &roomUsage{
+		minutes:   make(map[uuid.UUID]int),
+		buildings: make(map[string]map[uuid.UUID]bool),
+	}
+}
+
+// book records a meeting of the courses in the room
+func (u *roomUsage) book(room *models.Room, courseKeys []string, minutes int) {
+	u.minutes[room.ID] += minutes
+
+	for _, key := range courseKeys {
+		if u.buildings[key] == nil {
+			u.buildings[key] = make(map[uuid.UUID]bool)
+		}
+		u.buildings[key][room.Building] = true
+	}
+}
+
+// bestFit keeps the candidates as given, smallest adequate room first
+func bestFit(candidates, _ []*models.Room, _ *roomUsage, _ string) []*models.Room {
+	return candidates
+}
+
+// firstFit tries the candidates in the order the rooms are listed
+func firstFit(candidates, rooms []*models.Room, _ *roomUsage, _ string) []*models.Room {
+	return slices.DeleteFunc(slices.Clone(rooms), func(room *models.Room) bool {
+		return !slices.Contains(candidates, room)
+	})
+}
+
+// leastUtilised tries the room booked for the fewest minutes first, then the smallest adequate room
+func leastUtilised(candidates, _ []*models.Room, usage *roomUsage, _ string) []*models.Room {
+	ordered := slices.Clone(candidates)
+	slices.SortStableFunc(ordered, func(a, b *models.Room) int {
+		return usage.minutes[a.ID] - usage.minutes[b.ID]
+	})
+
+	return ordered
+}
+
+// sameBuilding tries rooms in buildings the course already meets in first, then the smallest adequate room
+func sameBuilding(candidates, _ []*models.Room, usage *roomUsage, courseKey string) []*models.Room {
+	used := usage.buildings[courseKey]
+	ordered := slices.Clone(candidates)
+	slices.SortStableFunc(ordered, func(a, b *models.Room) int {
+		switch {
+		case used[a.Building] == used[b.Building]:
+			return 0
+		case used[a.Building]:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return ordered
+}
@@ -0,0 +1,19 @@
+package weight
+
+import (
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ WeightStrategyInterface = (*PriorityWeight)(nil)
+
+// PriorityWeight ranks courses by their explicit Priority, highest first
+type PriorityWeight struct{}
+
+func (w *PriorityWeight) Calculate(course *models.Course, _ []*models.CourseSession, _ *scheduler.Input) int {
+	if course == nil {
+		return 0
+	}
+
+	return int(course.Priority)
+}
@@ -0,0 +1,60 @@
+package weight
+
+import "slices"
+
+// Strategy is a named weight strategy that can be chosen when generating a schedule
+type Strategy struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Strategy    WeightStrategyInterface `json:"-"`
+}
+
+// Registry holds the weight strategies available by name
+type Registry struct {
+	strategies []Strategy
+}
+
+func NewRegistry(strategies ...Strategy) *Registry {
+	return &Registry{strategies: strategies}
+}
+
+// DefaultRegistry returns the built-in strategies, starting with the default total time weight
+func DefaultRegistry() *Registry {
+	return NewRegistry(
+		Strategy{
+			Name:        "total_time",
+			Description: "Courses needing the most teaching minutes each week are scheduled first",
+			Strategy:    &TotalTimeWeight{},
+		},
+		Strategy{
+			Name:        "scarcity",
+			Description: "Courses needing the largest share of the open time of their required room types are scheduled first",
+			Strategy:    &ScarcityWeight{},
+		},
+		Strategy{
+			Name:        "sessions_per_week",
+			Description: "Courses meeting the most times each week are scheduled first",
+			Strategy:    &SessionsPerWeekWeight{},
+		},
+		Strategy{
+			Name:        "priority",
+			Description: "Courses with the highest priority are scheduled first",
+			Strategy:    &PriorityWeight{},
+		},
+	)
+}
+
+// Get returns the strategy with the given name
+func (r *Registry) Get(name string) (WeightStrategyInterface, bool) {
+	i := slices.IndexFunc(r.strategies, func(s Strategy) bool { return s.Name == name })
+	if i < 0 {
+		return nil, false
+	}
+
+	return r.strategies[i].Strategy, true
+}
+
+// List returns every registered strategy in registration order
+func (r *Registry) List() []Strategy {
+	return slices.Clone(r.strategies)
+}
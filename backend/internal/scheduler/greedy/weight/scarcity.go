@@ -0,0 +1,120 @@
+package weight
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ WeightStrategyInterface = (*ScarcityWeight)(nil)
+
+// ScarcityScale converts the share of room time a course needs into an integer weight (parts per million)
+const ScarcityScale = 1_000_000
+
+// ScarcityWeight ranks courses by how constrained they are: the minutes each session needs
+// divided by the minutes rooms of its required type are open each week, summed over the
+// course's sessions. Courses competing for scarce rooms are placed first. Sessions whose room
+// type has no open time cannot be placed at all and add nothing.
+//
+// The open minutes of every room type are worked out on the first Calculate for an input and
+// reused for the rest of its courses, so the input must not change between those calls.
+type ScarcityWeight struct {
+	mu        sync.Mutex
+	input     *scheduler.Input // the input available was worked out for
+	available map[string]int
+}
+
+func (w *ScarcityWeight) Calculate(_ *models.Course, sessions []*models.CourseSession, input *scheduler.Input) int {
+	if input == nil {
+		return 0
+	}
+
+	available := w.availableFor(input)
+	scarcity := 0.0
+
+	for _, session := range sessions {
+		if minutes := available[session.RequiredRoom]; minutes > 0 {
+			scarcity += float64(int(*session.Duration)*int(*session.NumberOfSessions)) / float64(minutes)
+		}
+	}
+
+	return int(scarcity * ScarcityScale)
+}
+
+// availableFor returns the open minutes of each room type for the input, working them out on
+// the first call for it
+func (w *ScarcityWeight) availableFor(input *scheduler.Input) map[string]int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.input != input {
+		w.input, w.available = input, availableMinutes(input)
+	}
+
+	return w.available
+}
+
+// availableMinutes returns the weekly minutes rooms of each type are open, less their
+// unavailability. Overlapping opening ranges and blackouts are merged first so no minute is
+// counted or subtracted twice.
+func availableMinutes(input *scheduler.Input) map[string]int {
+	config := input.Config
+	if config == nil {
+		config = scheduler.DefaultConfig()
+	}
+
+	blackouts := make(map[uuid.UUID]map[scheduler.Day][]scheduler.TimeRange)
+	for _, u := range input.RoomUnavailability {
+		if u == nil {
+			continue
+		}
+		if _, exists := blackouts[u.RoomID]; !exists {
+			blackouts[u.RoomID] = make(map[scheduler.Day][]scheduler.TimeRange)
+		}
+		day := scheduler.Day(u.Day)
+		blackouts[u.RoomID][day] = append(blackouts[u.RoomID][day], scheduler.TimeRange{Start: int(u.StartTime), End: int(u.EndTime)})
+	}
+
+	available := make(map[string]int)
+	for _, room := range input.Rooms {
+		if room == nil {
+			continue
+		}
+
+		for _, day := range config.Days() {
+			closed := mergeRanges(blackouts[room.ID][day])
+			for _, open := range mergeRanges(config.Hours(day)) {
+				minutes := open.End - open.Start
+				for _, c := range closed {
+					minutes -= max(0, min(open.End, c.End)-max(open.Start, c.Start))
+				}
+				available[room.Type] += max(0, minutes)
+			}
+		}
+	}
+
+	return available
+}
+
+// mergeRanges sorts ranges by start and merges those that overlap or touch
+func mergeRanges(ranges []scheduler.TimeRange) []scheduler.TimeRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b scheduler.TimeRange) int {
+		return a.Start - b.Start
+	})
+
+	result := make([]scheduler.TimeRange, 0, len(sorted))
+	for _, r := range sorted {
+		if n := len(result); n > 0 && r.Start <= result[n-1].End {
+			result[n-1].End = max(result[n-1].End, r.End)
+			continue
+		}
+		result = append(result, r)
+	}
+
+	return result
+}
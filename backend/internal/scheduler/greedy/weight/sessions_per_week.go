@@ -0,0 +1,22 @@
+package weight
+
+import (
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ WeightStrategyInterface = (*SessionsPerWeekWeight)(nil)
+
+// SessionsPerWeekWeight ranks courses by how many meetings they need each week, so courses that
+// must be spread over the most days are placed while those days are still free
+type SessionsPerWeekWeight struct{}
+
+func (w *SessionsPerWeekWeight) Calculate(_ *models.Course, sessions []*models.CourseSession, _ *scheduler.Input) int {
+	meetings := 0
+
+	for _, session := range sessions {
+		meetings += int(*session.NumberOfSessions)
+	}
+
+	return meetings
+}
@@ -2,13 +2,14 @@ package weight
 
 import (
 	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
 )
 
 var _ WeightStrategyInterface = (*TotalTimeWeight)(nil)
 
 type TotalTimeWeight struct{}
 
-func (w *TotalTimeWeight) Calculate(sessions []*models.CourseSession) int {
+func (w *TotalTimeWeight) Calculate(_ *models.Course, sessions []*models.CourseSession, _ *scheduler.Input) int {
 	var totalWeight = 0
 
 	for _, session := range sessions {
@@ -1,10 +1,14 @@
 package weight
 
-import "github.com/TerrenceMurray/course-scheduler/internal/models"
+import (
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
 
-// Weight defines the interface for the weight calculation strategy
+// Weight defines the interface for the weight calculation strategy. Courses with a higher
+// weight are scheduled first.
 type WeightStrategyInterface interface {
-	Calculate(sessions []*models.CourseSession) int
+	Calculate(course *models.Course, sessions []*models.CourseSession, input *scheduler.Input) int
 }
 
 // CourseWeights are calculated based on the course and it's sessions
@@ -0,0 +1,287 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// ErrSessionGroupMismatch is returned when the course sessions of a group cannot meet as one:
+// they must share their duration, number of meetings and required room type
+var ErrSessionGroupMismatch = errors.New("course sessions in a session group do not match")
+
+// ErrSessionGroupSectioned is returned when a session group includes a session of a course with
+// sections, which would have the group meet once for all of the course's sections
+var ErrSessionGroupSectioned = errors.New("session groups cannot include sessions of courses with sections")
+
+// Groups maps the combined sessions of an input made by CombineSessions back to the stored
+// course sessions of each group
+type Groups struct {
+	groups map[uuid.UUID]*combined // group ID -> combined session
+}
+
+// combined is one session group as the scheduler sees it
+type combined struct {
+	lead      *models.CourseSession // the first member, reported for the whole group
+	courseIDs []uuid.UUID           // every course the group serves, in member order
+}
+
+// CombineSessions replaces the course sessions of each session group with one session, so
+// schedulers place the group once, in one room, without knowing about groups. The combined
+// session and its course both take the group's ID. The session copies the group's first member,
+// with its windows and spreading rules, and its enrollment is the sum of every member's; the
+// course takes the group's name and the highest priority of the members' courses.
+//
+// The combined session is taught by every instructor assigned to a member and belongs to every
+// cohort of a member's course. Its CourseIDs lists the members' courses, so its meetings count
+// against each of them when spreading meetings across the week; day patterns apply to each
+// course session on its own and need nothing more. Pins and links on any member hold for the
+// combined session. A group whose members differ in duration, meetings or room type is an
+// ErrSessionGroupMismatch.
+func CombineSessions(input *Input, groups []*models.SessionGroup) (*Input, *Groups, error) {
+	g := &Groups{groups: make(map[uuid.UUID]*combined)}
+	if len(groups) == 0 {
+		return input, g, nil
+	}
+
+	sessionsByID := make(map[uuid.UUID]*models.CourseSession, len(input.CourseSessions))
+	for _, cs := range input.CourseSessions {
+		if cs != nil {
+			sessionsByID[cs.ID] = cs
+		}
+	}
+
+	coursesByID := make(map[uuid.UUID]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID] = course
+		}
+	}
+
+	groupOf := make(map[uuid.UUID]uuid.UUID) // member session ID -> group ID
+	var sessions []*models.CourseSession
+	var courses []*models.Course
+
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+
+		var members []*models.CourseSession
+		for _, id := range group.CourseSessionIDs {
+			if cs, exists := sessionsByID[id]; exists && groupOf[id] == uuid.Nil {
+				members = append(members, cs)
+			}
+		}
+		if len(members) < 2 {
+			continue
+		}
+
+		lead := members[0]
+		session := *lead
+		session.ID = group.ID
+		session.CourseID = group.ID
+		course := &models.Course{ID: group.ID, Name: group.Name}
+		c := &combined{lead: lead}
+
+		enrollment := int32(0)
+		for _, member := range members {
+			if !sameValue(member.Duration, lead.Duration) || !sameValue(member.NumberOfSessions, lead.NumberOfSessions) || member.RequiredRoom != lead.RequiredRoom {
+				return nil, nil, fmt.Errorf("%w: %q", ErrSessionGroupMismatch, group.Name)
+			}
+
+			memberCourse := coursesByID[member.CourseID]
+			switch {
+			case member.Enrollment != nil:
+				enrollment += *member.Enrollment
+			case memberCourse != nil:
+				enrollment += memberCourse.Enrollment
+			}
+			if memberCourse != nil {
+				course.Priority = max(course.Priority, memberCourse.Priority)
+			}
+
+			if !slices.Contains(c.courseIDs, member.CourseID) {
+				c.courseIDs = append(c.courseIDs, member.CourseID)
+			}
+			groupOf[member.ID] = group.ID
+		}
+
+		session.Enrollment = &enrollment
+		session.CourseIDs = slices.Clone(c.courseIDs)
+		course.Enrollment = enrollment
+
+		sessions = append(sessions, &session)
+		courses = append(courses, course)
+		g.groups[group.ID] = c
+	}
+	if len(g.groups) == 0 {
+		return input, g, nil
+	}
+
+	combinedInput := *input
+	combinedInput.Courses = append(slices.Clone(input.Courses), courses...)
+	combinedInput.CourseSessions = nil
+	combinedInput.InstructorAssignments = nil
+	combinedInput.Cohorts = nil
+	combinedInput.Pins = nil
+	combinedInput.Links = nil
+
+	for _, cs := range input.CourseSessions {
+		if cs == nil || groupOf[cs.ID] == uuid.Nil {
+			combinedInput.CourseSessions = append(combinedInput.CourseSessions, cs)
+		}
+	}
+	combinedInput.CourseSessions = append(combinedInput.CourseSessions, sessions...)
+
+	// sessionID returns the ID the scheduler knows a course session by
+	sessionID := func(id uuid.UUID) uuid.UUID {
+		if groupID := groupOf[id]; groupID != uuid.Nil {
+			return groupID
+		}
+		return id
+	}
+
+	seenAssignments := make(map[[2]uuid.UUID]bool)
+	for _, a := range input.InstructorAssignments {
+		if a == nil {
+			continue
+		}
+
+		key := [2]uuid.UUID{sessionID(a.CourseSessionID), a.InstructorID}
+		if seenAssignments[key] {
+			continue
+		}
+		seenAssignments[key] = true
+
+		assignment := *a
+		assignment.CourseSessionID = key[0]
+		combinedInput.InstructorAssignments = append(combinedInput.InstructorAssignments, &assignment)
+	}
+
+	for _, cohort := range input.Cohorts {
+		if cohort == nil {
+			continue
+		}
+
+		c := *cohort
+		c.CourseIDs = slices.Clone(cohort.CourseIDs)
+		for _, group := range groups {
+			if group == nil || g.groups[group.ID] == nil {
+				continue
+			}
+			if slices.ContainsFunc(g.groups[group.ID].courseIDs, func(id uuid.UUID) bool { return slices.Contains(cohort.CourseIDs, id) }) {
+				c.CourseIDs = append(c.CourseIDs, group.ID)
+			}
+		}
+		combinedInput.Cohorts = append(combinedInput.Cohorts, &c)
+	}
+
+	for _, pin := range input.Pins {
+		if pin == nil {
+			continue
+		}
+
+		p := *pin
+		p.CourseSessionID = sessionID(pin.CourseSessionID)
+		if slices.ContainsFunc(combinedInput.Pins, func(other *models.SessionPin) bool {
+			return other.CourseSessionID == p.CourseSessionID && other.RoomID == p.RoomID && other.Day == p.Day && other.StartTime == p.StartTime
+		}) {
+			continue
+		}
+		combinedInput.Pins = append(combinedInput.Pins, &p)
+	}
+
+	for _, link := range input.Links {
+		if link == nil {
+			continue
+		}
+
+		l := *link
+		l.CourseSessionID, l.OtherSessionID = sessionID(link.CourseSessionID), sessionID(link.OtherSessionID)
+		if l.CourseSessionID == l.OtherSessionID {
+			continue
+		}
+		combinedInput.Links = append(combinedInput.Links, &l)
+	}
+
+	return &combinedInput, g, nil
+}
+
+// Expand rewrites saved meetings of session groups to refer to the combined sessions
+// CombineSessions made, so they can be compared with a combined input
+func (g *Groups) Expand(saved []models.ScheduledSession) []models.ScheduledSession {
+	expanded := slices.Clone(saved)
+
+	for i, ss := range expanded {
+		if _, exists := g.groups[ss.GroupID]; exists {
+			expanded[i].CourseID = ss.GroupID
+			expanded[i].CourseSessionID = ss.GroupID
+			expanded[i].GroupID = uuid.Nil
+			expanded[i].CourseIDs = nil
+		}
+	}
+
+	return expanded
+}
+
+// Restore points the scheduled sessions and failures in output made from a combined input back
+// at the stored course sessions. A combined meeting is reported for the group's first member,
+// with GroupID set and every course it serves in CourseIDs; a failed group is reported as its
+// first member.
+func (g *Groups) Restore(output *Output) {
+	if output == nil {
+		return
+	}
+
+	for _, ss := range output.ScheduledSessions {
+		g.RestoreSession(ss)
+	}
+
+	for _, f := range output.Failures {
+		if f.CourseSession == nil {
+			continue
+		}
+
+		if c, exists := g.groups[f.CourseSession.ID]; exists {
+			f.CourseSession = c.lead
+		}
+	}
+}
+
+// RestoreSession points a scheduled combined session back at the group's first member
+func (g *Groups) RestoreSession(ss *models.ScheduledSession) {
+	if ss == nil {
+		return
+	}
+
+	if c, exists := g.groups[ss.CourseSessionID]; exists {
+		ss.GroupID = ss.CourseSessionID
+		ss.CourseID = c.lead.CourseID
+		ss.CourseSessionID = c.lead.ID
+		ss.CourseIDs = slices.Clone(c.courseIDs)
+	}
+}
+
+// SessionID returns the stored course session ID of the group's first member for the ID of a
+// combined session, or the ID itself
+func (g *Groups) SessionID(id uuid.UUID) uuid.UUID {
+	if c, exists := g.groups[id]; exists {
+		return c.lead.ID
+	}
+
+	return id
+}
+
+// sameValue reports whether two optional values are both unset or equal
+func sameValue[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
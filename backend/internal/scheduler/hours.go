@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"maps"
+	"slices"
+)
+
+// Days returns the days sessions can be scheduled on, Monday first: the OperatingDays and every
+// day with its own DayHours, less the days DayHours closes
+func (c *Config) Days() []Day {
+	days := slices.Concat(c.OperatingDays, slices.Collect(maps.Keys(c.DayHours)))
+	slices.Sort(days)
+	days = slices.Compact(days)
+
+	return slices.DeleteFunc(days, func(day Day) bool {
+		ranges, listed := c.DayHours[day]
+		return listed && len(ranges) == 0
+	})
+}
+
+// Hours returns the open time ranges of a day in order: its DayHours, or OperatingHours when it
+// has none, less the BlockedWindows. A day that is not an operating day has none.
+func (c *Config) Hours(day Day) []TimeRange {
+	if !slices.Contains(c.Days(), day) {
+		return nil
+	}
+
+	return c.openHours(day)
+}
+
+// LongestOpening returns the length of the longest open range on any operating day
+func (c *Config) LongestOpening() int {
+	longest := 0
+
+	for _, day := range c.Days() {
+		for _, r := range c.Hours(day) {
+			longest = max(longest, r.End-r.Start)
+		}
+	}
+
+	return longest
+}
+
+// openHours returns a day's ranges sorted and merged, with the blocked windows taken out
+func (c *Config) openHours(day Day) []TimeRange {
+	ranges, listed := c.DayHours[day]
+	if !listed {
+		ranges = []TimeRange{c.OperatingHours}
+	}
+
+	ranges = slices.DeleteFunc(slices.Clone(ranges), func(r TimeRange) bool { return r.End <= r.Start })
+	slices.SortFunc(ranges, func(a, b TimeRange) int { return a.Start - b.Start })
+
+	var open []TimeRange
+	for _, r := range ranges {
+		if n := len(open); n > 0 && r.Start <= open[n-1].End {
+			open[n-1].End = max(open[n-1].End, r.End)
+			continue
+		}
+		open = append(open, r)
+	}
+
+	for _, blocked := range c.BlockedWindows {
+		var left []TimeRange
+		for _, r := range open {
+			if r.End <= blocked.Start || r.Start >= blocked.End {
+				left = append(left, r)
+				continue
+			}
+			if r.Start < blocked.Start {
+				left = append(left, TimeRange{Start: r.Start, End: blocked.Start})
+			}
+			if r.End > blocked.End {
+				left = append(left, TimeRange{Start: blocked.End, End: r.End})
+			}
+		}
+		open = left
+	}
+
+	return open
+}
@@ -0,0 +1,137 @@
+package scheduler
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// LinkAllows reports whether a meeting of a link's other session may take place at ss, given where
+// the anchor session's meetings are. Links to an anchor with no meetings are always satisfied.
+func LinkAllows(kind string, anchor []*models.ScheduledSession, ss *models.ScheduledSession, minBreak int) bool {
+	if len(anchor) == 0 {
+		return true
+	}
+
+	switch kind {
+	case models.SessionLinkBefore:
+		first := slices.MinFunc(anchor, func(a, b *models.ScheduledSession) int {
+			return weekMinute(a.Day, a.EndTime) - weekMinute(b.Day, b.EndTime)
+		})
+		return weekMinute(ss.Day, ss.StartTime) >= weekMinute(first.Day, first.EndTime)
+	case models.SessionLinkSameDay:
+		return slices.ContainsFunc(anchor, func(a *models.ScheduledSession) bool { return a.Day == ss.Day })
+	case models.SessionLinkDifferentDay:
+		return !slices.ContainsFunc(anchor, func(a *models.ScheduledSession) bool { return a.Day == ss.Day })
+	case models.SessionLinkConsecutive:
+		return slices.ContainsFunc(anchor, func(a *models.ScheduledSession) bool {
+			return a.Day == ss.Day && a.EndTime+minBreak == ss.StartTime
+		})
+	case models.SessionLinkSameRoom:
+		return slices.ContainsFunc(anchor, func(a *models.ScheduledSession) bool { return a.RoomID == ss.RoomID })
+	default:
+		return true
+	}
+}
+
+// EnforceLinks takes every meeting that breaks one of the input's links out of the output and
+// reports its course session as a failure, returning whether anything was taken out. The other
+// session's meeting is taken out, or the anchor's meetings when that meeting is pinned; pinned
+// meetings are never taken out, so links between two pinned sessions are left as they are.
+func EnforceLinks(input *Input, output *Output) bool {
+	if len(input.Links) == 0 || output == nil {
+		return false
+	}
+
+	config := input.Config
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	pinned := func(ss *models.ScheduledSession) bool {
+		return slices.ContainsFunc(input.Pins, func(pin *models.SessionPin) bool {
+			return pin != nil && pin.CourseSessionID == ss.CourseSessionID && pin.RoomID == ss.RoomID &&
+				int(pin.Day) == ss.Day && int(pin.StartTime) == ss.StartTime
+		})
+	}
+
+	removed := make(map[uuid.UUID]bool)
+	for changed := true; changed; {
+		changed = false
+
+		for _, link := range input.Links {
+			if link == nil {
+				continue
+			}
+
+			anchor := meetingsOf(output.ScheduledSessions, link.CourseSessionID)
+			for _, ss := range meetingsOf(output.ScheduledSessions, link.OtherSessionID) {
+				if LinkAllows(link.Kind, anchor, ss, config.MinBreakBetweenSessions) {
+					continue
+				}
+
+				drop := []*models.ScheduledSession{ss}
+				if pinned(ss) {
+					drop = slices.DeleteFunc(slices.Clone(anchor), pinned)
+				}
+				if len(drop) == 0 {
+					continue
+				}
+
+				output.ScheduledSessions = slices.DeleteFunc(output.ScheduledSessions, func(s *models.ScheduledSession) bool {
+					return slices.Contains(drop, s)
+				})
+				for _, s := range drop {
+					removed[s.CourseSessionID] = true
+				}
+				changed = true
+				break
+			}
+		}
+	}
+
+	for _, cs := range input.CourseSessions {
+		if cs == nil || !removed[cs.ID] {
+			continue
+		}
+
+		alreadyFailed := slices.ContainsFunc(output.Failures, func(f *FailedSession) bool {
+			return f.CourseSession != nil && f.CourseSession.ID == cs.ID
+		})
+		if alreadyFailed {
+			continue
+		}
+
+		output.Failures = append(output.Failures, &FailedSession{
+			CourseSession: cs,
+			Reason:        ReasonLinkConflict,
+			Diagnosis: &Diagnosis{
+				Code:     CodeLinkConflict,
+				Blocking: []FailureCode{CodeLinkConflict},
+				Duration: int(*cs.Duration),
+			},
+		})
+	}
+
+	return len(removed) > 0
+}
+
+// meetingsOf returns the scheduled meetings of a course session
+func meetingsOf(sessions []*models.ScheduledSession, courseSessionID uuid.UUID) []*models.ScheduledSession {
+	var meetings []*models.ScheduledSession
+
+	for _, ss := range sessions {
+		if ss != nil && ss.CourseSessionID == courseSessionID {
+			meetings = append(meetings, ss)
+		}
+	}
+
+	return meetings
+}
+
+// weekMinute orders times across the week
+func weekMinute(day, minute int) int {
+	return day*models.MinutesPerDay + minute
+}
@@ -0,0 +1,166 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// Reasons a pinned placement cannot be honoured
+const (
+	ReasonPinUnknownSession  = "pin refers to an unknown course session"
+	ReasonPinUnknownRoom     = "pin refers to an unknown room"
+	ReasonPinBadDay          = "pinned day is not between 0 and 6"
+	ReasonPinBadStart        = "pinned start time is before midnight"
+	ReasonPinPastMidnight    = "pinned meeting runs past midnight"
+	ReasonPinTooMany         = "more pins than meetings of the course session"
+	ReasonPinRoomClash       = "pinned meetings overlap in the same room"
+	ReasonPinInstructorClash = "pinned meetings overlap for a shared instructor"
+	ReasonPinCohortClash     = "pinned meetings overlap for a shared cohort"
+)
+
+// PinConflict is a pin that cannot be honoured, either on its own or alongside another pin
+type PinConflict struct {
+	Pin           *models.SessionPin
+	ConflictsWith *models.SessionPin // nil when the pin is invalid on its own
+	Reason        string
+}
+
+// PinConflictError is returned by schedulers when the input's pins cannot all be honoured
+type PinConflictError struct {
+	Conflicts []*PinConflict
+}
+
+func (e *PinConflictError) Error() string {
+	reasons := make([]string, len(e.Conflicts))
+	for i, c := range e.Conflicts {
+		reasons[i] = fmt.Sprintf("pin %s: %s", c.Pin.ID, c.Reason)
+	}
+
+	return "pinned sessions conflict: " + strings.Join(reasons, "; ")
+}
+
+// ValidatePins checks that the input's pins refer to known course sessions and rooms and
+// can all be honoured together, returning a *PinConflictError listing every problem found.
+// Pinned meetings may sit back to back; breaks and travel time are not enforced between them.
+func ValidatePins(input *Input) error {
+	if len(input.Pins) == 0 {
+		return nil
+	}
+
+	sessions := make(map[uuid.UUID]*models.CourseSession)
+	for _, cs := range input.CourseSessions {
+		if cs != nil {
+			sessions[cs.ID] = cs
+		}
+	}
+
+	rooms := make(map[uuid.UUID]bool)
+	for _, room := range input.Rooms {
+		if room != nil {
+			rooms[room.ID] = true
+		}
+	}
+
+	instructors := make(map[uuid.UUID][]uuid.UUID) // course session -> instructors
+	for _, a := range input.InstructorAssignments {
+		if a != nil {
+			instructors[a.CourseSessionID] = append(instructors[a.CourseSessionID], a.InstructorID)
+		}
+	}
+
+	cohorts := make(map[uuid.UUID][]uuid.UUID) // course -> cohorts
+	for _, cohort := range input.Cohorts {
+		if cohort == nil {
+			continue
+		}
+		for _, courseID := range cohort.CourseIDs {
+			cohorts[courseID] = append(cohorts[courseID], cohort.ID)
+		}
+	}
+
+	var conflicts []*PinConflict
+	var valid []*models.SessionPin
+	used := make(map[uuid.UUID]int)
+
+	for _, pin := range input.Pins {
+		if pin == nil {
+			continue
+		}
+
+		cs, exists := sessions[pin.CourseSessionID]
+		reason := ""
+		switch {
+		case !exists:
+			reason = ReasonPinUnknownSession
+		case !rooms[pin.RoomID]:
+			reason = ReasonPinUnknownRoom
+		case pin.Day < 0 || pin.Day > 6:
+			reason = ReasonPinBadDay
+		case pin.StartTime < 0:
+			reason = ReasonPinBadStart
+		case int(pin.StartTime)+int(*cs.Duration) > models.MinutesPerDay:
+			reason = ReasonPinPastMidnight
+		case used[cs.ID] >= int(*cs.NumberOfSessions):
+			reason = ReasonPinTooMany
+		}
+
+		if reason != "" {
+			conflicts = append(conflicts, &PinConflict{Pin: pin, Reason: reason})
+			continue
+		}
+
+		used[cs.ID]++
+		valid = append(valid, pin)
+	}
+
+	// Compare each valid pair once, reporting the first shared resource that clashes
+	for i, a := range valid {
+		csA := sessions[a.CourseSessionID]
+		for _, b := range valid[i+1:] {
+			csB := sessions[b.CourseSessionID]
+
+			overlap := a.Day == b.Day &&
+				a.StartTime < b.StartTime+*csB.Duration && b.StartTime < a.StartTime+*csA.Duration
+			if !overlap {
+				continue
+			}
+
+			reason := ""
+			switch {
+			case a.RoomID == b.RoomID:
+				reason = ReasonPinRoomClash
+			case shares(instructors[csA.ID], instructors[csB.ID]):
+				reason = ReasonPinInstructorClash
+			case shares(cohorts[csA.CourseID], cohorts[csB.CourseID]):
+				reason = ReasonPinCohortClash
+			}
+
+			if reason != "" {
+				conflicts = append(conflicts, &PinConflict{Pin: b, ConflictsWith: a, Reason: reason})
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return &PinConflictError{Conflicts: conflicts}
+	}
+
+	return nil
+}
+
+// shares reports whether the two lists have an ID in common
+func shares(a, b []uuid.UUID) bool {
+	for _, id := range a {
+		for _, other := range b {
+			if id == other {
+				return true
+			}
+		}
+	}
+
+	return false
+}
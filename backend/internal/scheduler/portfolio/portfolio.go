@@ -0,0 +1,267 @@
+// Package portfolio runs many variants of the greedy scheduler at once and keeps the best.
+//
+// A single greedy pass takes milliseconds but commits to one arbitrary answer: the order its
+// weight strategy gives the courses, the rooms its selection policy picks and the way it breaks
+// ties. The portfolio tries every combination of the configured weight strategies, room
+// selection policies and tie-break seeds across a pool of goroutines, scores each timetable and
+// returns the one leaving the fewest meetings unplaced, then the lowest score. Output.Variants lists every
+// run so the settings that suit the data can be seen.
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/score"
+)
+
+var _ scheduler.Scheduler = (*PortfolioScheduler)(nil)
+
+// DefaultConfig returns settings that try every built-in strategy and room selection policy with
+// four seeds besides the fixed tie-breakers, on every CPU, for at most ten seconds
+func DefaultConfig() *Config {
+	return &Config{
+		Seeds:     4,
+		TimeLimit: 10 * time.Second,
+	}
+}
+
+// Config tunes the portfolio
+type Config struct {
+	// Strategies are the weight strategies tried; empty tries every strategy in weight.DefaultRegistry
+	Strategies []weight.Strategy
+
+	// RoomSelections are the room selection policies tried; empty tries every scheduler.RoomSelections
+	RoomSelections []scheduler.RoomSelection
+
+	// Seeds is how many random tie-break seeds are tried for each strategy and policy, besides
+	// the fixed tie-breakers. They run from Seed upwards.
+	Seeds int
+	Seed  int64
+
+	// Workers is how many variants run at once; 0 uses every CPU
+	Workers int
+
+	// TimeLimit bounds the wall-clock time spent: when it runs out, variants not started are
+	// skipped, those running stop part way, and Output.Status reports timed_out. 0 runs every
+	// variant to the end.
+	TimeLimit time.Duration
+}
+
+type PortfolioScheduler struct {
+	Config *Config
+	Scorer *score.Scorer
+}
+
+// NewPortfolioScheduler returns a portfolio of greedy schedulers rated by the default scorer.
+// A nil config uses DefaultConfig.
+func NewPortfolioScheduler(config *Config) scheduler.Scheduler {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	return &PortfolioScheduler{
+		Config: config,
+		Scorer: score.DefaultScorer(),
+	}
+}
+
+// variant is one combination of settings to run
+type variant struct {
+	strategy      weight.Strategy
+	roomSelection scheduler.RoomSelection
+	seed          *int64
+}
+
+// Generate runs every variant and returns the output of the best. The input config's room
+// selection and seed are replaced by each variant's; the rest of the config applies to all.
+// When ctx is done no more variants start, those running stop, and the best of what was
+// found is returned. Progress reports the most meetings any finished variant placed.
+func (s *PortfolioScheduler) Generate(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+	variants := s.variants()
+
+	config := input.Config
+	if config == nil {
+		config = scheduler.DefaultConfig()
+	}
+
+	if s.Config.TimeLimit > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Config.TimeLimit)
+		defer cancel()
+	}
+
+	workers := s.Config.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	outputs := make([]*scheduler.Output, len(variants))
+	errs := make([]error, len(variants))
+	var failed atomic.Bool
+
+	total := input.Meetings()
+	var mu sync.Mutex
+	reported := 0
+	report := func(output *scheduler.Output) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if placed := len(output.ScheduledSessions); placed > reported {
+			reported = placed
+			input.ReportProgress(placed, total)
+		}
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(variants)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				outputs[i], errs[i] = s.run(ctx, input, config, variants[i])
+				if errs[i] != nil {
+					failed.Store(true)
+					continue
+				}
+				report(outputs[i])
+			}
+		}()
+	}
+
+	// The first variant always runs so there is a timetable to return
+	var status scheduler.Status
+	for i := range variants {
+		if failed.Load() {
+			break
+		}
+		if i > 0 && ctx.Err() != nil {
+			status = scheduler.StopStatus(ctx)
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Every variant sees the same input, so an error such as a pin conflict is the input's
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var best *scheduler.Output
+	var bestVariant *scheduler.Variant
+	var summary []*scheduler.Variant
+	for i, output := range outputs {
+		if output == nil {
+			continue
+		}
+
+		v := &scheduler.Variant{
+			Strategy:      variants[i].strategy.Name,
+			RoomSelection: variants[i].roomSelection,
+			Seed:          variants[i].seed,
+			Failures:      total - len(output.ScheduledSessions),
+			Score:         output.Score.Total,
+			Status:        output.Status,
+		}
+		summary = append(summary, v)
+		if bestVariant == nil || better(v, bestVariant) {
+			best, bestVariant = output, v
+		}
+
+		// A run stopped part way means the portfolio was stopped too
+		if status == "" {
+			status = v.Status
+		}
+	}
+
+	bestVariant.Best = true
+	output := best
+	output.Variants = summary
+	if status != "" {
+		output.Status = status
+	}
+
+	return output, nil
+}
+
+// run generates and scores one variant's timetable
+func (s *PortfolioScheduler) run(ctx context.Context, input *scheduler.Input, config *scheduler.Config, v variant) (*scheduler.Output, error) {
+	c := *config
+	c.RoomSelection = v.roomSelection
+	c.Seed = v.seed
+
+	// Variants run at once, so only the portfolio reports progress
+	variantInput := *input
+	variantInput.Config = &c
+	variantInput.Progress = nil
+
+	output, err := greedy.NewGreedyScheduler(v.strategy.Strategy).Generate(ctx, &variantInput)
+	if err != nil {
+		return nil, err
+	}
+	output.Score = s.Scorer.Evaluate(output.ScheduledSessions, &variantInput)
+
+	return output, nil
+}
+
+// variants lists every combination of strategy, room selection policy and seed in a fixed order
+func (s *PortfolioScheduler) variants() []variant {
+	strategies := s.Config.Strategies
+	if len(strategies) == 0 {
+		strategies = weight.DefaultRegistry().List()
+	}
+
+	roomSelections := s.Config.RoomSelections
+	if len(roomSelections) == 0 {
+		roomSelections = scheduler.RoomSelections
+	}
+
+	seeds := []*int64{nil}
+	for i := range max(s.Config.Seeds, 0) {
+		seed := s.Config.Seed + int64(i)
+		seeds = append(seeds, &seed)
+	}
+
+	var variants []variant
+	for _, strategy := range strategies {
+		for _, roomSelection := range roomSelections {
+			for _, seed := range seeds {
+				variants = append(variants, variant{strategy: strategy, roomSelection: roomSelection, seed: seed})
+			}
+		}
+	}
+
+	return variants
+}
+
+// better reports whether a beats b: a finished run beats one stopped part way, then fewer
+// unplaced meetings win, then a lower score. Ties keep the earlier variant, so the result does
+// not depend on which goroutine finished first.
+func better(a, b *scheduler.Variant) bool {
+	return cmp.Or(
+		cmp.Compare(stopped(a), stopped(b)),
+		cmp.Compare(a.Failures, b.Failures),
+		cmp.Compare(a.Score, b.Score),
+	) < 0
+}
+
+// stopped is 1 for a run stopped before it tried every session, 0 otherwise
+func stopped(v *scheduler.Variant) int {
+	if v.Status != "" {
+		return 1
+	}
+
+	return 0
+}
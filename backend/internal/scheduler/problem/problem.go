@@ -0,0 +1,1092 @@
+// Package problem indexes a scheduler.Input into the flat form used by the search-based schedulers:
+// one entry per meeting to place, the rooms it may use, the people who must attend it, the links
+// that place it relative to other meetings and the rules spreading its course across the week.
+package problem
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// Cost weights shared by the search-based schedulers
+const (
+	ViolationCost  = 2000 // a broken hard constraint, worse than leaving a meeting out
+	UnplacedCost   = 1000 // a meeting left out of the timetable
+	PreferenceCost = 10   // an instructor teaching outside their preferred windows
+	SameDayCost    = 5    // two meetings of one course on the same day
+	SubstituteCost = 3    // a meeting in a substitute for its required room type
+	PatternCost    = 4    // a course session meeting on days none of its day patterns cover
+)
+
+// defaultStep is the spacing between candidate start times when no preferred slot duration is set
+const defaultStep = 15
+
+// Clash kinds, in the order failures are diagnosed
+const (
+	clashRoom = 1 << iota
+	clashInstructor
+	clashCohort
+	clashLink
+	clashSpread
+)
+
+// Placement is where a meeting takes place. Room indexes Problem.Rooms.
+type Placement struct {
+	Room  int
+	Day   int
+	Start int
+}
+
+// Unplaced is the placement of a meeting that is not in the timetable
+var Unplaced = Placement{Room: -1}
+
+// Placed reports whether the placement puts the meeting in a room
+func (pl Placement) Placed() bool {
+	return pl.Room >= 0
+}
+
+// Assignment holds one placement per meeting, indexed like Problem.Sessions
+type Assignment []Placement
+
+// Clone returns an independent copy of the assignment
+func (a Assignment) Clone() Assignment {
+	return slices.Clone(a)
+}
+
+// Placed counts the meetings the assignment puts in a room
+func (a Assignment) Placed() int {
+	placed := 0
+
+	for _, pl := range a {
+		if pl.Placed() {
+			placed++
+		}
+	}
+
+	return placed
+}
+
+// Session is a single meeting of a course session that needs a room, day and start time
+type Session struct {
+	CourseSession *models.CourseSession
+	Duration      int
+	Rooms         []int         // candidate rooms, the required type first, then least wasted capacity first
+	Starts        map[int][]int // day -> candidate start times
+	Instructors   []uuid.UUID
+	Cohorts       []uuid.UUID
+	Pin           *Placement // the only placement allowed for a pinned meeting, nil otherwise
+
+	reason    string           // why the meeting can never be placed, when Rooms is empty
+	anchoring []*Link          // links whose anchor the meeting belongs to
+	following []*Link          // links whose other session the meeting belongs to
+	spread    scheduler.Spread // spreading rules in force for the meeting's course session
+	patterns  [][]int          // day patterns that suit the course session, as days
+}
+
+// Link places the meetings of one course session (Others) relative to those of another (Anchors),
+// with the semantics of scheduler.LinkAllows
+type Link struct {
+	Kind    string
+	Anchors []int
+	Others  []int
+}
+
+// Problem is a scheduler.Input indexed for fast constraint checks
+type Problem struct {
+	Config   *scheduler.Config
+	Rooms    []*models.Room
+	Sessions []*Session
+	Days     []int
+
+	roomIndex   map[uuid.UUID]int
+	hours       map[int][]scheduler.TimeRange               // day -> open hours
+	roomBlocked map[int]map[int][]scheduler.TimeRange       // room index -> day -> blackout windows
+	unavailable map[uuid.UUID]map[int][]scheduler.TimeRange // instructor -> day -> unavailable windows
+	preferred   map[uuid.UUID]map[int][]scheduler.TimeRange // instructor -> day -> preferred windows
+	travel      map[uuid.UUID]map[uuid.UUID]int             // building -> building -> minutes
+	related     []map[int]int                               // meeting -> meetings sharing an attendee -> clash kind
+	siblings    [][]int                                     // meeting -> other meetings of the same course
+	apart       []map[int]int                               // meeting -> other meetings of the same course -> minimum days between them
+	meetings    map[uuid.UUID][]int                         // course session -> its meetings
+	links       []*Link
+}
+
+// New indexes the input. Nil entries are ignored, as the greedy scheduler does.
+func New(input *scheduler.Input) *Problem {
+	config := input.Config
+	if config == nil {
+		config = scheduler.DefaultConfig()
+	}
+
+	p := &Problem{
+		Config:      config,
+		roomIndex:   make(map[uuid.UUID]int),
+		hours:       make(map[int][]scheduler.TimeRange),
+		roomBlocked: make(map[int]map[int][]scheduler.TimeRange),
+		unavailable: make(map[uuid.UUID]map[int][]scheduler.TimeRange),
+		preferred:   make(map[uuid.UUID]map[int][]scheduler.TimeRange),
+		travel:      make(map[uuid.UUID]map[uuid.UUID]int),
+		meetings:    make(map[uuid.UUID][]int),
+	}
+
+	for _, day := range config.Days() {
+		p.Days = append(p.Days, int(day))
+		p.hours[int(day)] = config.Hours(day)
+	}
+
+	for _, room := range input.Rooms {
+		if room != nil {
+			p.roomIndex[room.ID] = len(p.Rooms)
+			p.Rooms = append(p.Rooms, room)
+		}
+	}
+
+	p.indexWindows(input)
+	p.indexSessions(input)
+	p.indexLinks(input)
+	p.indexRelations()
+
+	return p
+}
+
+// indexWindows records room blackouts, instructor availability and travel times
+func (p *Problem) indexWindows(input *scheduler.Input) {
+	for _, u := range input.RoomUnavailability {
+		if u == nil {
+			continue
+		}
+
+		room, exists := p.roomIndex[u.RoomID]
+		if !exists {
+			continue
+		}
+
+		if _, exists := p.roomBlocked[room]; !exists {
+			p.roomBlocked[room] = make(map[int][]scheduler.TimeRange)
+		}
+		p.roomBlocked[room][int(u.Day)] = append(p.roomBlocked[room][int(u.Day)], scheduler.TimeRange{Start: int(u.StartTime), End: int(u.EndTime)})
+	}
+
+	for _, a := range input.InstructorAvailability {
+		if a == nil {
+			continue
+		}
+
+		windows := p.unavailable
+		if a.Kind == models.AvailabilityPreferred {
+			windows = p.preferred
+		}
+
+		if _, exists := windows[a.InstructorID]; !exists {
+			windows[a.InstructorID] = make(map[int][]scheduler.TimeRange)
+		}
+		windows[a.InstructorID][int(a.Day)] = append(windows[a.InstructorID][int(a.Day)], scheduler.TimeRange{Start: int(a.StartTime), End: int(a.EndTime)})
+	}
+
+	for _, t := range input.TravelTimes {
+		if t == nil {
+			continue
+		}
+
+		if _, exists := p.travel[t.FromBuildingID]; !exists {
+			p.travel[t.FromBuildingID] = make(map[uuid.UUID]int)
+		}
+		p.travel[t.FromBuildingID][t.ToBuildingID] = int(t.Minutes)
+	}
+}
+
+// indexSessions expands every course session into one entry per weekly meeting
+func (p *Problem) indexSessions(input *scheduler.Input) {
+	coursesByID := make(map[uuid.UUID]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID] = course
+		}
+	}
+
+	instructors := make(map[uuid.UUID][]uuid.UUID)
+	for _, a := range input.InstructorAssignments {
+		if a != nil {
+			instructors[a.CourseSessionID] = append(instructors[a.CourseSessionID], a.InstructorID)
+		}
+	}
+
+	cohorts := make(map[uuid.UUID][]uuid.UUID)
+	for _, cohort := range input.Cohorts {
+		if cohort == nil {
+			continue
+		}
+		for _, courseID := range cohort.CourseIDs {
+			cohorts[courseID] = append(cohorts[courseID], cohort.ID)
+		}
+	}
+
+	pins := make(map[uuid.UUID][]Placement)
+	for _, pin := range input.Pins {
+		if pin == nil {
+			continue
+		}
+		if room, exists := p.roomIndex[pin.RoomID]; exists {
+			pins[pin.CourseSessionID] = append(pins[pin.CourseSessionID], Placement{Room: room, Day: int(pin.Day), Start: int(pin.StartTime)})
+		}
+	}
+
+	for _, cs := range input.CourseSessions {
+		if cs == nil || cs.Duration == nil || cs.NumberOfSessions == nil {
+			continue
+		}
+
+		rooms, reason := p.candidateRooms(cs, coursesByID[cs.CourseID], scheduler.SubstituteChain(input.RoomTypes, cs.RequiredRoom))
+		duration := int(*cs.Duration)
+		spread := p.Config.SpreadFor(cs)
+
+		// A pattern suits a session that meets once on each of its days, as in the greedy scheduler
+		var patterns [][]int
+		for _, pattern := range spread.DayPatterns {
+			if len(pattern) != int(*cs.NumberOfSessions) {
+				continue
+			}
+			days := make([]int, len(pattern))
+			for k, day := range pattern {
+				days[k] = int(day)
+			}
+			patterns = append(patterns, days)
+		}
+
+		for k := range int(*cs.NumberOfSessions) {
+			s := &Session{
+				CourseSession: cs,
+				Duration:      duration,
+				Rooms:         rooms,
+				Starts:        p.allowedStarts(cs, p.startTimes(duration)),
+				Instructors:   instructors[cs.ID],
+				Cohorts:       cohorts[cs.CourseID],
+				reason:        reason,
+				spread:        spread,
+				patterns:      patterns,
+			}
+
+			// The first meetings of a course session take its pins, in order
+			if k < len(pins[cs.ID]) {
+				s.Pin = &pins[cs.ID][k]
+			}
+
+			p.meetings[cs.ID] = append(p.meetings[cs.ID], len(p.Sessions))
+			p.Sessions = append(p.Sessions, s)
+		}
+	}
+}
+
+// allowedStarts keeps the start times at which a meeting of the course session lies inside its
+// allowed windows, leaving out days with none
+func (p *Problem) allowedStarts(cs *models.CourseSession, starts map[int][]int) map[int][]int {
+	allowed := make(map[int][]int)
+
+	for day, times := range starts {
+		for _, start := range times {
+			if cs.InAllowedWindows(day, start, start+int(*cs.Duration)) {
+				allowed[day] = append(allowed[day], start)
+			}
+		}
+	}
+
+	return allowed
+}
+
+// candidateRooms returns the rooms of the required type or its substitutes that seat the expected
+// enrollment, in the order of the chain of types and then least wasted capacity first, or the
+// failure reason when there are none
+func (p *Problem) candidateRooms(cs *models.CourseSession, course *models.Course, chain []string) ([]int, string) {
+	enrollment := 0
+	if cs.Enrollment != nil {
+		enrollment = int(*cs.Enrollment)
+	} else if course != nil {
+		enrollment = int(course.Enrollment)
+	}
+
+	ofType := 0
+	var rooms []int
+	for i, room := range p.Rooms {
+		if !slices.Contains(chain, room.Type) {
+			continue
+		}
+		ofType++
+
+		if int(room.Capacity) >= enrollment {
+			rooms = append(rooms, i)
+		}
+	}
+
+	slices.SortStableFunc(rooms, func(a, b int) int {
+		rankA, rankB := slices.Index(chain, p.Rooms[a].Type), slices.Index(chain, p.Rooms[b].Type)
+		if rankA != rankB {
+			return rankA - rankB
+		}
+		return int(p.Rooms[a].Capacity) - int(p.Rooms[b].Capacity)
+	})
+
+	switch {
+	case ofType == 0:
+		return nil, scheduler.ReasonNoRoomsOfType
+	case len(rooms) == 0:
+		return nil, scheduler.ReasonInsufficientCapacity
+	}
+
+	return rooms, scheduler.ReasonNoTimeSlot
+}
+
+// startTimes lists the start times tried for a meeting on each operating day: the opening time
+// of every open range and every slot boundary after it that leaves room for the whole meeting.
+// Days the meeting fits on nowhere are left out.
+func (p *Problem) startTimes(duration int) map[int][]int {
+	step := p.Config.PreferredSlotDuration
+	if step <= 0 {
+		step = defaultStep
+	}
+
+	starts := make(map[int][]int)
+	for day, hours := range p.hours {
+		for _, r := range hours {
+			if r.Start+duration > r.End {
+				continue
+			}
+
+			starts[day] = append(starts[day], r.Start)
+			for start := (r.Start/step + 1) * step; start+duration <= r.End; start += step {
+				starts[day] = append(starts[day], start)
+			}
+		}
+	}
+
+	return starts
+}
+
+// indexLinks ties together the meetings of linked course sessions. Links between two sessions
+// whose meetings are all pinned are left as they are, as scheduler.EnforceLinks does. A session
+// a link keeps on its anchor's days is not spread across the week, as in the greedy scheduler.
+func (p *Problem) indexLinks(input *scheduler.Input) {
+	pinned := func(i int) bool { return p.Sessions[i].Pin != nil }
+
+	for _, l := range input.Links {
+		if l == nil || l.CourseSessionID == l.OtherSessionID {
+			continue
+		}
+
+		anchors, others := p.meetings[l.CourseSessionID], p.meetings[l.OtherSessionID]
+		if len(anchors) == 0 || len(others) == 0 {
+			continue
+		}
+		if !slices.ContainsFunc(anchors, func(i int) bool { return !pinned(i) }) &&
+			!slices.ContainsFunc(others, func(i int) bool { return !pinned(i) }) {
+			continue
+		}
+
+		link := &Link{Kind: l.Kind, Anchors: anchors, Others: others}
+		p.links = append(p.links, link)
+		for _, i := range anchors {
+			p.Sessions[i].anchoring = append(p.Sessions[i].anchoring, link)
+		}
+		for _, i := range others {
+			p.Sessions[i].following = append(p.Sessions[i].following, link)
+			if l.Kind == models.SessionLinkSameDay || l.Kind == models.SessionLinkConsecutive {
+				p.Sessions[i].spread.MinDaysBetween, p.Sessions[i].spread.MaxPerDay = 0, 0
+			}
+		}
+	}
+}
+
+// indexRelations links meetings that share an instructor or cohort, and meetings of the same course
+// (of the same section, when the course has sections); a combined session group's meetings belong
+// to every course it serves. Two meetings of a course are kept as many
+// days apart as the more lenient of their rules asks, which the greedy scheduler also guarantees.
+func (p *Problem) indexRelations() {
+	p.related = make([]map[int]int, len(p.Sessions))
+	p.siblings = make([][]int, len(p.Sessions))
+	p.apart = make([]map[int]int, len(p.Sessions))
+
+	byInstructor := make(map[uuid.UUID][]int)
+	byCohort := make(map[uuid.UUID][]int)
+	byCourse := make(map[[2]uuid.UUID][]int)
+
+	for i, s := range p.Sessions {
+		p.related[i] = make(map[int]int)
+		for _, id := range s.Instructors {
+			byInstructor[id] = append(byInstructor[id], i)
+		}
+		for _, id := range s.Cohorts {
+			byCohort[id] = append(byCohort[id], i)
+		}
+		for _, courseID := range s.CourseSession.Courses() {
+			course := [2]uuid.UUID{courseID, s.CourseSession.SectionID}
+			byCourse[course] = append(byCourse[course], i)
+		}
+	}
+
+	link := func(groups map[uuid.UUID][]int, kind int) {
+		for _, group := range groups {
+			for _, i := range group {
+				for _, j := range group {
+					if i != j {
+						p.related[i][j] |= kind
+					}
+				}
+			}
+		}
+	}
+	link(byInstructor, clashInstructor)
+	link(byCohort, clashCohort)
+
+	for _, group := range byCourse {
+		for _, i := range group {
+			for _, j := range group {
+				if i == j || slices.Contains(p.siblings[i], j) {
+					continue
+				}
+				p.siblings[i] = append(p.siblings[i], j)
+
+				if days := min(p.Sessions[i].spread.MinDaysBetween, p.Sessions[j].spread.MinDaysBetween); days > 0 {
+					if p.apart[i] == nil {
+						p.apart[i] = make(map[int]int)
+					}
+					p.apart[i][j] = days
+				}
+			}
+		}
+	}
+}
+
+// Fits reports whether a meeting may be placed somewhere on its own: a candidate room,
+// within operating hours and the session's allowed windows, and outside room blackouts and
+// instructor unavailability. A pinned meeting fits only at its pin.
+func (p *Problem) Fits(i int, pl Placement) bool {
+	if pin := p.Sessions[i].Pin; pin != nil {
+		return pl == *pin
+	}
+
+	return p.fitsRoom(i, pl) && p.fitsWindows(i, pl) && p.fitsInstructors(i, pl)
+}
+
+func (p *Problem) fitsWindows(i int, pl Placement) bool {
+	s := p.Sessions[i]
+	return s.CourseSession.InAllowedWindows(pl.Day, pl.Start, pl.Start+s.Duration)
+}
+
+func (p *Problem) fitsRoom(i int, pl Placement) bool {
+	s := p.Sessions[i]
+	if !pl.Placed() || !slices.Contains(s.Rooms, pl.Room) || !slices.Contains(p.Days, pl.Day) {
+		return false
+	}
+
+	end := pl.Start + s.Duration
+	open := slices.ContainsFunc(p.hours[pl.Day], func(r scheduler.TimeRange) bool {
+		return r.Start <= pl.Start && end <= r.End
+	})
+	if !open {
+		return false
+	}
+
+	return !overlapsAny(p.roomBlocked[pl.Room][pl.Day], pl.Start, end)
+}
+
+func (p *Problem) fitsInstructors(i int, pl Placement) bool {
+	s := p.Sessions[i]
+	for _, id := range s.Instructors {
+		if overlapsAny(p.unavailable[id][pl.Day], pl.Start, pl.Start+s.Duration) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// clash returns the kinds of conflict between two placed meetings. Meetings in the same room
+// must be MinBreakBetweenSessions apart; meetings sharing an attendee must also leave time to
+// travel when they are in different buildings; meetings of a course must be the days apart its
+// spreading rules ask. Pins never clash with each other; they are checked up front by
+// scheduler.ValidatePins.
+func (p *Problem) clash(i int, pi Placement, j int, pj Placement) int {
+	if !pi.Placed() || !pj.Placed() {
+		return 0
+	}
+
+	if p.Sessions[i].Pin != nil && p.Sessions[j].Pin != nil {
+		return 0
+	}
+
+	kinds := 0
+	if days, exists := p.apart[i][j]; exists && max(pi.Day-pj.Day, pj.Day-pi.Day) < days {
+		kinds |= clashSpread
+	}
+
+	if pi.Day != pj.Day {
+		return kinds
+	}
+
+	brk := p.Config.MinBreakBetweenSessions
+	endI := pi.Start + p.Sessions[i].Duration
+	endJ := pj.Start + p.Sessions[j].Duration
+
+	if pi.Room == pj.Room && !(endI+brk <= pj.Start || endJ+brk <= pi.Start) {
+		kinds |= clashRoom
+	}
+
+	if shared := p.related[i][j]; shared != 0 {
+		from, to := p.Rooms[pi.Room].Building, p.Rooms[pj.Room].Building
+		gapIJ, gapJI := brk, brk
+		if from != to {
+			gapIJ = max(brk, p.TravelTime(from, to))
+			gapJI = max(brk, p.TravelTime(to, from))
+		}
+
+		if !(endI+gapIJ <= pj.Start || endJ+gapJI <= pi.Start) {
+			kinds |= shared
+		}
+	}
+
+	return kinds
+}
+
+// Clashes reports whether meetings i and j cannot take place at the given placements together
+func (p *Problem) Clashes(i int, pi Placement, j int, pj Placement) bool {
+	return p.clash(i, pi, j, pj) != 0
+}
+
+// Conflicts returns the placed meetings that would clash with meeting i at the given placement,
+// break a link with it there or take the day over its course's per-day cap
+func (p *Problem) Conflicts(a Assignment, i int, pl Placement) []int {
+	var conflicts []int
+
+	for j, pj := range a {
+		if j != i && p.clash(i, pl, j, pj) != 0 {
+			conflicts = append(conflicts, j)
+		}
+	}
+
+	if !pl.Placed() {
+		return conflicts
+	}
+
+	for _, j := range slices.Concat(p.linkConflicts(a, i, pl), p.capConflicts(a, i, pl.Day)) {
+		if !slices.Contains(conflicts, j) {
+			conflicts = append(conflicts, j)
+		}
+	}
+
+	return conflicts
+}
+
+// DayAllows reports whether meeting i may join the meetings of its course placed on the given day
+// without going over the per-day cap
+func (p *Problem) DayAllows(a Assignment, i int, day int) bool {
+	return len(p.capConflicts(a, i, day)) == 0
+}
+
+// capConflicts returns the placed meetings of the course that would have to leave the day for
+// meeting i to join them within the per-day caps. The caps hold the way the greedy scheduler keeps
+// them: at most c of a day's meetings have a cap of c or less. Pinned meetings always join.
+func (p *Problem) capConflicts(a Assignment, i int, day int) []int {
+	capOf := func(j int) int { return p.Sessions[j].spread.MaxPerDay }
+	if p.Sessions[i].Pin != nil || capOf(i) <= 0 {
+		return nil
+	}
+
+	var onDay []int
+	for _, j := range p.siblings[i] {
+		if a[j].Placed() && a[j].Day == day && capOf(j) > 0 {
+			onDay = append(onDay, j)
+		}
+	}
+	slices.SortStableFunc(onDay, func(x, y int) int { return capOf(x) - capOf(y) })
+
+	// Taking out the tightest caps first frees the most room
+	var conflicts []int
+	for {
+		caps := []int{capOf(i)}
+		for _, j := range onDay {
+			caps = append(caps, capOf(j))
+		}
+		if capsHold(caps) {
+			return conflicts
+		}
+
+		conflicts = append(conflicts, onDay[0])
+		onDay = onDay[1:]
+	}
+}
+
+// capsHold reports whether, for every c, at most c of the caps are c or less
+func capsHold(caps []int) bool {
+	slices.Sort(caps)
+
+	for k, c := range caps {
+		if k >= c {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Anchoring returns the links whose anchor meeting i belongs to
+func (p *Problem) Anchoring(i int) []*Link {
+	return p.Sessions[i].anchoring
+}
+
+// LinkAllows reports whether a meeting of the link's other session may take place at pl, given
+// the anchor's meetings placed in a. Links to an anchor with no meetings placed are always satisfied.
+func (p *Problem) LinkAllows(l *Link, a Assignment, pl Placement) bool {
+	return p.linkAllows(l, a, -1, Unplaced, pl)
+}
+
+// linkAllows is LinkAllows with anchor meeting moved taken to be at instead
+func (p *Problem) linkAllows(l *Link, a Assignment, moved int, at, pl Placement) bool {
+	duration := p.Sessions[l.Anchors[0]].Duration
+	brk := p.Config.MinBreakBetweenSessions
+
+	placed, firstEnd := false, 0
+	for _, k := range l.Anchors {
+		anchor := a[k]
+		if k == moved {
+			anchor = at
+		}
+		if !anchor.Placed() {
+			continue
+		}
+
+		if end := weekMinute(anchor.Day, anchor.Start+duration); !placed || end < firstEnd {
+			firstEnd = end
+		}
+		placed = true
+
+		switch {
+		case l.Kind == models.SessionLinkSameDay && anchor.Day == pl.Day,
+			l.Kind == models.SessionLinkConsecutive && anchor.Day == pl.Day && anchor.Start+duration+brk == pl.Start,
+			l.Kind == models.SessionLinkSameRoom && anchor.Room == pl.Room:
+			return true
+		case l.Kind == models.SessionLinkDifferentDay && anchor.Day == pl.Day:
+			return false
+		}
+	}
+
+	switch {
+	case !placed:
+		return true
+	case l.Kind == models.SessionLinkBefore:
+		return weekMinute(pl.Day, pl.Start) >= firstEnd
+	case l.Kind == models.SessionLinkSameDay, l.Kind == models.SessionLinkConsecutive, l.Kind == models.SessionLinkSameRoom:
+		return false
+	default:
+		return true
+	}
+}
+
+// linkConflicts returns the placed meetings that would break a link with meeting i at pl: the
+// anchor's meetings in its way, or the meetings following it that the move would leave behind
+func (p *Problem) linkConflicts(a Assignment, i int, pl Placement) []int {
+	var conflicts []int
+
+	for _, l := range p.Sessions[i].following {
+		if p.LinkAllows(l, a, pl) {
+			continue
+		}
+
+		// Only the anchor's meetings ending too late or on the same day are in the way of the
+		// ordering links; the rest must all go before the link is satisfied by no anchor at all
+		duration := p.Sessions[l.Anchors[0]].Duration
+		for _, k := range l.Anchors {
+			anchor := a[k]
+			switch {
+			case !anchor.Placed(),
+				l.Kind == models.SessionLinkBefore && weekMinute(anchor.Day, anchor.Start+duration) <= weekMinute(pl.Day, pl.Start),
+				l.Kind == models.SessionLinkDifferentDay && anchor.Day != pl.Day:
+				continue
+			}
+			conflicts = append(conflicts, k)
+		}
+	}
+
+	for _, l := range p.Sessions[i].anchoring {
+		for _, j := range l.Others {
+			if a[j].Placed() && !p.linkAllows(l, a, i, pl, a[j]) {
+				conflicts = append(conflicts, j)
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// BrokenLinks counts the placed meetings that break a link to their anchor's meetings
+func (p *Problem) BrokenLinks(a Assignment) int {
+	broken := 0
+
+	for _, l := range p.links {
+		for _, j := range l.Others {
+			if a[j].Placed() && !p.LinkAllows(l, a, a[j]) {
+				broken++
+			}
+		}
+	}
+
+	return broken
+}
+
+// conflictKinds combines every kind of clash meeting i would have at the given placement
+func (p *Problem) conflictKinds(a Assignment, i int, pl Placement) int {
+	kinds := 0
+
+	for j, pj := range a {
+		if j != i {
+			kinds |= p.clash(i, pl, j, pj)
+		}
+	}
+
+	if !p.fitsInstructors(i, pl) {
+		kinds |= clashInstructor
+	}
+
+	if len(p.linkConflicts(a, i, pl)) > 0 {
+		kinds |= clashLink
+	}
+
+	if len(p.capConflicts(a, i, pl.Day)) > 0 {
+		kinds |= clashSpread
+	}
+
+	return kinds
+}
+
+// TravelTime returns the minutes needed between two buildings,
+// falling back to the reverse trip when only that direction is recorded
+func (p *Problem) TravelTime(from, to uuid.UUID) int {
+	if m, exists := p.travel[from][to]; exists {
+		return m
+	}
+
+	return p.travel[to][from]
+}
+
+// Domain lists every placement of meeting i that Fits, ordered by day, start time and room.
+// A pinned meeting's only placement is its pin.
+func (p *Problem) Domain(i int) []Placement {
+	if pin := p.Sessions[i].Pin; pin != nil {
+		return []Placement{*pin}
+	}
+
+	var domain []Placement
+
+	for _, day := range p.Days {
+		for _, start := range p.Sessions[i].Starts[day] {
+			for _, room := range p.Sessions[i].Rooms {
+				pl := Placement{Room: room, Day: day, Start: start}
+				if p.Fits(i, pl) {
+					domain = append(domain, pl)
+				}
+			}
+		}
+	}
+
+	return domain
+}
+
+// Related reports whether meetings i and j share an instructor or cohort
+func (p *Problem) Related(i, j int) bool {
+	return p.related[i][j] != 0
+}
+
+// OffPattern reports whether putting meeting i on the given day leaves its course session's days
+// outside every day pattern that suits it. Sessions without such patterns are never off them.
+func (p *Problem) OffPattern(a Assignment, i int, day int) bool {
+	days := []int{day}
+	for _, j := range p.meetings[p.Sessions[i].CourseSession.ID] {
+		if j != i && a[j].Placed() {
+			days = append(days, a[j].Day)
+		}
+	}
+
+	return !p.fitsPattern(i, days)
+}
+
+// fitsPattern reports whether one of the day patterns suiting meeting i's session covers every day
+func (p *Problem) fitsPattern(i int, days []int) bool {
+	patterns := p.Sessions[i].patterns
+	if len(patterns) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(patterns, func(pattern []int) bool {
+		return !slices.ContainsFunc(days, func(day int) bool { return !slices.Contains(pattern, day) })
+	})
+}
+
+// SameDaySiblings counts the placed meetings of the same course as meeting i on the given day
+func (p *Problem) SameDaySiblings(a Assignment, i int, day int) int {
+	count := 0
+
+	for _, j := range p.siblings[i] {
+		if a[j].Placed() && a[j].Day == day {
+			count++
+		}
+	}
+
+	return count
+}
+
+// RandomPlacement picks a candidate room, operating day and start time for meeting i,
+// or its pin when it has one. It returns Unplaced when the meeting has no candidates at all.
+func (p *Problem) RandomPlacement(i int, rng *rand.Rand) Placement {
+	s := p.Sessions[i]
+	if s.Pin != nil {
+		return *s.Pin
+	}
+
+	days := slices.DeleteFunc(slices.Clone(p.Days), func(day int) bool { return len(s.Starts[day]) == 0 })
+	if len(s.Rooms) == 0 || len(days) == 0 {
+		return Unplaced
+	}
+
+	day := days[rng.Intn(len(days))]
+	return Placement{
+		Room:  s.Rooms[rng.Intn(len(s.Rooms))],
+		Day:   day,
+		Start: s.Starts[day][rng.Intn(len(s.Starts[day]))],
+	}
+}
+
+// PreferenceViolations counts the instructors of meeting i whose preferred windows
+// do not cover the given placement, plus how far it misses the session's own heaviest
+// preferred window (see models.CourseSession.PreferenceMiss)
+func (p *Problem) PreferenceViolations(i int, pl Placement) int {
+	if !pl.Placed() {
+		return 0
+	}
+
+	s := p.Sessions[i]
+	end := pl.Start + s.Duration
+	violations := s.CourseSession.PreferenceMiss(pl.Day, pl.Start, end)
+
+	for _, id := range s.Instructors {
+		windows, exists := p.preferred[id]
+		if !exists {
+			continue
+		}
+
+		covered := slices.ContainsFunc(windows[pl.Day], func(r scheduler.TimeRange) bool {
+			return r.Start <= pl.Start && end <= r.End
+		})
+		if !covered {
+			violations++
+		}
+	}
+
+	return violations
+}
+
+// Substituted reports whether placement pl puts meeting i in a substitute for its required room type
+func (p *Problem) Substituted(i int, pl Placement) bool {
+	return pl.Placed() && p.Rooms[pl.Room].Type != p.Sessions[i].CourseSession.RequiredRoom
+}
+
+// Cost scores an assignment; lower is better. Unplaced meetings dominate, followed by
+// preference violations, meetings of one course stacked on the same day, course sessions off
+// their day patterns and meetings in substitute room types.
+func (p *Problem) Cost(a Assignment) int {
+	cost := 0
+
+	for _, meetings := range p.meetings {
+		var days []int
+		for _, i := range meetings {
+			if a[i].Placed() {
+				days = append(days, a[i].Day)
+			}
+		}
+
+		if !p.fitsPattern(meetings[0], days) {
+			cost += PatternCost
+		}
+	}
+
+	for i, pl := range a {
+		if !pl.Placed() {
+			cost += UnplacedCost
+			continue
+		}
+
+		cost += PreferenceCost * p.PreferenceViolations(i, pl)
+		if p.Substituted(i, pl) {
+			cost += SubstituteCost
+		}
+
+		// Count each same-day pair once
+		for _, j := range p.siblings[i] {
+			if j > i && a[j].Placed() && a[j].Day == pl.Day {
+				cost += SameDayCost
+			}
+		}
+	}
+
+	return cost
+}
+
+// Violations counts the hard constraints an assignment breaks: meetings that do not fit
+// their placement, pairs of meetings that clash, meetings that break a link and meetings on a
+// day over their course's cap. Valid assignments have none.
+func (p *Problem) Violations(a Assignment) int {
+	violations := p.BrokenLinks(a)
+
+	for i, pl := range a {
+		if !pl.Placed() {
+			continue
+		}
+
+		if !p.Fits(i, pl) {
+			violations++
+		}
+
+		if !p.DayAllows(a, i, pl.Day) {
+			violations++
+		}
+
+		for j := i + 1; j < len(a); j++ {
+			if p.clash(i, pl, j, a[j]) != 0 {
+				violations++
+			}
+		}
+	}
+
+	return violations
+}
+
+// FromOutput rebuilds an assignment from a scheduler's output, matching scheduled sessions
+// to meetings by course session. Anything that cannot be matched stays unplaced.
+func (p *Problem) FromOutput(output *scheduler.Output) Assignment {
+	a := make(Assignment, len(p.Sessions))
+	open := make(map[uuid.UUID][]int)
+	for i, s := range p.Sessions {
+		a[i] = Unplaced
+		open[s.CourseSession.ID] = append(open[s.CourseSession.ID], i)
+	}
+
+	if output == nil {
+		return a
+	}
+
+	for _, ss := range output.ScheduledSessions {
+		if ss == nil {
+			continue
+		}
+
+		room, exists := p.roomIndex[ss.RoomID]
+		queue := open[ss.CourseSessionID]
+		if !exists || len(queue) == 0 {
+			continue
+		}
+
+		a[queue[0]] = Placement{Room: room, Day: ss.Day, Start: ss.StartTime}
+		open[ss.CourseSessionID] = queue[1:]
+	}
+
+	return a
+}
+
+// Output converts an assignment into scheduler output, reporting one failure per course session
+func (p *Problem) Output(a Assignment) *scheduler.Output {
+	output := &scheduler.Output{}
+	failed := make(map[uuid.UUID]bool)
+
+	for i, pl := range a {
+		s := p.Sessions[i]
+
+		if !pl.Placed() {
+			if !failed[s.CourseSession.ID] {
+				failed[s.CourseSession.ID] = true
+				output.Failures = append(output.Failures, &scheduler.FailedSession{
+					CourseSession: s.CourseSession,
+					Reason:        p.Diagnose(a, i),
+				})
+			}
+			continue
+		}
+
+		output.ScheduledSessions = append(output.ScheduledSessions, &models.ScheduledSession{
+			CourseID:        s.CourseSession.CourseID,
+			CourseSessionID: s.CourseSession.ID,
+			SectionID:       s.CourseSession.SectionID,
+			RoomID:          p.Rooms[pl.Room].ID,
+			Day:             pl.Day,
+			StartTime:       pl.Start,
+			EndTime:         pl.Start + s.Duration,
+			Substituted:     p.Substituted(i, pl),
+		})
+		output.PreferenceViolations += p.PreferenceViolations(i, pl)
+	}
+
+	return output
+}
+
+// Diagnose explains why meeting i could not be placed alongside the rest of the assignment,
+// checking rooms, then instructors, then cohorts, then links, then spreading rules like the greedy
+// scheduler does
+func (p *Problem) Diagnose(a Assignment, i int) string {
+	s := p.Sessions[i]
+	if len(s.Rooms) == 0 {
+		return s.reason
+	}
+	if len(s.Starts) == 0 {
+		if len(p.startTimes(s.Duration)) > 0 {
+			return scheduler.ReasonAllowedWindows
+		}
+		return scheduler.ReasonDurationTooLong
+	}
+
+	roomFree, instructorsFree, cohortsFree, linksFree := false, false, false, false
+	for _, room := range s.Rooms {
+		for _, day := range p.Days {
+			for _, start := range s.Starts[day] {
+				pl := Placement{Room: room, Day: day, Start: start}
+				if !p.fitsRoom(i, pl) || !p.fitsWindows(i, pl) {
+					continue
+				}
+
+				kinds := p.conflictKinds(a, i, pl)
+				if kinds == 0 {
+					return scheduler.ReasonNoTimeSlot
+				}
+				roomFree = roomFree || kinds&clashRoom == 0
+				instructorsFree = instructorsFree || kinds&(clashRoom|clashInstructor) == 0
+				cohortsFree = cohortsFree || kinds&(clashRoom|clashInstructor|clashCohort) == 0
+				linksFree = linksFree || kinds&(clashRoom|clashInstructor|clashCohort|clashLink) == 0
+			}
+		}
+	}
+
+	switch {
+	case !roomFree:
+		return scheduler.ReasonNoTimeSlot
+	case !instructorsFree:
+		return scheduler.ReasonInstructorConflict
+	case !cohortsFree:
+		return scheduler.ReasonCohortClash
+	case !linksFree:
+		return scheduler.ReasonLinkConflict
+	default:
+		return scheduler.ReasonSpreadRule
+	}
+}
+
+// overlapsAny reports whether [start, end) overlaps any of the ranges
+func overlapsAny(ranges []scheduler.TimeRange, start, end int) bool {
+	return slices.ContainsFunc(ranges, func(r scheduler.TimeRange) bool {
+		return start < r.End && r.Start < end
+	})
+}
+
+// weekMinute orders times across the week
+func weekMinute(day, minute int) int {
+	return day*models.MinutesPerDay + minute
+}
@@ -0,0 +1,35 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+)
+
+// Progress reports how far a scheduler has got: the best timetable it has found so far places
+// Placed of the Total meetings in the input. Search-based schedulers report whenever their best
+// timetable improves, so Placed can reach Total long before Generate returns.
+type Progress struct {
+	Placed int `json:"placed"`
+	Total  int `json:"total"`
+}
+
+// ProgressFunc receives progress reports. Reports never overlap, but may be made from
+// goroutines other than the one calling Generate, so the function should return quickly.
+type ProgressFunc func(Progress)
+
+// ReportProgress passes a progress report to the input's Progress function, if any
+func (in *Input) ReportProgress(placed, total int) {
+	if in.Progress != nil {
+		in.Progress(Progress{Placed: placed, Total: total})
+	}
+}
+
+// StopStatus returns the status of a search stopped because ctx is done: timed out when its
+// deadline passed, canceled otherwise
+func StopStatus(ctx context.Context) Status {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return StatusTimedOut
+	}
+
+	return StatusCanceled
+}
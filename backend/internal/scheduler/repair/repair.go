@@ -0,0 +1,321 @@
+// Package repair fixes a saved timetable after the data behind it has changed, moving as few
+// sessions as possible.
+//
+// Every saved session that still fits its room, day and start time, and neither clashes with nor
+// breaks a link to anything kept before it, stays where it is. Only the sessions that no longer fit (a deleted room, a new
+// blackout, a changed duration and so on) are re-placed, each at the free placement nearest to
+// where it was. Meetings added since the schedule was saved are placed the same way.
+package repair
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/problem"
+)
+
+// Reasons a saved session was changed
+const (
+	ReasonSessionRemoved  = "course session no longer exists or needs fewer meetings"
+	ReasonRoomRemoved     = "room no longer exists"
+	ReasonNoLongerFits    = "room, operating hours, allowed windows or instructor availability no longer allow the placement"
+	ReasonDurationChanged = "session duration changed"
+	ReasonClash           = "clashes with a session that was kept, or breaks a link to one"
+	ReasonPinned          = "session is pinned elsewhere"
+	ReasonNewMeeting      = "meeting is not in the saved schedule"
+)
+
+// Change is one session that was moved, added or removed by a repair
+type Change struct {
+	CourseSessionID uuid.UUID
+	SectionID       uuid.UUID                // uuid.Nil unless the course has sections
+	Old             *models.ScheduledSession // nil when the meeting was not in the saved schedule
+	New             *models.ScheduledSession // nil when the meeting was dropped or could not be re-placed
+	Reason          string
+}
+
+// Result is a repaired timetable and the changes made to the saved one
+type Result struct {
+	Output  *scheduler.Output
+	Changes []*Change
+}
+
+// Repair re-places the saved sessions that are no longer valid for the input, keeping every
+// other session fixed. Sessions that cannot be re-placed are reported as failures in the output.
+func Repair(input *scheduler.Input, saved []models.ScheduledSession) (*Result, error) {
+	if err := scheduler.ValidatePins(input); err != nil {
+		return nil, err
+	}
+
+	p := problem.New(input)
+	old, removed := match(p, saved)
+
+	result := &Result{}
+	for _, ss := range removed {
+		result.Changes = append(result.Changes, &Change{
+			CourseSessionID: ss.CourseSessionID,
+			Old:             ss,
+			Reason:          ReasonSessionRemoved,
+		})
+	}
+
+	a, displaced := keep(p, old)
+	for _, i := range order(p, displaced) {
+		a[i] = nearest(p, a, i, old[i])
+	}
+
+	result.Output = p.Output(a)
+	result.Changes = append(result.Changes, changes(p, a, old)...)
+
+	return result, nil
+}
+
+// match pairs each meeting with the saved session it came from, returning the saved session per
+// meeting (nil for new meetings) and the saved sessions no meeting needs any more. A pinned meeting
+// takes a saved session already at its pin when there is one; the rest are paired in saved order.
+// Sessions saved without a course session are matched by course, preferring the same duration.
+func match(p *problem.Problem, saved []models.ScheduledSession) ([]*models.ScheduledSession, []*models.ScheduledSession) {
+	old := make([]*models.ScheduledSession, len(p.Sessions))
+	bySession := make(map[uuid.UUID][]*models.ScheduledSession)
+	var legacy, removed []*models.ScheduledSession
+
+	for k := range saved {
+		ss := &saved[k]
+		if ss.CourseSessionID == uuid.Nil {
+			legacy = append(legacy, ss)
+			continue
+		}
+		bySession[ss.CourseSessionID] = append(bySession[ss.CourseSessionID], ss)
+	}
+
+	for i, s := range p.Sessions {
+		if s.Pin == nil {
+			continue
+		}
+
+		queue := bySession[s.CourseSession.ID]
+		k := slices.IndexFunc(queue, func(ss *models.ScheduledSession) bool {
+			return placementOf(p, ss) == *s.Pin
+		})
+		if k >= 0 {
+			old[i] = queue[k]
+			bySession[s.CourseSession.ID] = slices.Delete(queue, k, k+1)
+		}
+	}
+
+	for i, s := range p.Sessions {
+		queue := bySession[s.CourseSession.ID]
+		if old[i] != nil || len(queue) == 0 {
+			continue
+		}
+		old[i] = queue[0]
+		bySession[s.CourseSession.ID] = queue[1:]
+	}
+
+	for _, ss := range legacy {
+		best := -1
+		for i, s := range p.Sessions {
+			if old[i] != nil || s.CourseSession.CourseID != ss.CourseID {
+				continue
+			}
+			if best < 0 || s.Duration == ss.EndTime-ss.StartTime {
+				best = i
+			}
+			if s.Duration == ss.EndTime-ss.StartTime {
+				break
+			}
+		}
+
+		if best < 0 {
+			removed = append(removed, ss)
+			continue
+		}
+		old[best] = ss
+	}
+
+	for k := range saved {
+		ss := &saved[k]
+		if ss.CourseSessionID == uuid.Nil {
+			continue
+		}
+		if slices.Contains(bySession[ss.CourseSessionID], ss) {
+			removed = append(removed, ss)
+		}
+	}
+
+	return old, removed
+}
+
+// keep fixes every pinned meeting at its pin, then every saved session that still fits and
+// clashes with nothing kept before it. It returns the assignment and the meetings left to place.
+func keep(p *problem.Problem, old []*models.ScheduledSession) (problem.Assignment, []int) {
+	a := make(problem.Assignment, len(p.Sessions))
+	for i, s := range p.Sessions {
+		a[i] = problem.Unplaced
+		if s.Pin != nil {
+			a[i] = *s.Pin
+		}
+	}
+
+	var displaced []int
+	for i, s := range p.Sessions {
+		if s.Pin != nil {
+			continue
+		}
+
+		pl := placementOf(p, old[i])
+		if old[i] != nil && p.Fits(i, pl) && len(p.Conflicts(a, i, pl)) == 0 {
+			a[i] = pl
+			continue
+		}
+		displaced = append(displaced, i)
+	}
+
+	return a, displaced
+}
+
+// order places the meetings with the fewest choices first
+func order(p *problem.Problem, displaced []int) []int {
+	sizes := make(map[int]int, len(displaced))
+	for _, i := range displaced {
+		sizes[i] = len(p.Domain(i))
+	}
+
+	sorted := slices.Clone(displaced)
+	slices.SortStableFunc(sorted, func(i, j int) int {
+		return cmp.Compare(sizes[i], sizes[j])
+	})
+
+	return sorted
+}
+
+// nearest returns the free placement of meeting i closest to where it was saved: the same day
+// first, then the nearest start time, keeping instructors in their preferred windows and courses
+// spread across the week on ties. It returns problem.Unplaced when nothing is free.
+func nearest(p *problem.Problem, a problem.Assignment, i int, ss *models.ScheduledSession) problem.Placement {
+	var candidates []problem.Placement
+	for _, pl := range p.Domain(i) {
+		if len(p.Conflicts(a, i, pl)) == 0 {
+			candidates = append(candidates, pl)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return problem.Unplaced
+	}
+
+	if ss == nil {
+		ss = &models.ScheduledSession{Day: -1}
+	}
+	distance := func(pl problem.Placement) (int, int) {
+		day := 0
+		if pl.Day != ss.Day {
+			day = 1
+		}
+		return day, abs(pl.Start - ss.StartTime)
+	}
+	penalty := func(pl problem.Placement) int {
+		return problem.PreferenceCost*p.PreferenceViolations(i, pl) +
+			problem.SameDayCost*p.SameDaySiblings(a, i, pl.Day)
+	}
+
+	return slices.MinFunc(candidates, func(x, y problem.Placement) int {
+		dayX, startX := distance(x)
+		dayY, startY := distance(y)
+		return cmp.Or(cmp.Compare(dayX, dayY), cmp.Compare(startX, startY), cmp.Compare(penalty(x), penalty(y)))
+	})
+}
+
+// changes lists every meeting whose placement differs from the saved one, in meeting order
+func changes(p *problem.Problem, a problem.Assignment, old []*models.ScheduledSession) []*Change {
+	var list []*Change
+
+	for i, pl := range a {
+		s := p.Sessions[i]
+
+		var next *models.ScheduledSession
+		if pl.Placed() {
+			next = &models.ScheduledSession{
+				CourseID:        s.CourseSession.CourseID,
+				CourseSessionID: s.CourseSession.ID,
+				SectionID:       s.CourseSession.SectionID,
+				RoomID:          p.Rooms[pl.Room].ID,
+				Day:             pl.Day,
+				StartTime:       pl.Start,
+				EndTime:         pl.Start + s.Duration,
+				Substituted:     p.Substituted(i, pl),
+			}
+		}
+
+		if old[i] != nil && next != nil && old[i].Equal(*next) {
+			continue
+		}
+		// Sessions saved without a course session are unchanged when only that link is filled in
+		if old[i] != nil && next != nil && old[i].CourseSessionID == uuid.Nil {
+			legacy := *next
+			legacy.CourseSessionID = uuid.Nil
+			if old[i].Equal(legacy) {
+				continue
+			}
+		}
+
+		list = append(list, &Change{
+			CourseSessionID: s.CourseSession.ID,
+			SectionID:       s.CourseSession.SectionID,
+			Old:             old[i],
+			New:             next,
+			Reason:          reason(p, i, old[i]),
+		})
+	}
+
+	return list
+}
+
+// reason explains why meeting i moved from its saved session
+func reason(p *problem.Problem, i int, ss *models.ScheduledSession) string {
+	s := p.Sessions[i]
+	pl := placementOf(p, ss)
+
+	switch {
+	case ss == nil:
+		return ReasonNewMeeting
+	case !pl.Placed():
+		return ReasonRoomRemoved
+	case s.Pin != nil:
+		return ReasonPinned
+	case ss.EndTime-ss.StartTime != s.Duration:
+		return ReasonDurationChanged
+	case !p.Fits(i, pl):
+		return ReasonNoLongerFits
+	default:
+		return ReasonClash
+	}
+}
+
+// placementOf returns where a saved session takes place, or problem.Unplaced when it is
+// missing or its room no longer exists
+func placementOf(p *problem.Problem, ss *models.ScheduledSession) problem.Placement {
+	if ss == nil {
+		return problem.Unplaced
+	}
+
+	room := slices.IndexFunc(p.Rooms, func(r *models.Room) bool {
+		return r.ID == ss.RoomID
+	})
+	if room < 0 {
+		return problem.Unplaced
+	}
+
+	return problem.Placement{Room: room, Day: ss.Day, Start: ss.StartTime}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
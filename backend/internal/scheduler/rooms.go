@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// RoomSelection is the policy the greedy scheduler uses to choose among the rooms that can
+// host a session on a day. Every policy only considers rooms of the required type that seat
+// the expected enrollment.
+type RoomSelection string
+
+const (
+	// RoomSelectionBestFit tries the room with the fewest spare seats first (the default)
+	RoomSelectionBestFit RoomSelection = "best_fit"
+
+	// RoomSelectionFirstFit tries rooms in the order they are listed
+	RoomSelectionFirstFit RoomSelection = "first_fit"
+
+	// RoomSelectionLeastUtilised tries the room booked for the fewest minutes so far first,
+	// spreading wear and cleaning load across rooms
+	RoomSelectionLeastUtilised RoomSelection = "least_utilised"
+
+	// RoomSelectionSameBuilding tries rooms in buildings where the course already meets first
+	RoomSelectionSameBuilding RoomSelection = "same_building"
+)
+
+// RoomSelections lists every supported room selection policy
+var RoomSelections = []RoomSelection{
+	RoomSelectionBestFit,
+	RoomSelectionFirstFit,
+	RoomSelectionLeastUtilised,
+	RoomSelectionSameBuilding,
+}
+
+// ErrUnknownRoomSelection is returned when the config names a room selection policy that does not exist
+var ErrUnknownRoomSelection = errors.New("unknown room selection policy")
+
+// Validate returns an error for an unknown policy. The empty policy is best fit.
+func (r RoomSelection) Validate() error {
+	if r != "" && !slices.Contains(RoomSelections, r) {
+		return fmt.Errorf("%w: %q", ErrUnknownRoomSelection, r)
+	}
+
+	return nil
+}
+
+// SubstituteChain returns the room types a session requiring roomType may meet in, in order of
+// preference: roomType itself, then its substitutes, then theirs, and so on, each type once
+func SubstituteChain(roomTypes []*models.RoomType, roomType string) []string {
+	substitutes := make(map[string][]string, len(roomTypes))
+	for _, rt := range roomTypes {
+		if rt != nil {
+			substitutes[rt.Name] = rt.Substitutes
+		}
+	}
+
+	chain := []string{roomType}
+	for i := 0; i < len(chain); i++ {
+		for _, substitute := range substitutes[chain[i]] {
+			if !slices.Contains(chain, substitute) {
+				chain = append(chain, substitute)
+			}
+		}
+	}
+
+	return chain
+}
@@ -1,6 +1,10 @@
 package scheduler
 
-import "github.com/TerrenceMurray/course-scheduler/internal/models"
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
 
 // Day represents a day of the week (0 = Monday, 6 = Sunday)
 type Day int
@@ -15,7 +19,8 @@ const (
 	Sunday
 )
 
-// DefaultConfig returns a standard Mon-Fri, 8AM-9PM configuration
+// DefaultConfig returns a standard Mon-Fri, 8AM-9PM configuration with the same hours every day
+// and no blocked windows
 func DefaultConfig() *Config {
 	return &Config{
 		OperatingHours: TimeRange{
@@ -34,18 +39,48 @@ type Config struct {
 	// OperatingDays defines which days sessions can be scheduled
 	OperatingDays []Day
 
+	// DayHours gives individual days their own opening ranges (several per day) in place of
+	// OperatingHours. A day listed here is an operating day even when missing from OperatingDays;
+	// a day listed with no ranges is closed.
+	DayHours map[Day][]TimeRange
+
+	// BlockedWindows are closed to sessions on every day, e.g. a common lunch hour
+	BlockedWindows []TimeRange
+
 	// MinBreakBetweenSessions is the minimum gap between sessions (in minutes)
-	// Useful for travel time between buildings, etc.
+	// Travel between buildings is covered separately by Input.TravelTimes
 	MinBreakBetweenSessions int
 
 	// PreferredSlotDuration helps align sessions to consistent start times (e.g., 60 = hourly slots)
 	// Set to 0 to disable
 	PreferredSlotDuration int
+
+	// MinDaysBetweenSessions keeps meetings of the same course at least this many days apart
+	// Set to 0 to disable
+	MinDaysBetweenSessions int
+
+	// MaxSessionsPerDay caps how many meetings of the same course fall on one day
+	// Set to 0 for no cap; meetings still go on separate days while there are enough days left
+	MaxSessionsPerDay int
+
+	// DayPatterns are preferred sets of days for a course's meetings, e.g. Mon/Wed/Fri or Tue/Thu.
+	// A session meeting as many times as a pattern has days tries that pattern's days first.
+	// Course sessions can override each spreading rule with their own.
+	DayPatterns [][]Day
+
+	// RoomSelection chooses which free room the greedy scheduler takes; empty is best fit
+	RoomSelection RoomSelection
+
+	// Seed, when set, breaks ties between equally good choices in a random order drawn from it
+	// instead of the fixed tie-breakers. The same input and seed always give the same schedule.
+	Seed *int64
 }
 
-// Scheduler generates schedules from inputs
+// Scheduler generates schedules from inputs. Generate stops early when ctx is canceled or its
+// deadline passes, returning the best partial timetable found so far with Output.Status set to
+// canceled or timed_out; pins that cannot be honoured are still an error.
 type Scheduler interface {
-	Generate(input *Input) (*Output, error)
+	Generate(ctx context.Context, input *Input) (*Output, error)
 }
 
 // Input contains everything needed to generate a schedule
@@ -54,20 +89,150 @@ type Input struct {
 	Rooms          []*models.Room
 	Courses        []*models.Course
 	CourseSessions []*models.CourseSession
+
+	// RoomTypes declare the substitutes each room type may fall back to. A session is placed in a
+	// room of its required type when one is free, and otherwise along the chain of substitutes.
+	RoomTypes []*models.RoomType
+
+	// RoomUnavailability lists weekly blackout windows removed from each room's availability
+	RoomUnavailability []*models.RoomUnavailability
+
+	// TravelTimes gives the minutes needed between buildings. Consecutive sessions for the same
+	// instructor or cohort in different buildings are kept at least this far apart.
+	TravelTimes []*models.BuildingTravelTime
+
+	// InstructorAssignments links course sessions to the instructors teaching them.
+	// An instructor is never scheduled in two places at once.
+	InstructorAssignments []*models.InstructorAssignment
+
+	// InstructorAvailability holds weekly windows when instructors are unavailable (never scheduled)
+	// or prefer to teach (favoured when there is a choice)
+	InstructorAvailability []*models.InstructorAvailability
+
+	// Cohorts group courses taken by the same students.
+	// A cohort can only attend one session at a time.
+	Cohorts []*models.Cohort
+
+	// Pins fix meetings of course sessions to a room, day and start time. They are placed first,
+	// as given, even outside operating hours; pins that cannot all be honoured are an error.
+	Pins []*models.SessionPin
+
+	// Links place course sessions relative to each other (before, same day, different day,
+	// consecutive, same room). A meeting that cannot satisfy its links is reported as a failure.
+	Links []*models.SessionLink
+
+	// Progress, when set, receives progress reports as the timetable is built (see Progress)
+	Progress ProgressFunc
+}
+
+// Meetings counts the meetings of every course session in the input
+func (in *Input) Meetings() int {
+	total := 0
+
+	for _, cs := range in.CourseSessions {
+		if cs != nil && cs.NumberOfSessions != nil {
+			total += int(*cs.NumberOfSessions)
+		}
+	}
+
+	return total
 }
 
 // Output contains the generated sessions
 type Output struct {
-	ScheduledSessions []*models.ScheduledSession
-	Failures          []*FailedSession
+	ScheduledSessions []*models.ScheduledSession `json:"scheduled_sessions"`
+	Failures          []*FailedSession           `json:"failures,omitempty"`
+
+	// PreferenceViolations counts scheduled sessions placed outside an assigned
+	// instructor's preferred windows, once per instructor, plus how far each misses
+	// its course session's heaviest preferred window (1 outside windows of weight 1)
+	PreferenceViolations int `json:"preference_violations"`
+
+	// Status is set by schedulers that can prove something about their result or that were
+	// stopped early, empty otherwise
+	Status Status `json:"status,omitempty"`
+
+	// FitnessHistory is the best fitness of each generation for population-based schedulers
+	// (lower is better), empty otherwise
+	FitnessHistory []int `json:"fitness_history,omitempty"`
+
+	// Score rates the scheduled sessions against weighted soft constraints when scored
+	Score *Score `json:"score,omitempty"`
+
+	// Seed is the Config.Seed the schedule was generated with, nil when ties were broken
+	// by the fixed tie-breakers; generating again with it reproduces the schedule exactly
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Variants summarises every run of a portfolio scheduler in the order they were planned,
+	// empty otherwise
+	Variants []*Variant `json:"variants,omitempty"`
+}
+
+// Variant summarises one run of a portfolio scheduler: the settings it tried and how well
+// the timetable it produced did
+type Variant struct {
+	Strategy      string        `json:"strategy"`         // weight strategy name
+	RoomSelection RoomSelection `json:"room_selection"`   // room selection policy
+	Seed          *int64        `json:"seed,omitempty"`   // tie-break seed, nil for the fixed tie-breakers
+	Failures      int           `json:"failures"`         // meetings that could not be placed
+	Score         float64       `json:"score"`            // soft constraint score total; lower is better
+	Status        Status        `json:"status,omitempty"` // canceled or timed_out when the run was stopped part way
+	Best          bool          `json:"best"`             // this run's timetable was returned
+}
+
+// Score rates a timetable against weighted soft constraints; lower is better
+type Score struct {
+	Total     float64            `json:"total"`
+	Breakdown []*ConstraintScore `json:"breakdown"`
+}
+
+// ConstraintScore is one soft constraint's share of a Score
+type ConstraintScore struct {
+	Name    string  `json:"name"`
+	Weight  float64 `json:"weight"`
+	Penalty float64 `json:"penalty"` // unweighted, in the constraint's own unit
+	Score   float64 `json:"score"`   // Weight * Penalty
 }
 
+// Status describes how conclusive a scheduler's result is
+type Status string
+
+const (
+	// StatusOptimal means every session was placed, so no timetable has fewer failures
+	StatusOptimal Status = "optimal"
+
+	// StatusInfeasible means the search proved that not every session can be placed
+	StatusInfeasible Status = "infeasible"
+
+	// StatusTimedOut means the search ran out of time; the output is the best partial timetable found
+	StatusTimedOut Status = "timed_out"
+
+	// StatusCanceled means the search was canceled; the output is the best partial timetable found
+	StatusCanceled Status = "canceled"
+)
+
 // FailedSession represents a session that couldn't be scheduled
 type FailedSession struct {
-	CourseSession *models.CourseSession
-	Reason        string
+	CourseSession *models.CourseSession `json:"course_session"`
+	Reason        string                `json:"reason"`
+
+	// Diagnosis explains the failure in detail when the scheduler can, nil otherwise
+	Diagnosis *Diagnosis `json:"diagnosis,omitempty"`
 }
 
+// Failure reasons reported by schedulers
+const (
+	ReasonNoTimeSlot           = "no available time slot found"
+	ReasonNoRoomsOfType        = "no rooms of the required type"
+	ReasonInsufficientCapacity = "no room with sufficient capacity for enrollment"
+	ReasonDurationTooLong      = "session is longer than the operating hours"
+	ReasonAllowedWindows       = "no free slot within the session's allowed windows"
+	ReasonInstructorConflict   = "no time slot where all assigned instructors are free"
+	ReasonCohortClash          = "no time slot free of clashes with other courses in the same cohort"
+	ReasonSpreadRule           = "every free slot is on a day the course's spreading rules rule out"
+	ReasonLinkConflict         = "no time slot satisfies the session's links to other sessions"
+)
+
 // TimeRange defines a time interval (in minutes from midnight)
 type TimeRange struct {
 	Start int // e.g., 480 = 8:00 AM
@@ -0,0 +1,107 @@
+package score
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ ConstraintInterface = (*IdleGaps)(nil)
+
+// IdleGaps penalises the hours instructors and cohorts spend waiting between sessions on the
+// same day. The configured minimum break and any travel time are not counted as idle.
+type IdleGaps struct{}
+
+func (c *IdleGaps) Name() string {
+	return "idle_gaps"
+}
+
+func (c *IdleGaps) Penalty(sessions []*models.ScheduledSession, input *scheduler.Input) float64 {
+	instructors := make(map[uuid.UUID][]uuid.UUID) // course session -> instructors
+	for _, a := range input.InstructorAssignments {
+		if a != nil {
+			instructors[a.CourseSessionID] = append(instructors[a.CourseSessionID], a.InstructorID)
+		}
+	}
+
+	cohorts := make(map[uuid.UUID][]uuid.UUID) // course -> cohorts
+	for _, cohort := range input.Cohorts {
+		if cohort == nil {
+			continue
+		}
+		for _, courseID := range cohort.CourseIDs {
+			cohorts[courseID] = append(cohorts[courseID], cohort.ID)
+		}
+	}
+
+	// Instructor and cohort IDs are both UUIDs, so one map holds every attendee's day
+	days := make(map[uuid.UUID]map[int][]*models.ScheduledSession)
+	attend := func(id uuid.UUID, ss *models.ScheduledSession) {
+		if _, exists := days[id]; !exists {
+			days[id] = make(map[int][]*models.ScheduledSession)
+		}
+		days[id][ss.Day] = append(days[id][ss.Day], ss)
+	}
+
+	for _, ss := range sessions {
+		if ss == nil {
+			continue
+		}
+		for _, id := range instructors[ss.CourseSessionID] {
+			attend(id, ss)
+		}
+		for _, id := range cohorts[ss.CourseID] {
+			attend(id, ss)
+		}
+	}
+
+	rooms := make(map[uuid.UUID]uuid.UUID) // room -> building
+	for _, room := range input.Rooms {
+		if room != nil {
+			rooms[room.ID] = room.Building
+		}
+	}
+
+	brk := config(input).MinBreakBetweenSessions
+	idle := 0
+	for _, byDay := range days {
+		for _, day := range byDay {
+			slices.SortFunc(day, func(a, b *models.ScheduledSession) int {
+				return a.StartTime - b.StartTime
+			})
+
+			for k := 1; k < len(day); k++ {
+				allowed := max(brk, travelTime(input, rooms[day[k-1].RoomID], rooms[day[k].RoomID]))
+				idle += max(0, day[k].StartTime-day[k-1].EndTime-allowed)
+			}
+		}
+	}
+
+	return float64(idle) / 60
+}
+
+// travelTime returns the minutes needed between two buildings,
+// falling back to the reverse trip when only that direction is recorded
+func travelTime(input *scheduler.Input, from, to uuid.UUID) int {
+	if from == to {
+		return 0
+	}
+
+	reverse := 0
+	for _, t := range input.TravelTimes {
+		if t == nil {
+			continue
+		}
+		if t.FromBuildingID == from && t.ToBuildingID == to {
+			return int(t.Minutes)
+		}
+		if t.FromBuildingID == to && t.ToBuildingID == from {
+			reverse = int(t.Minutes)
+		}
+	}
+
+	return reverse
+}
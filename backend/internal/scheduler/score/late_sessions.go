@@ -0,0 +1,36 @@
+package score
+
+import (
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ ConstraintInterface = (*LateSessions)(nil)
+
+// DefaultLateAfter is 17:00, when sessions start counting as late
+const DefaultLateAfter = 17 * 60
+
+// LateSessions penalises the hours of teaching after a cutoff (in minutes from midnight)
+type LateSessions struct {
+	After int // zero uses DefaultLateAfter
+}
+
+func (c *LateSessions) Name() string {
+	return "late_sessions"
+}
+
+func (c *LateSessions) Penalty(sessions []*models.ScheduledSession, input *scheduler.Input) float64 {
+	after := c.After
+	if after <= 0 {
+		after = DefaultLateAfter
+	}
+
+	late := 0
+	for _, ss := range sessions {
+		if ss != nil {
+			late += max(0, ss.EndTime-max(ss.StartTime, after))
+		}
+	}
+
+	return float64(late) / 60
+}
@@ -0,0 +1,77 @@
+// Package score rates a timetable against weighted soft constraints, so timetables from
+// different schedulers or from manual edits can be compared. Lower scores are better.
+package score
+
+import (
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// ConstraintInterface measures how far a timetable strays from one soft constraint.
+// The input supplies the rooms, courses, instructors and cohorts the sessions refer to.
+type ConstraintInterface interface {
+	Name() string
+	Penalty(sessions []*models.ScheduledSession, input *scheduler.Input) float64
+}
+
+// WeightedConstraint scales a constraint's penalty by its weight
+type WeightedConstraint struct {
+	Constraint ConstraintInterface
+	Weight     float64
+}
+
+type Scorer struct {
+	Constraints []WeightedConstraint
+}
+
+func NewScorer(constraints ...WeightedConstraint) *Scorer {
+	return &Scorer{
+		Constraints: constraints,
+	}
+}
+
+// DefaultScorer weighs idle gaps, late sessions, unbalanced days, wasted capacity and sessions
+// outside their preferred windows
+func DefaultScorer() *Scorer {
+	return NewScorer(
+		WeightedConstraint{Constraint: &IdleGaps{}, Weight: 1},
+		WeightedConstraint{Constraint: &LateSessions{}, Weight: 2},
+		WeightedConstraint{Constraint: &UnbalancedDays{}, Weight: 1},
+		WeightedConstraint{Constraint: &WastedCapacity{}, Weight: 1},
+		WeightedConstraint{Constraint: &SessionPreferences{}, Weight: 2},
+	)
+}
+
+// Evaluate scores the sessions, breaking the total down by constraint
+func (s *Scorer) Evaluate(sessions []*models.ScheduledSession, input *scheduler.Input) *scheduler.Score {
+	if input == nil {
+		input = &scheduler.Input{}
+	}
+
+	result := &scheduler.Score{
+		Breakdown: make([]*scheduler.ConstraintScore, 0, len(s.Constraints)),
+	}
+
+	for _, wc := range s.Constraints {
+		penalty := wc.Constraint.Penalty(sessions, input)
+
+		result.Breakdown = append(result.Breakdown, &scheduler.ConstraintScore{
+			Name:    wc.Constraint.Name(),
+			Weight:  wc.Weight,
+			Penalty: penalty,
+			Score:   wc.Weight * penalty,
+		})
+		result.Total += wc.Weight * penalty
+	}
+
+	return result
+}
+
+// config returns the input's configuration, or the defaults when there is none
+func config(input *scheduler.Input) *scheduler.Config {
+	if input.Config == nil {
+		return scheduler.DefaultConfig()
+	}
+
+	return input.Config
+}
@@ -0,0 +1,41 @@
+package score
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ ConstraintInterface = (*SessionPreferences)(nil)
+
+// SessionPreferences penalises the hours of teaching outside each course session's preferred windows,
+// each hour scaled by how far it misses the session's heaviest window. Sessions without preferred
+// windows are not counted.
+type SessionPreferences struct{}
+
+func (c *SessionPreferences) Name() string {
+	return "session_preferences"
+}
+
+func (c *SessionPreferences) Penalty(sessions []*models.ScheduledSession, input *scheduler.Input) float64 {
+	courseSessions := make(map[uuid.UUID]*models.CourseSession)
+	for _, cs := range input.CourseSessions {
+		if cs != nil && len(cs.PreferredWindows) > 0 {
+			courseSessions[cs.ID] = cs
+		}
+	}
+
+	outside := 0
+	for _, ss := range sessions {
+		if ss == nil {
+			continue
+		}
+
+		if cs, exists := courseSessions[ss.CourseSessionID]; exists {
+			outside += (ss.EndTime - ss.StartTime) * cs.PreferenceMiss(ss.Day, ss.StartTime, ss.EndTime)
+		}
+	}
+
+	return float64(outside) / 60
+}
@@ -0,0 +1,39 @@
+package score
+
+import (
+	"slices"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ ConstraintInterface = (*UnbalancedDays)(nil)
+
+// UnbalancedDays penalises the difference, in hours of teaching, between the busiest and
+// quietest operating days
+type UnbalancedDays struct{}
+
+func (c *UnbalancedDays) Name() string {
+	return "unbalanced_days"
+}
+
+func (c *UnbalancedDays) Penalty(sessions []*models.ScheduledSession, input *scheduler.Input) float64 {
+	days := config(input).Days()
+	if len(days) == 0 {
+		return 0
+	}
+
+	minutes := make(map[int]int)
+	for _, ss := range sessions {
+		if ss != nil {
+			minutes[ss.Day] += ss.EndTime - ss.StartTime
+		}
+	}
+
+	totals := make([]int, len(days))
+	for i, day := range days {
+		totals[i] = minutes[int(day)]
+	}
+
+	return float64(slices.Max(totals)-slices.Min(totals)) / 60
+}
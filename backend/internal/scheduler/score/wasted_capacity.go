@@ -0,0 +1,64 @@
+package score
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+var _ ConstraintInterface = (*WastedCapacity)(nil)
+
+// WastedCapacity penalises sessions in rooms far larger than their enrollment. Each session adds
+// the share of its room's seats left empty, so a session in a room twice its size adds 0.5.
+// Sessions without a known enrollment are not counted.
+type WastedCapacity struct{}
+
+func (c *WastedCapacity) Name() string {
+	return "wasted_capacity"
+}
+
+func (c *WastedCapacity) Penalty(sessions []*models.ScheduledSession, input *scheduler.Input) float64 {
+	rooms := make(map[uuid.UUID]*models.Room)
+	for _, room := range input.Rooms {
+		if room != nil {
+			rooms[room.ID] = room
+		}
+	}
+
+	enrollments := make(map[uuid.UUID]int32) // course session -> enrollment
+	courses := make(map[uuid.UUID]int32)     // course -> enrollment
+	for _, course := range input.Courses {
+		if course != nil {
+			courses[course.ID] = course.Enrollment
+		}
+	}
+	for _, cs := range input.CourseSessions {
+		if cs != nil && cs.Enrollment != nil {
+			enrollments[cs.ID] = *cs.Enrollment
+		}
+	}
+
+	wasted := 0.0
+	for _, ss := range sessions {
+		if ss == nil {
+			continue
+		}
+
+		room, exists := rooms[ss.RoomID]
+		if !exists || room.Capacity <= 0 {
+			continue
+		}
+
+		enrollment, exists := enrollments[ss.CourseSessionID]
+		if !exists {
+			enrollment = courses[ss.CourseID]
+		}
+
+		if empty := room.Capacity - enrollment; enrollment > 0 && empty > 0 {
+			wasted += float64(empty) / float64(room.Capacity)
+		}
+	}
+
+	return wasted
+}
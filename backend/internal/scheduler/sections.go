@@ -0,0 +1,210 @@
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// Sections maps the course sessions of an input expanded by ExpandSections back to the stored
+// course sessions they copy
+type Sections struct {
+	copies   map[uuid.UUID]*models.CourseSession // copy ID -> stored course session
+	sections map[uuid.UUID][]uuid.UUID           // stored course session ID -> section IDs in order
+}
+
+// ExpandSections gives every section of a course its own copy of each of the course's sessions,
+// so schedulers place sections independently without knowing about them. A copy has its own ID,
+// carries the section in SectionID, takes the section's capacity as its enrollment and is taught
+// by the section's instructor in place of the assigned ones when those are set.
+//
+// Pins on a sectioned course session fix the meetings of its first section. Links between two
+// sessions of the same sectioned course hold within each section; other links hold between every
+// copy of the two sessions. Courses without sections are left as they are.
+//
+// ExpandSections runs after CombineSessions. A combined session group serving a course with
+// sections would meet once for all of its sections, so it is an ErrSessionGroupSectioned.
+func ExpandSections(input *Input, sections []*models.CourseSection) (*Input, *Sections, error) {
+	s := &Sections{
+		copies:   make(map[uuid.UUID]*models.CourseSession),
+		sections: make(map[uuid.UUID][]uuid.UUID),
+	}
+
+	byCourse := make(map[uuid.UUID][]*models.CourseSection)
+	for _, section := range sections {
+		if section != nil {
+			byCourse[section.CourseID] = append(byCourse[section.CourseID], section)
+		}
+	}
+	if len(byCourse) == 0 {
+		return input, s, nil
+	}
+
+	for _, cs := range input.CourseSessions {
+		if cs != nil && slices.ContainsFunc(cs.CourseIDs, func(id uuid.UUID) bool { return len(byCourse[id]) > 0 }) {
+			return nil, nil, fmt.Errorf("%w: session group %s", ErrSessionGroupSectioned, cs.ID)
+		}
+	}
+
+	expanded := *input
+	expanded.CourseSessions = nil
+	expanded.InstructorAssignments = nil
+	expanded.Pins = nil
+	expanded.Links = nil
+
+	assignments := make(map[uuid.UUID][]*models.InstructorAssignment)
+	for _, a := range input.InstructorAssignments {
+		if a != nil {
+			assignments[a.CourseSessionID] = append(assignments[a.CourseSessionID], a)
+		}
+	}
+
+	for _, cs := range input.CourseSessions {
+		if cs == nil || len(byCourse[cs.CourseID]) == 0 {
+			expanded.CourseSessions = append(expanded.CourseSessions, cs)
+			if cs != nil {
+				expanded.InstructorAssignments = append(expanded.InstructorAssignments, assignments[cs.ID]...)
+			}
+			continue
+		}
+
+		for _, section := range byCourse[cs.CourseID] {
+			c := *cs
+			c.ID = copyID(cs.ID, section.ID)
+			c.SectionID = section.ID
+			if section.Capacity != nil {
+				c.Enrollment = section.Capacity
+			}
+
+			expanded.CourseSessions = append(expanded.CourseSessions, &c)
+			s.copies[c.ID] = cs
+			s.sections[cs.ID] = append(s.sections[cs.ID], section.ID)
+
+			if section.InstructorID != nil {
+				expanded.InstructorAssignments = append(expanded.InstructorAssignments,
+					models.NewInstructorAssignment(c.ID, *section.InstructorID, nil))
+				continue
+			}
+			for _, a := range assignments[cs.ID] {
+				expanded.InstructorAssignments = append(expanded.InstructorAssignments,
+					models.NewInstructorAssignment(c.ID, a.InstructorID, a.CreatedAt))
+			}
+		}
+	}
+
+	for _, pin := range input.Pins {
+		if pin == nil || len(s.sections[pin.CourseSessionID]) == 0 {
+			expanded.Pins = append(expanded.Pins, pin)
+			continue
+		}
+
+		p := *pin
+		p.CourseSessionID = copyID(pin.CourseSessionID, s.sections[pin.CourseSessionID][0])
+		expanded.Pins = append(expanded.Pins, &p)
+	}
+
+	for _, link := range input.Links {
+		if link == nil {
+			continue
+		}
+
+		anchors, others := s.copiesOf(link.CourseSessionID), s.copiesOf(link.OtherSessionID)
+		sameCourse := len(s.sections[link.CourseSessionID]) > 0 && slices.Equal(s.sections[link.CourseSessionID], s.sections[link.OtherSessionID])
+
+		for i, anchor := range anchors {
+			for j, other := range others {
+				if sameCourse && i != j {
+					continue
+				}
+
+				l := *link
+				l.CourseSessionID, l.OtherSessionID = anchor, other
+				expanded.Links = append(expanded.Links, &l)
+			}
+		}
+	}
+
+	return &expanded, s, nil
+}
+
+// Expand rewrites saved sessions of sectioned courses to refer to the copies ExpandSections made,
+// so they can be compared with an expanded input
+func (s *Sections) Expand(saved []models.ScheduledSession) []models.ScheduledSession {
+	expanded := slices.Clone(saved)
+
+	for i, ss := range expanded {
+		if ss.SectionID != uuid.Nil && slices.Contains(s.sections[ss.CourseSessionID], ss.SectionID) {
+			expanded[i].CourseSessionID = copyID(ss.CourseSessionID, ss.SectionID)
+		}
+	}
+
+	return expanded
+}
+
+// Restore points the scheduled sessions and failures in output made from an expanded input back
+// at the stored course sessions. Scheduled sessions keep their SectionID, and a failed copy is
+// reported as the stored course session with SectionID set.
+func (s *Sections) Restore(output *Output) {
+	if output == nil {
+		return
+	}
+
+	for _, ss := range output.ScheduledSessions {
+		s.RestoreSession(ss)
+	}
+
+	for _, f := range output.Failures {
+		if f.CourseSession == nil {
+			continue
+		}
+
+		if cs, exists := s.copies[f.CourseSession.ID]; exists {
+			restored := *cs
+			restored.SectionID = f.CourseSession.SectionID
+			f.CourseSession = &restored
+		}
+	}
+}
+
+// RestoreSession points a scheduled session of a copy back at the stored course session
+func (s *Sections) RestoreSession(ss *models.ScheduledSession) {
+	if ss == nil {
+		return
+	}
+
+	if cs, exists := s.copies[ss.CourseSessionID]; exists {
+		ss.CourseSessionID = cs.ID
+	}
+}
+
+// SessionID returns the stored course session ID for the ID of a copy, or the ID itself
+func (s *Sections) SessionID(id uuid.UUID) uuid.UUID {
+	if cs, exists := s.copies[id]; exists {
+		return cs.ID
+	}
+
+	return id
+}
+
+// copiesOf returns the IDs of every copy of a course session, or the ID itself when it has no sections
+func (s *Sections) copiesOf(id uuid.UUID) []uuid.UUID {
+	sections := s.sections[id]
+	if len(sections) == 0 {
+		return []uuid.UUID{id}
+	}
+
+	ids := make([]uuid.UUID, len(sections))
+	for i, section := range sections {
+		ids[i] = copyID(id, section)
+	}
+
+	return ids
+}
+
+// copyID derives a stable ID for a section's copy of a course session
+func copyID(courseSessionID, sectionID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(sectionID, courseSessionID[:])
+}
@@ -0,0 +1,45 @@
+package scheduler
+
+import "github.com/TerrenceMurray/course-scheduler/internal/models"
+
+// Spread holds the day-spreading rules in force for one course session
+type Spread struct {
+	// MinDaysBetween keeps meetings of the course at least this many days apart, 0 when off
+	MinDaysBetween int
+
+	// MaxPerDay caps the course's meetings on one day, 0 when there is no cap
+	MaxPerDay int
+
+	// DayPatterns are preferred sets of days, tried in order
+	DayPatterns [][]Day
+}
+
+// SpreadFor returns the config's spreading rules, with any rule the session sets for itself in its place
+func (c *Config) SpreadFor(session *models.CourseSession) Spread {
+	spread := Spread{
+		MinDaysBetween: c.MinDaysBetweenSessions,
+		MaxPerDay:      c.MaxSessionsPerDay,
+		DayPatterns:    c.DayPatterns,
+	}
+
+	if session == nil || session.Spread == nil {
+		return spread
+	}
+
+	if session.Spread.MinDaysBetween != nil {
+		spread.MinDaysBetween = int(*session.Spread.MinDaysBetween)
+	}
+	if session.Spread.MaxPerDay != nil {
+		spread.MaxPerDay = int(*session.Spread.MaxPerDay)
+	}
+	if len(session.Spread.DayPatterns) > 0 {
+		spread.DayPatterns = make([][]Day, len(session.Spread.DayPatterns))
+		for i, pattern := range session.Spread.DayPatterns {
+			for _, day := range pattern {
+				spread.DayPatterns[i] = append(spread.DayPatterns[i], Day(day))
+			}
+		}
+	}
+
+	return spread
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ BuildingTravelTimeServiceInterface = (*BuildingTravelTimeService)(nil)
+
+type BuildingTravelTimeServiceInterface interface {
+	Set(ctx context.Context, travelTime *models.BuildingTravelTime) (*models.BuildingTravelTime, error)
+	GetByID(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) (*models.BuildingTravelTime, error)
+	GetByBuildingID(ctx context.Context, fromBuildingID uuid.UUID) ([]*models.BuildingTravelTime, error)
+	Delete(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error
+}
+
+type BuildingTravelTimeService struct {
+	repo repository.BuildingTravelTimeRepositoryInterface
+}
+
+func NewBuildingTravelTimeService(repo repository.BuildingTravelTimeRepositoryInterface) *BuildingTravelTimeService {
+	return &BuildingTravelTimeService{
+		repo: repo,
+	}
+}
+
+func (s *BuildingTravelTimeService) Set(ctx context.Context, travelTime *models.BuildingTravelTime) (*models.BuildingTravelTime, error) {
+	return s.repo.Set(ctx, travelTime)
+}
+
+func (s *BuildingTravelTimeService) GetByID(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) (*models.BuildingTravelTime, error) {
+	return s.repo.GetByID(ctx, fromBuildingID, toBuildingID)
+}
+
+func (s *BuildingTravelTimeService) GetByBuildingID(ctx context.Context, fromBuildingID uuid.UUID) ([]*models.BuildingTravelTime, error) {
+	return s.repo.GetByBuildingID(ctx, fromBuildingID)
+}
+
+func (s *BuildingTravelTimeService) Delete(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error {
+	return s.repo.Delete(ctx, fromBuildingID, toBuildingID)
+}
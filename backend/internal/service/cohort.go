@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ CohortServiceInterface = (*CohortService)(nil)
+
+type CohortServiceInterface interface {
+	Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error)
+	CreateBatch(ctx context.Context, cohorts []*models.Cohort) ([]*models.Cohort, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error)
+	List(ctx context.Context) ([]*models.Cohort, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error)
+}
+
+type CohortService struct {
+	repo repository.CohortRepositoryInterface
+}
+
+func NewCohortService(repo repository.CohortRepositoryInterface) *CohortService {
+	return &CohortService{
+		repo: repo,
+	}
+}
+
+func (s *CohortService) Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error) {
+	return s.repo.Create(ctx, cohort)
+}
+
+func (s *CohortService) CreateBatch(ctx context.Context, cohorts []*models.Cohort) ([]*models.Cohort, error) {
+	return s.repo.CreateBatch(ctx, cohorts)
+}
+
+func (s *CohortService) GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *CohortService) List(ctx context.Context) ([]*models.Cohort, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *CohortService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *CohortService) Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error) {
+	return s.repo.Update(ctx, id, updates)
+}
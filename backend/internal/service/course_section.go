@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ CourseSectionServiceInterface = (*CourseSectionService)(nil)
+
+type CourseSectionServiceInterface interface {
+	Create(ctx context.Context, section *models.CourseSection) (*models.CourseSection, error)
+	GetByID(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (*models.CourseSection, error)
+	GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSection, error)
+	Delete(ctx context.Context, courseID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates *models.CourseSectionUpdate) (*models.CourseSection, error)
+}
+
+type CourseSectionService struct {
+	repo repository.CourseSectionRepositoryInterface
+}
+
+func NewCourseSectionService(repo repository.CourseSectionRepositoryInterface) *CourseSectionService {
+	return &CourseSectionService{
+		repo: repo,
+	}
+}
+
+func (s *CourseSectionService) Create(ctx context.Context, section *models.CourseSection) (*models.CourseSection, error) {
+	if err := section.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Create(ctx, section)
+}
+
+func (s *CourseSectionService) GetByID(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (*models.CourseSection, error) {
+	return s.repo.GetByID(ctx, courseID, id)
+}
+
+func (s *CourseSectionService) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSection, error) {
+	return s.repo.GetByCourseID(ctx, courseID)
+}
+
+func (s *CourseSectionService) Delete(ctx context.Context, courseID uuid.UUID, id uuid.UUID) error {
+	return s.repo.Delete(ctx, courseID, id)
+}
+
+func (s *CourseSectionService) Update(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates *models.CourseSectionUpdate) (*models.CourseSection, error) {
+	if updates == nil {
+		return nil, fmt.Errorf("%w: updates cannot be nil", repository.ErrInvalidInput)
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Update(ctx, courseID, id, updates)
+}
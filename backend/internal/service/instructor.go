@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ InstructorServiceInterface = (*InstructorService)(nil)
+
+type InstructorServiceInterface interface {
+	Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error)
+	CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error)
+	GetBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*models.Instructor, error)
+	List(ctx context.Context) ([]*models.Instructor, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error)
+	Assign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) (*models.InstructorAssignment, error)
+	Unassign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) error
+}
+
+type InstructorService struct {
+	repo repository.InstructorRepositoryInterface
+}
+
+func NewInstructorService(repo repository.InstructorRepositoryInterface) *InstructorService {
+	return &InstructorService{
+		repo: repo,
+	}
+}
+
+func (s *InstructorService) Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error) {
+	return s.repo.Create(ctx, instructor)
+}
+
+func (s *InstructorService) CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error) {
+	return s.repo.CreateBatch(ctx, instructors)
+}
+
+func (s *InstructorService) GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *InstructorService) GetBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*models.Instructor, error) {
+	return s.repo.GetBySessionID(ctx, sessionID)
+}
+
+func (s *InstructorService) List(ctx context.Context) ([]*models.Instructor, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *InstructorService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *InstructorService) Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error) {
+	return s.repo.Update(ctx, id, updates)
+}
+
+func (s *InstructorService) Assign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) (*models.InstructorAssignment, error) {
+	return s.repo.Assign(ctx, sessionID, instructorID)
+}
+
+func (s *InstructorService) Unassign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) error {
+	return s.repo.Unassign(ctx, sessionID, instructorID)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ InstructorAvailabilityServiceInterface = (*InstructorAvailabilityService)(nil)
+
+type InstructorAvailabilityServiceInterface interface {
+	Create(ctx context.Context, availability *models.InstructorAvailability) (*models.InstructorAvailability, error)
+	GetByID(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) (*models.InstructorAvailability, error)
+	GetByInstructorID(ctx context.Context, instructorID uuid.UUID) ([]*models.InstructorAvailability, error)
+	Delete(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, instructorID uuid.UUID, id uuid.UUID, updates *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error)
+}
+
+type InstructorAvailabilityService struct {
+	repo repository.InstructorAvailabilityRepositoryInterface
+}
+
+func NewInstructorAvailabilityService(repo repository.InstructorAvailabilityRepositoryInterface) *InstructorAvailabilityService {
+	return &InstructorAvailabilityService{
+		repo: repo,
+	}
+}
+
+func (s *InstructorAvailabilityService) Create(ctx context.Context, availability *models.InstructorAvailability) (*models.InstructorAvailability, error) {
+	if err := availability.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Create(ctx, availability)
+}
+
+func (s *InstructorAvailabilityService) GetByID(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) (*models.InstructorAvailability, error) {
+	return s.repo.GetByID(ctx, instructorID, id)
+}
+
+func (s *InstructorAvailabilityService) GetByInstructorID(ctx context.Context, instructorID uuid.UUID) ([]*models.InstructorAvailability, error) {
+	return s.repo.GetByInstructorID(ctx, instructorID)
+}
+
+func (s *InstructorAvailabilityService) Delete(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) error {
+	return s.repo.Delete(ctx, instructorID, id)
+}
+
+func (s *InstructorAvailabilityService) Update(ctx context.Context, instructorID uuid.UUID, id uuid.UUID, updates *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error) {
+	if updates == nil {
+		return nil, fmt.Errorf("%w: updates cannot be nil", repository.ErrInvalidInput)
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Update(ctx, instructorID, id, updates)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ RoomUnavailabilityServiceInterface = (*RoomUnavailabilityService)(nil)
+
+type RoomUnavailabilityServiceInterface interface {
+	Create(ctx context.Context, unavailability *models.RoomUnavailability) (*models.RoomUnavailability, error)
+	GetByID(ctx context.Context, roomID uuid.UUID, id uuid.UUID) (*models.RoomUnavailability, error)
+	GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomUnavailability, error)
+	Delete(ctx context.Context, roomID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, roomID uuid.UUID, id uuid.UUID, updates *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error)
+}
+
+type RoomUnavailabilityService struct {
+	repo repository.RoomUnavailabilityRepositoryInterface
+}
+
+func NewRoomUnavailabilityService(repo repository.RoomUnavailabilityRepositoryInterface) *RoomUnavailabilityService {
+	return &RoomUnavailabilityService{
+		repo: repo,
+	}
+}
+
+func (s *RoomUnavailabilityService) Create(ctx context.Context, unavailability *models.RoomUnavailability) (*models.RoomUnavailability, error) {
+	if err := unavailability.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Create(ctx, unavailability)
+}
+
+func (s *RoomUnavailabilityService) GetByID(ctx context.Context, roomID uuid.UUID, id uuid.UUID) (*models.RoomUnavailability, error) {
+	return s.repo.GetByID(ctx, roomID, id)
+}
+
+func (s *RoomUnavailabilityService) GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomUnavailability, error) {
+	return s.repo.GetByRoomID(ctx, roomID)
+}
+
+func (s *RoomUnavailabilityService) Delete(ctx context.Context, roomID uuid.UUID, id uuid.UUID) error {
+	return s.repo.Delete(ctx, roomID, id)
+}
+
+func (s *RoomUnavailabilityService) Update(ctx context.Context, roomID uuid.UUID, id uuid.UUID, updates *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error) {
+	if updates == nil {
+		return nil, fmt.Errorf("%w: updates cannot be nil", repository.ErrInvalidInput)
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Update(ctx, roomID, id, updates)
+}
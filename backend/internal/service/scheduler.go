@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -9,21 +10,48 @@ import (
 	"github.com/TerrenceMurray/course-scheduler/internal/models"
 	"github.com/TerrenceMurray/course-scheduler/internal/repository"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/portfolio"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/repair"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/score"
 )
 
 var _ SchedulerServiceInterface = (*SchedulerService)(nil)
 
+// ErrUnknownStrategy is returned when a generate request names a weight strategy that is not registered
+var ErrUnknownStrategy = errors.New("unknown weight strategy")
+
+// PortfolioStrategy names the portfolio scheduler, which runs every registered weight strategy
+// with every room selection policy and several tie-break seeds and keeps the best timetable
+const PortfolioStrategy = "portfolio"
+
 type SchedulerServiceInterface interface {
-	GenerateAndSave(ctx context.Context, name string, config *scheduler.Config) (*models.Schedule, *scheduler.Output, error)
-	Generate(ctx context.Context, config *scheduler.Config) (*scheduler.Output, error)
+	GenerateAndSave(ctx context.Context, name string, config *scheduler.Config, pins []*models.SessionPin, strategy string, progress scheduler.ProgressFunc) (*models.Schedule, *scheduler.Output, error)
+	Generate(ctx context.Context, config *scheduler.Config, pins []*models.SessionPin, strategy string, progress scheduler.ProgressFunc) (*scheduler.Output, error)
+	Strategies() []weight.Strategy
+	Score(ctx context.Context, scheduleID uuid.UUID) (*scheduler.Score, error)
+	Repair(ctx context.Context, scheduleID uuid.UUID, config *scheduler.Config) (*models.Schedule, *repair.Result, error)
 }
 
 type SchedulerService struct {
-	scheduler   scheduler.Scheduler
-	scheduleRepo repository.ScheduleRepositoryInterface
-	roomRepo     repository.RoomRepositoryInterface
-	courseRepo   repository.CourseRepositoryInterface
-	sessionRepo  repository.CourseSessionRepositoryInterface
+	scheduler          scheduler.Scheduler
+	scheduleRepo       repository.ScheduleRepositoryInterface
+	roomRepo           repository.RoomRepositoryInterface
+	courseRepo         repository.CourseRepositoryInterface
+	sessionRepo        repository.CourseSessionRepositoryInterface
+	instructorRepo     repository.InstructorRepositoryInterface
+	cohortRepo         repository.CohortRepositoryInterface
+	unavailabilityRepo repository.RoomUnavailabilityRepositoryInterface
+	availabilityRepo   repository.InstructorAvailabilityRepositoryInterface
+	travelTimeRepo     repository.BuildingTravelTimeRepositoryInterface
+	pinRepo            repository.SessionPinRepositoryInterface
+	linkRepo           repository.SessionLinkRepositoryInterface
+	sectionRepo        repository.CourseSectionRepositoryInterface
+	roomTypeRepo       repository.RoomTypeRepositoryInterface
+	groupRepo          repository.SessionGroupRepositoryInterface
+	scorer             *score.Scorer
+	strategies         *weight.Registry
 }
 
 func NewSchedulerService(
@@ -32,46 +60,129 @@ func NewSchedulerService(
 	roomRepo repository.RoomRepositoryInterface,
 	courseRepo repository.CourseRepositoryInterface,
 	sessionRepo repository.CourseSessionRepositoryInterface,
+	instructorRepo repository.InstructorRepositoryInterface,
+	cohortRepo repository.CohortRepositoryInterface,
+	unavailabilityRepo repository.RoomUnavailabilityRepositoryInterface,
+	availabilityRepo repository.InstructorAvailabilityRepositoryInterface,
+	travelTimeRepo repository.BuildingTravelTimeRepositoryInterface,
+	pinRepo repository.SessionPinRepositoryInterface,
+	linkRepo repository.SessionLinkRepositoryInterface,
+	sectionRepo repository.CourseSectionRepositoryInterface,
+	roomTypeRepo repository.RoomTypeRepositoryInterface,
+	groupRepo repository.SessionGroupRepositoryInterface,
 ) *SchedulerService {
 	return &SchedulerService{
-		scheduler:    sched,
-		scheduleRepo: scheduleRepo,
-		roomRepo:     roomRepo,
-		courseRepo:   courseRepo,
-		sessionRepo:  sessionRepo,
+		scheduler:          sched,
+		scheduleRepo:       scheduleRepo,
+		roomRepo:           roomRepo,
+		courseRepo:         courseRepo,
+		sessionRepo:        sessionRepo,
+		instructorRepo:     instructorRepo,
+		cohortRepo:         cohortRepo,
+		unavailabilityRepo: unavailabilityRepo,
+		availabilityRepo:   availabilityRepo,
+		travelTimeRepo:     travelTimeRepo,
+		pinRepo:            pinRepo,
+		linkRepo:           linkRepo,
+		sectionRepo:        sectionRepo,
+		roomTypeRepo:       roomTypeRepo,
+		groupRepo:          groupRepo,
+		scorer:             score.DefaultScorer(),
+		strategies:         weight.DefaultRegistry(),
 	}
 }
 
-// Generate creates a schedule without persisting it
-func (s *SchedulerService) Generate(ctx context.Context, config *scheduler.Config) (*scheduler.Output, error) {
-	input, err := s.buildInput(ctx, config)
+// Generate creates a schedule without persisting it. The given pins are honoured
+// alongside those stored for each course session. A named weight strategy orders the
+// courses of a greedy pass in place of the configured scheduler, PortfolioStrategy runs the
+// portfolio scheduler and an empty name keeps the configured scheduler.
+//
+// The scheduler stops when ctx is done and the best partial timetable is returned with its
+// Status set. A non-nil progress receives the scheduler's progress reports as it runs.
+func (s *SchedulerService) Generate(ctx context.Context, config *scheduler.Config, pins []*models.SessionPin, strategy string, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+	sched, err := s.schedulerFor(strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	input, mapping, err := s.buildInput(ctx, config, pins)
+	if err != nil {
+		return nil, err
+	}
+
+	input.Progress = progress
+	output, err := sched.Generate(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.scheduler.Generate(input)
+	// Score before restoring so each section and session group is rated against its own
+	// capacity and instructors
+	output.Score = s.scorer.Evaluate(output.ScheduledSessions, input)
+	mapping.Restore(output)
+
+	return output, nil
+}
+
+// Strategies lists the weight strategies a generate request can name
+func (s *SchedulerService) Strategies() []weight.Strategy {
+	return s.strategies.List()
 }
 
-// GenerateAndSave creates a schedule and persists it to the database
-func (s *SchedulerService) GenerateAndSave(ctx context.Context, name string, config *scheduler.Config) (*models.Schedule, *scheduler.Output, error) {
-	output, err := s.Generate(ctx, config)
+// schedulerFor returns a greedy scheduler using the named weight strategy, a portfolio of every
+// registered strategy for PortfolioStrategy, or the configured scheduler when no strategy is named
+func (s *SchedulerService) schedulerFor(strategy string) (scheduler.Scheduler, error) {
+	switch strategy {
+	case "":
+		return s.scheduler, nil
+	case PortfolioStrategy:
+		config := portfolio.DefaultConfig()
+		config.Strategies = s.strategies.List()
+		return portfolio.NewPortfolioScheduler(config), nil
+	}
+
+	weightStrategy, ok := s.strategies.Get(strategy)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownStrategy, strategy)
+	}
+
+	return greedy.NewGreedyScheduler(weightStrategy), nil
+}
+
+// Score rates a saved schedule against the soft constraints, using the current rooms,
+// courses, instructors and cohorts
+func (s *SchedulerService) Score(ctx context.Context, scheduleID uuid.UUID) (*scheduler.Score, error) {
+	schedule, err := s.scheduleRepo.GetByID(ctx, scheduleID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate schedule: %w", err)
+		return nil, err
 	}
 
-	// Convert scheduled sessions to model format
-	sessions := make([]models.ScheduledSession, len(output.ScheduledSessions))
-	for i, ss := range output.ScheduledSessions {
-		sessions[i] = models.ScheduledSession{
-			CourseID:  ss.CourseID,
-			RoomID:    ss.RoomID,
-			Day:       ss.Day,
-			StartTime: ss.StartTime,
-			EndTime:   ss.EndTime,
-		}
+	input, mapping, err := s.buildInput(ctx, nil, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	schedule := models.NewSchedule(uuid.New(), name, sessions, nil)
+	saved := mapping.Expand(schedule.Sessions)
+	sessions := make([]*models.ScheduledSession, len(saved))
+	for i := range saved {
+		sessions[i] = &saved[i]
+	}
+
+	return s.scorer.Evaluate(sessions, input), nil
+}
+
+// GenerateAndSave creates a schedule and persists it to the database. Nothing is saved when
+// ctx is done before the scheduler finishes.
+func (s *SchedulerService) GenerateAndSave(ctx context.Context, name string, config *scheduler.Config, pins []*models.SessionPin, strategy string, progress scheduler.ProgressFunc) (*models.Schedule, *scheduler.Output, error) {
+	output, err := s.Generate(ctx, config, pins, strategy, progress)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate schedule: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, output, fmt.Errorf("failed to generate schedule: %w", err)
+	}
+
+	schedule := models.NewSchedule(uuid.New(), name, toScheduledSessions(output), nil)
 
 	saved, err := s.scheduleRepo.Create(ctx, schedule)
 	if err != nil {
@@ -81,16 +192,113 @@ func (s *SchedulerService) GenerateAndSave(ctx context.Context, name string, con
 	return saved, output, nil
 }
 
-// buildInput fetches all required data and builds scheduler input
-func (s *SchedulerService) buildInput(ctx context.Context, config *scheduler.Config) (*scheduler.Input, error) {
+// Repair re-places the sessions of a saved schedule that are no longer valid for the current
+// rooms, courses, instructors and pins, keeping every other session where it is. The repaired
+// schedule is saved when anything changed.
+func (s *SchedulerService) Repair(ctx context.Context, scheduleID uuid.UUID, config *scheduler.Config) (*models.Schedule, *repair.Result, error) {
+	schedule, err := s.scheduleRepo.GetByID(ctx, scheduleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input, mapping, err := s.buildInput(ctx, config, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := repair.Repair(input, mapping.Expand(schedule.Sessions))
+	if err != nil {
+		return nil, nil, err
+	}
+	result.Output.Score = s.scorer.Evaluate(result.Output.ScheduledSessions, input)
+	mapping.Restore(result.Output)
+	for _, change := range result.Changes {
+		change.CourseSessionID = mapping.SessionID(change.CourseSessionID)
+		mapping.RestoreSession(change.Old)
+		mapping.RestoreSession(change.New)
+	}
+
+	if len(result.Changes) == 0 {
+		return schedule, result, nil
+	}
+
+	saved, err := s.scheduleRepo.Update(ctx, scheduleID, &models.ScheduleUpdate{
+		Sessions: toScheduledSessions(result.Output),
+	})
+	if err != nil {
+		return nil, result, fmt.Errorf("failed to save repaired schedule: %w", err)
+	}
+
+	return saved, result, nil
+}
+
+// toScheduledSessions converts scheduled sessions to model format
+func toScheduledSessions(output *scheduler.Output) []models.ScheduledSession {
+	sessions := make([]models.ScheduledSession, len(output.ScheduledSessions))
+	for i, ss := range output.ScheduledSessions {
+		sessions[i] = models.ScheduledSession{
+			CourseID:        ss.CourseID,
+			CourseSessionID: ss.CourseSessionID,
+			SectionID:       ss.SectionID,
+			RoomID:          ss.RoomID,
+			Day:             ss.Day,
+			StartTime:       ss.StartTime,
+			EndTime:         ss.EndTime,
+			Substituted:     ss.Substituted,
+			GroupID:         ss.GroupID,
+			CourseIDs:       ss.CourseIDs,
+		}
+	}
+
+	return sessions
+}
+
+// inputMapping maps scheduler results for an input built by buildInput back to the stored data
+type inputMapping struct {
+	groups   *scheduler.Groups
+	sections *scheduler.Sections
+}
+
+// Expand rewrites saved sessions to refer to the combined sessions and section copies of the input
+func (m *inputMapping) Expand(saved []models.ScheduledSession) []models.ScheduledSession {
+	return m.sections.Expand(m.groups.Expand(saved))
+}
+
+// Restore points the output back at the stored course sessions
+func (m *inputMapping) Restore(output *scheduler.Output) {
+	m.sections.Restore(output)
+	m.groups.Restore(output)
+}
+
+// RestoreSession points one scheduled session back at its stored course session
+func (m *inputMapping) RestoreSession(ss *models.ScheduledSession) {
+	m.sections.RestoreSession(ss)
+	m.groups.RestoreSession(ss)
+}
+
+// SessionID returns the stored course session ID for an ID of the input
+func (m *inputMapping) SessionID(id uuid.UUID) uuid.UUID {
+	return m.groups.SessionID(m.sections.SessionID(id))
+}
+
+// buildInput fetches all required data and builds scheduler input, honouring the given pins
+// alongside the stored ones (see mergePins). Session groups are combined so each is scheduled once, and courses
+// with sections are expanded so each section is scheduled on its own; the returned mapping
+// maps the results back.
+func (s *SchedulerService) buildInput(ctx context.Context, config *scheduler.Config, extraPins []*models.SessionPin) (*scheduler.Input, *inputMapping, error) {
 	rooms, err := s.roomRepo.List(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch rooms: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch rooms: %w", err)
+	}
+
+	roomTypes, err := s.roomTypeRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch room types: %w", err)
 	}
 
 	coursesVal, err := s.courseRepo.List(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch courses: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch courses: %w", err)
 	}
 
 	// Convert []models.Course to []*models.Course
@@ -101,13 +309,123 @@ func (s *SchedulerService) buildInput(ctx context.Context, config *scheduler.Con
 
 	sessions, err := s.sessionRepo.List(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch sessions: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch sessions: %w", err)
+	}
+
+	unavailability, err := s.unavailabilityRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch room unavailability: %w", err)
+	}
+
+	travelTimes, err := s.travelTimeRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch building travel times: %w", err)
+	}
+
+	assignments, err := s.instructorRepo.ListAssignments(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch instructor assignments: %w", err)
+	}
+
+	instructorAvailability, err := s.availabilityRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch instructor availability: %w", err)
+	}
+
+	cohorts, err := s.cohortRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch cohorts: %w", err)
+	}
+
+	pins, err := s.pinRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch session pins: %w", err)
+	}
+
+	links, err := s.linkRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch session links: %w", err)
+	}
+
+	sections, err := s.sectionRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch course sections: %w", err)
+	}
+
+	groups, err := s.groupRepo.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch session groups: %w", err)
+	}
+
+	input, combination, err := scheduler.CombineSessions(&scheduler.Input{
+		Config:                 config,
+		Rooms:                  rooms,
+		RoomTypes:              roomTypes,
+		Courses:                courses,
+		CourseSessions:         sessions,
+		RoomUnavailability:     unavailability,
+		TravelTimes:            travelTimes,
+		InstructorAssignments:  assignments,
+		InstructorAvailability: instructorAvailability,
+		Cohorts:                cohorts,
+		Pins:                   mergePins(pins, extraPins),
+		Links:                  links,
+	}, groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input, expansion, err := scheduler.ExpandSections(input, sections)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return input, &inputMapping{groups: combination, sections: expansion}, nil
+}
+
+// mergePins returns the stored pins followed by the requested ones. A requested pin with a stored
+// pin's ID replaces it, and one placing the same course session in the same room, day and start
+// time as an earlier pin is dropped, so repeating a stored pin in a request does not pin a
+// second meeting.
+func mergePins(stored, requested []*models.SessionPin) []*models.SessionPin {
+	type placement struct {
+		courseSessionID uuid.UUID
+		roomID          uuid.UUID
+		day             int32
+		startTime       int32
+	}
+
+	merged := make([]*models.SessionPin, 0, len(stored)+len(requested))
+	index := make(map[uuid.UUID]int) // pin ID -> position in merged
+	placed := make(map[placement]bool)
+
+	add := func(pin *models.SessionPin) {
+		if pin == nil {
+			return
+		}
+
+		if i, exists := index[pin.ID]; exists {
+			old := merged[i]
+			delete(placed, placement{old.CourseSessionID, old.RoomID, old.Day, old.StartTime})
+			merged[i] = pin
+		} else {
+			key := placement{pin.CourseSessionID, pin.RoomID, pin.Day, pin.StartTime}
+			if placed[key] {
+				return
+			}
+			index[pin.ID] = len(merged)
+			merged = append(merged, pin)
+		}
+
+		placed[placement{pin.CourseSessionID, pin.RoomID, pin.Day, pin.StartTime}] = true
+	}
+
+	for _, pin := range stored {
+		add(pin)
+	}
+	for _, pin := range requested {
+		add(pin)
 	}
 
-	return &scheduler.Input{
-		Config:         config,
-		Rooms:          rooms,
-		Courses:        courses,
-		CourseSessions: sessions,
-	}, nil
+	return merged
 }
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ SessionGroupServiceInterface = (*SessionGroupService)(nil)
+
+type SessionGroupServiceInterface interface {
+	Create(ctx context.Context, group *models.SessionGroup) (*models.SessionGroup, error)
+	CreateBatch(ctx context.Context, groups []*models.SessionGroup) ([]*models.SessionGroup, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.SessionGroup, error)
+	List(ctx context.Context) ([]*models.SessionGroup, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.SessionGroupUpdate) (*models.SessionGroup, error)
+}
+
+type SessionGroupService struct {
+	repo repository.SessionGroupRepositoryInterface
+}
+
+func NewSessionGroupService(repo repository.SessionGroupRepositoryInterface) *SessionGroupService {
+	return &SessionGroupService{
+		repo: repo,
+	}
+}
+
+func (s *SessionGroupService) Create(ctx context.Context, group *models.SessionGroup) (*models.SessionGroup, error) {
+	return s.repo.Create(ctx, group)
+}
+
+func (s *SessionGroupService) CreateBatch(ctx context.Context, groups []*models.SessionGroup) ([]*models.SessionGroup, error) {
+	return s.repo.CreateBatch(ctx, groups)
+}
+
+func (s *SessionGroupService) GetByID(ctx context.Context, id uuid.UUID) (*models.SessionGroup, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *SessionGroupService) List(ctx context.Context) ([]*models.SessionGroup, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *SessionGroupService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *SessionGroupService) Update(ctx context.Context, id uuid.UUID, updates *models.SessionGroupUpdate) (*models.SessionGroup, error) {
+	return s.repo.Update(ctx, id, updates)
+}
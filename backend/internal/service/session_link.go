@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ SessionLinkServiceInterface = (*SessionLinkService)(nil)
+
+type SessionLinkServiceInterface interface {
+	Create(ctx context.Context, link *models.SessionLink) (*models.SessionLink, error)
+	GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionLink, error)
+	GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionLink, error)
+	Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionLinkUpdate) (*models.SessionLink, error)
+}
+
+type SessionLinkService struct {
+	repo repository.SessionLinkRepositoryInterface
+}
+
+func NewSessionLinkService(repo repository.SessionLinkRepositoryInterface) *SessionLinkService {
+	return &SessionLinkService{
+		repo: repo,
+	}
+}
+
+func (s *SessionLinkService) Create(ctx context.Context, link *models.SessionLink) (*models.SessionLink, error) {
+	if err := link.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Create(ctx, link)
+}
+
+func (s *SessionLinkService) GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionLink, error) {
+	return s.repo.GetByID(ctx, courseSessionID, id)
+}
+
+func (s *SessionLinkService) GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionLink, error) {
+	return s.repo.GetByCourseSessionID(ctx, courseSessionID)
+}
+
+func (s *SessionLinkService) Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error {
+	return s.repo.Delete(ctx, courseSessionID, id)
+}
+
+func (s *SessionLinkService) Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionLinkUpdate) (*models.SessionLink, error) {
+	if updates == nil {
+		return nil, fmt.Errorf("%w: updates cannot be nil", repository.ErrInvalidInput)
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	// the update alone cannot see the anchor, so a retarget onto it is caught here
+	if updates.OtherSessionID != nil && *updates.OtherSessionID == courseSessionID {
+		return nil, fmt.Errorf("%w: a session cannot be linked to itself", repository.ErrInvalidInput)
+	}
+
+	return s.repo.Update(ctx, courseSessionID, id, updates)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ SessionPinServiceInterface = (*SessionPinService)(nil)
+
+type SessionPinServiceInterface interface {
+	Create(ctx context.Context, pin *models.SessionPin) (*models.SessionPin, error)
+	GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionPin, error)
+	GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionPin, error)
+	Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error
+	Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionPinUpdate) (*models.SessionPin, error)
+}
+
+type SessionPinService struct {
+	repo repository.SessionPinRepositoryInterface
+}
+
+func NewSessionPinService(repo repository.SessionPinRepositoryInterface) *SessionPinService {
+	return &SessionPinService{
+		repo: repo,
+	}
+}
+
+func (s *SessionPinService) Create(ctx context.Context, pin *models.SessionPin) (*models.SessionPin, error) {
+	if err := pin.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Create(ctx, pin)
+}
+
+func (s *SessionPinService) GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionPin, error) {
+	return s.repo.GetByID(ctx, courseSessionID, id)
+}
+
+func (s *SessionPinService) GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionPin, error) {
+	return s.repo.GetByCourseSessionID(ctx, courseSessionID)
+}
+
+func (s *SessionPinService) Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error {
+	return s.repo.Delete(ctx, courseSessionID, id)
+}
+
+func (s *SessionPinService) Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionPinUpdate) (*models.SessionPin, error) {
+	if updates == nil {
+		return nil, fmt.Errorf("%w: updates cannot be nil", repository.ErrInvalidInput)
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrInvalidInput, err)
+	}
+
+	return s.repo.Update(ctx, courseSessionID, id, updates)
+}
@@ -0,0 +1,158 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type BuildingTravelTimeRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.BuildingTravelTimeRepositoryInterface
+	buildingRepo repository.BuildingRepositoryInterface
+	scienceBlock *models.Building
+	library      *models.Building
+}
+
+func (s *BuildingTravelTimeRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewBuildingTravelTimeRepository(s.testDB.DB, s.testDB.Logger)
+	s.buildingRepo = repository.NewBuildingRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *BuildingTravelTimeRepositorySuite) SetupTest() {
+	// Create two fresh buildings before each test
+	scienceBlock, err := s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Science Block", nil, nil))
+	s.Require().NoError(err)
+	s.scienceBlock = scienceBlock
+
+	library, err := s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Library", nil, nil))
+	s.Require().NoError(err)
+	s.library = library
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.building_travel_times")
+	s.testDB.Truncate("scheduler.buildings")
+}
+
+func (s *BuildingTravelTimeRepositorySuite) setTestTravelTime(minutes int32) *models.BuildingTravelTime {
+	travelTime, err := s.repo.Set(s.ctx, models.NewBuildingTravelTime(s.scienceBlock.ID, s.library.ID, minutes, nil, nil))
+	s.Require().NoError(err)
+	return travelTime
+}
+
+// TestSet
+func (s *BuildingTravelTimeRepositorySuite) TestSet_Success() {
+	actual := s.setTestTravelTime(10)
+
+	s.Require().Equal(s.scienceBlock.ID, actual.FromBuildingID)
+	s.Require().Equal(s.library.ID, actual.ToBuildingID)
+	s.Require().Equal(int32(10), actual.Minutes)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TestSet_ReplacesExisting() {
+	s.setTestTravelTime(10)
+
+	actual := s.setTestTravelTime(25)
+
+	s.Require().Equal(int32(25), actual.Minutes)
+
+	all, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(all, 1)
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TestSet_ValidationError() {
+	actual, err := s.repo.Set(s.ctx, models.NewBuildingTravelTime(s.scienceBlock.ID, s.scienceBlock.ID, 10, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TestSet_UnknownBuilding() {
+	_, err := s.repo.Set(s.ctx, models.NewBuildingTravelTime(s.scienceBlock.ID, uuid.New(), 10, nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestGetByID
+func (s *BuildingTravelTimeRepositorySuite) TestGetByID_Success() {
+	s.setTestTravelTime(10)
+
+	actual, err := s.repo.GetByID(s.ctx, s.scienceBlock.ID, s.library.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(int32(10), actual.Minutes)
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TestGetByID_ReverseNotFound() {
+	s.setTestTravelTime(10)
+
+	_, err := s.repo.GetByID(s.ctx, s.library.ID, s.scienceBlock.ID)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestGetByBuildingID
+func (s *BuildingTravelTimeRepositorySuite) TestGetByBuildingID_Success() {
+	s.setTestTravelTime(10)
+
+	actual, err := s.repo.GetByBuildingID(s.ctx, s.scienceBlock.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 1)
+
+	empty, err := s.repo.GetByBuildingID(s.ctx, s.library.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(empty)
+}
+
+// TestDelete
+func (s *BuildingTravelTimeRepositorySuite) TestDelete_Success() {
+	s.setTestTravelTime(10)
+
+	err := s.repo.Delete(s.ctx, s.scienceBlock.ID, s.library.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, s.scienceBlock.ID, s.library.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, s.scienceBlock.ID, s.library.ID)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *BuildingTravelTimeRepositorySuite) TestDelete_CascadesFromBuilding() {
+	s.setTestTravelTime(10)
+
+	s.Require().NoError(s.buildingRepo.Delete(s.ctx, s.library.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestBuildingTravelTimeRepositorySuite
+func TestBuildingTravelTimeRepositorySuite(t *testing.T) {
+	suite.Run(t, new(BuildingTravelTimeRepositorySuite))
+}
@@ -0,0 +1,206 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type CohortRepositorySuite struct {
+	suite.Suite
+	ctx         context.Context
+	testDB      *utils.TestDB
+	repo        repository.CohortRepositoryInterface
+	courseRepo  repository.CourseRepositoryInterface
+	testCourses []*models.Course
+}
+
+func (s *CohortRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewCohortRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *CohortRepositorySuite) SetupTest() {
+	// Create fresh courses before each test
+	s.testCourses = nil
+	for _, name := range []string{"Data Structures", "Discrete Maths"} {
+		course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), name, 0, 0, nil, nil))
+		s.Require().NoError(err)
+		s.testCourses = append(s.testCourses, course)
+	}
+}
+
+func (s *CohortRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *CohortRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.cohort_courses")
+	s.testDB.Truncate("scheduler.cohorts")
+	s.testDB.Truncate("scheduler.courses")
+}
+
+func (s *CohortRepositorySuite) courseIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(s.testCourses))
+	for i, course := range s.testCourses {
+		ids[i] = course.ID
+	}
+	return ids
+}
+
+// TestCreate
+func (s *CohortRepositorySuite) TestCreate_Success() {
+	expected := models.NewCohort(uuid.New(), "Year 2 Computer Science", s.courseIDs(), nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.Name, actual.Name)
+	s.Require().ElementsMatch(expected.CourseIDs, actual.CourseIDs)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *CohortRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), " ", nil, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *CohortRepositorySuite) TestCreate_UnknownCourse() {
+	cohort := models.NewCohort(uuid.New(), "Year 2 Computer Science", []uuid.UUID{uuid.New()}, nil, nil)
+
+	_, err := s.repo.Create(s.ctx, cohort)
+	s.Require().Error(err)
+
+	// The cohort row must be rolled back with its links
+	_, getErr := s.repo.GetByID(s.ctx, cohort.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+// TestCreateBatch
+func (s *CohortRepositorySuite) TestCreateBatch_Success() {
+	expected := []*models.Cohort{
+		models.NewCohort(uuid.New(), "Year 1 Computer Science", s.courseIDs()[:1], nil, nil),
+		models.NewCohort(uuid.New(), "Year 2 Computer Science", s.courseIDs(), nil, nil),
+	}
+
+	actual, err := s.repo.CreateBatch(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+// TestGetByID
+func (s *CohortRepositorySuite) TestGetByID_Success() {
+	cohort, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "Year 2 Computer Science", s.courseIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.GetByID(s.ctx, cohort.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(cohort.ID, actual.ID)
+	s.Require().ElementsMatch(s.courseIDs(), actual.CourseIDs)
+}
+
+func (s *CohortRepositorySuite) TestGetByID_NotFoundError() {
+	_, err := s.repo.GetByID(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestList
+func (s *CohortRepositorySuite) TestList_Success() {
+	_, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "Year 2 Computer Science", s.courseIDs(), nil, nil))
+	s.Require().NoError(err)
+	_, err = s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "Year 1 Computer Science", s.courseIDs()[:1], nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal("Year 1 Computer Science", actual[0].Name) // Ordered by name
+	s.Require().Len(actual[0].CourseIDs, 1)
+	s.Require().Len(actual[1].CourseIDs, 2)
+}
+
+// TestDelete
+func (s *CohortRepositorySuite) TestDelete_Success() {
+	cohort, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "Year 2 Computer Science", s.courseIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	err = s.repo.Delete(s.ctx, cohort.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, cohort.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *CohortRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestUpdate
+func (s *CohortRepositorySuite) TestUpdate_Name() {
+	cohort, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "Year 2 Computer Science", s.courseIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	newName := "Year 2 Software Engineering"
+	actual, err := s.repo.Update(s.ctx, cohort.ID, &models.CohortUpdate{Name: &newName})
+
+	s.Require().NoError(err)
+	s.Require().Equal(newName, actual.Name)
+	s.Require().ElementsMatch(s.courseIDs(), actual.CourseIDs) // Unchanged
+}
+
+func (s *CohortRepositorySuite) TestUpdate_ReplacesCourses() {
+	cohort, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "Year 2 Computer Science", s.courseIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	courseIDs := s.courseIDs()[1:]
+	actual, err := s.repo.Update(s.ctx, cohort.ID, &models.CohortUpdate{CourseIDs: &courseIDs})
+
+	s.Require().NoError(err)
+	s.Require().Equal(cohort.Name, actual.Name)
+	s.Require().Equal(courseIDs, actual.CourseIDs)
+}
+
+func (s *CohortRepositorySuite) TestUpdate_NotFound() {
+	courseIDs := s.courseIDs()
+	_, err := s.repo.Update(s.ctx, uuid.New(), &models.CohortUpdate{CourseIDs: &courseIDs})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *CohortRepositorySuite) TestUpdate_ValidationError() {
+	cohort, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "Year 2 Computer Science", nil, nil, nil))
+	s.Require().NoError(err)
+
+	emptyName := " "
+	_, err = s.repo.Update(s.ctx, cohort.ID, &models.CohortUpdate{Name: &emptyName})
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+}
+
+// TestCohortRepositorySuite
+func TestCohortRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CohortRepositorySuite))
+}
@@ -40,6 +40,8 @@ func (s *CourseRepositorySuite) TestCreate_Success() {
 	expected := models.NewCourse(
 		uuid.New(),
 		"Introduction to Data Analytics",
+		0,
+		0,
 		&now,
 		nil,
 	)
@@ -56,6 +58,8 @@ func (s *CourseRepositorySuite) TestCreate_ValidationError() {
 	expected := models.NewCourse(
 		uuid.New(),
 		" ",
+		0,
+		0,
 		&now,
 		nil,
 	)
@@ -72,6 +76,8 @@ func (s *CourseRepositorySuite) TestDelete_Success() {
 	course, _ := s.repo.Create(s.ctx, models.NewCourse(
 		uuid.New(),
 		"Introduction to Data Analytics",
+		0,
+		0,
 		&now,
 		nil,
 	))
@@ -97,8 +103,8 @@ func (s *CourseRepositorySuite) TestCreateBatch_Success() {
 	now := time.Now()
 
 	expected := []*models.Course{
-		models.NewCourse(uuid.New(), "Course 1", &now, nil),
-		models.NewCourse(uuid.New(), "Course 2", &now, nil),
+		models.NewCourse(uuid.New(), "Course 1", 0, 0, &now, nil),
+		models.NewCourse(uuid.New(), "Course 2", 0, 0, &now, nil),
 	}
 
 	actual, err := s.repo.CreateBatch(s.ctx, expected)
@@ -114,8 +120,8 @@ func (s *CourseRepositorySuite) TestCreateBatch_ValidationError() {
 	now := time.Now()
 
 	expected := []*models.Course{
-		models.NewCourse(uuid.New(), " ", &now, nil),
-		models.NewCourse(uuid.New(), "Course 2", &now, nil),
+		models.NewCourse(uuid.New(), " ", 0, 0, &now, nil),
+		models.NewCourse(uuid.New(), "Course 2", 0, 0, &now, nil),
 	}
 
 	_, err := s.repo.CreateBatch(s.ctx, expected)
@@ -131,8 +137,8 @@ func (s *CourseRepositorySuite) TestCreateBatch_RollbackOnError() {
 	// First course is invalid (empty name), second is valid
 	// Transaction should rollback, leaving no courses in DB
 	courses := []*models.Course{
-		models.NewCourse(uuid.New(), "Valid Course", &now, nil),
-		models.NewCourse(uuid.New(), " ", &now, nil), // Invalid - will fail validation
+		models.NewCourse(uuid.New(), "Valid Course", 0, 0, &now, nil),
+		models.NewCourse(uuid.New(), " ", 0, 0, &now, nil), // Invalid - will fail validation
 	}
 
 	_, createErr := s.repo.CreateBatch(s.ctx, courses)
@@ -150,7 +156,7 @@ func (s *CourseRepositorySuite) TestCreateBatch_RollbackOnError() {
 // TestGetByID
 func (s *CourseRepositorySuite) TestGetByID_Success() {
 	now := time.Now()
-	expected, _ := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Introduction to Data Analytics", &now, nil))
+	expected, _ := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Introduction to Data Analytics", 0, 0, &now, nil))
 
 	actual, err := s.repo.GetByID(s.ctx, expected.ID)
 
@@ -169,8 +175,8 @@ func (s *CourseRepositorySuite) TestGetByID_NotFoundError() {
 func (s *CourseRepositorySuite) TestList_Success() {
 	now := time.Now()
 	// Note: List orders by Name ASC, so "Advanced" comes before "Introduction"
-	expected1, _ := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Advanced Data Analytics", &now, nil))
-	expected2, _ := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Introduction to Data Analytics", &now, nil))
+	expected1, _ := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Advanced Data Analytics", 0, 0, &now, nil))
+	expected2, _ := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Introduction to Data Analytics", 0, 0, &now, nil))
 
 	actual, err := s.repo.List(s.ctx)
 
@@ -193,7 +199,7 @@ func (s *CourseRepositorySuite) TestList_Empty() {
 // TestUpdateCourse
 func (s *CourseRepositorySuite) TestUpdateCourse_Success() {
 	now := time.Now()
-	course, createErr := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Advnced Data Analytics", &now, nil))
+	course, createErr := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Advnced Data Analytics", 0, 0, &now, nil))
 
 	updatedName := "Adv. Data Analytics"
 	actual, updateErr := s.repo.Update(s.ctx, course.ID, &models.CourseUpdate{
@@ -207,6 +213,38 @@ func (s *CourseRepositorySuite) TestUpdateCourse_Success() {
 	s.Require().Contains(actual.Name, "Adv.")
 }
 
+func (s *CourseRepositorySuite) TestUpdateCourse_Enrollment() {
+	now := time.Now()
+	course, createErr := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Data Structures", 40, 0, &now, nil))
+
+	enrollment := int32(180)
+	actual, updateErr := s.repo.Update(s.ctx, course.ID, &models.CourseUpdate{
+		Enrollment: &enrollment,
+	})
+
+	s.Require().NoError(createErr)
+	s.Require().Equal(int32(40), course.Enrollment)
+	s.Require().NoError(updateErr)
+	s.Require().Equal(enrollment, actual.Enrollment)
+	s.Require().Equal(course.Name, actual.Name) // Unchanged
+}
+
+func (s *CourseRepositorySuite) TestUpdateCourse_Priority() {
+	now := time.Now()
+	course, createErr := s.repo.Create(s.ctx, models.NewCourse(uuid.New(), "Capstone Project", 40, 2, &now, nil))
+
+	priority := int32(5)
+	actual, updateErr := s.repo.Update(s.ctx, course.ID, &models.CourseUpdate{
+		Priority: &priority,
+	})
+
+	s.Require().NoError(createErr)
+	s.Require().Equal(int32(2), course.Priority)
+	s.Require().NoError(updateErr)
+	s.Require().Equal(priority, actual.Priority)
+	s.Require().Equal(course.Enrollment, actual.Enrollment) // Unchanged
+}
+
 func (s *CourseRepositorySuite) TestUpdateCourse_ValidationError() {
 	updatedName := ""
 	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.CourseUpdate{
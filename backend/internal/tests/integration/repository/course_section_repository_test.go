@@ -0,0 +1,202 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type CourseSectionRepositorySuite struct {
+	suite.Suite
+	ctx            context.Context
+	testDB         *utils.TestDB
+	repo           repository.CourseSectionRepositoryInterface
+	courseRepo     repository.CourseRepositoryInterface
+	instructorRepo repository.InstructorRepositoryInterface
+	course         *models.Course
+	instructor     *models.Instructor
+}
+
+func (s *CourseSectionRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewCourseSectionRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.instructorRepo = repository.NewInstructorRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *CourseSectionRepositorySuite) SetupTest() {
+	// Create a fresh course and instructor for the sections
+	var err error
+	s.course, err = s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Calculus", 0, 0, nil, nil))
+	s.Require().NoError(err)
+
+	s.instructor, err = s.instructorRepo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Smith", nil, nil, nil))
+	s.Require().NoError(err)
+}
+
+func (s *CourseSectionRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *CourseSectionRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.course_sections")
+	s.testDB.Truncate("scheduler.instructors")
+	s.testDB.Truncate("scheduler.courses")
+}
+
+func (s *CourseSectionRepositorySuite) createTestSection(name string) *models.CourseSection {
+	section, err := s.repo.Create(s.ctx, models.NewCourseSection(uuid.New(), s.course.ID, name, nil, nil, nil, nil))
+	s.Require().NoError(err)
+	return section
+}
+
+// TestCreate
+func (s *CourseSectionRepositorySuite) TestCreate_Success() {
+	capacity := int32(30)
+	expected := models.NewCourseSection(uuid.New(), s.course.ID, "Section A", &capacity, &s.instructor.ID, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.CourseID, actual.CourseID)
+	s.Require().Equal(expected.Name, actual.Name)
+	s.Require().Equal(capacity, *actual.Capacity)
+	s.Require().Equal(s.instructor.ID, *actual.InstructorID)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *CourseSectionRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewCourseSection(uuid.New(), s.course.ID, " ", nil, nil, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *CourseSectionRepositorySuite) TestCreate_UnknownCourse() {
+	_, err := s.repo.Create(s.ctx, models.NewCourseSection(uuid.New(), uuid.New(), "Section A", nil, nil, nil, nil))
+
+	s.Require().Error(err)
+}
+
+func (s *CourseSectionRepositorySuite) TestCreate_DuplicateName() {
+	s.createTestSection("Section A")
+
+	_, err := s.repo.Create(s.ctx, models.NewCourseSection(uuid.New(), s.course.ID, "Section A", nil, nil, nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestGetByID
+func (s *CourseSectionRepositorySuite) TestGetByID_Success() {
+	section := s.createTestSection("Section A")
+
+	actual, err := s.repo.GetByID(s.ctx, s.course.ID, section.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(section.ID, actual.ID)
+	s.Require().Nil(actual.Capacity)
+	s.Require().Nil(actual.InstructorID)
+}
+
+func (s *CourseSectionRepositorySuite) TestGetByID_WrongCourse() {
+	section := s.createTestSection("Section A")
+
+	_, err := s.repo.GetByID(s.ctx, uuid.New(), section.ID)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestGetByCourseID
+func (s *CourseSectionRepositorySuite) TestGetByCourseID_Success() {
+	s.createTestSection("Section B")
+	s.createTestSection("Section A")
+
+	actual, err := s.repo.GetByCourseID(s.ctx, s.course.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal("Section A", actual[0].Name) // Ordered by name
+}
+
+func (s *CourseSectionRepositorySuite) TestGetByCourseID_Empty() {
+	actual, err := s.repo.GetByCourseID(s.ctx, s.course.ID)
+
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestList
+func (s *CourseSectionRepositorySuite) TestList_Success() {
+	s.createTestSection("Section A")
+	s.createTestSection("Section B")
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+// TestDelete
+func (s *CourseSectionRepositorySuite) TestDelete_Success() {
+	section := s.createTestSection("Section A")
+
+	err := s.repo.Delete(s.ctx, s.course.ID, section.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, s.course.ID, section.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *CourseSectionRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, s.course.ID, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *CourseSectionRepositorySuite) TestDelete_InstructorClearsSection() {
+	section, err := s.repo.Create(s.ctx, models.NewCourseSection(uuid.New(), s.course.ID, "Section A", nil, &s.instructor.ID, nil, nil))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.instructorRepo.Delete(s.ctx, s.instructor.ID))
+
+	actual, err := s.repo.GetByID(s.ctx, s.course.ID, section.ID)
+	s.Require().NoError(err)
+	s.Require().Nil(actual.InstructorID)
+}
+
+// TestUpdate
+func (s *CourseSectionRepositorySuite) TestUpdate_Success() {
+	section := s.createTestSection("Section A")
+
+	newCapacity := int32(45)
+	actual, err := s.repo.Update(s.ctx, s.course.ID, section.ID, &models.CourseSectionUpdate{Capacity: &newCapacity})
+
+	s.Require().NoError(err)
+	s.Require().Equal(newCapacity, *actual.Capacity)
+	s.Require().Equal(section.Name, actual.Name) // Unchanged
+}
+
+func (s *CourseSectionRepositorySuite) TestUpdate_NotFound() {
+	newName := "Section Z"
+	_, err := s.repo.Update(s.ctx, s.course.ID, uuid.New(), &models.CourseSectionUpdate{Name: &newName})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestCourseSectionRepositorySuite
+func TestCourseSectionRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CourseSectionRepositorySuite))
+}
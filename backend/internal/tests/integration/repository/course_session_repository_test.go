@@ -32,12 +32,12 @@ func (s *CourseSessionRepositorySuite) SetupSuite() {
 
 func (s *CourseSessionRepositorySuite) SetupTest() {
 	// Create a fresh course before each test
-	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Test Course", nil, nil))
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Test Course", 0, 0, nil, nil))
 	s.Require().NoError(err)
 	s.testCourse = course
 
 	// Create a fresh room type before each test
-	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil))
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil, nil))
 	s.Require().NoError(err)
 	s.testRoomType = roomType
 }
@@ -64,6 +64,10 @@ func (s *CourseSessionRepositorySuite) createTestSession() *models.CourseSession
 		&numSessions,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 }
 
@@ -84,6 +88,36 @@ func (s *CourseSessionRepositorySuite) TestCreate_Success() {
 	s.Require().NotNil(actual.CreatedAt)
 }
 
+func (s *CourseSessionRepositorySuite) TestCreate_Windows() {
+	expected := s.createTestSession()
+	monday := int32(0)
+	expected.AllowedWindows = []models.SessionWindow{{StartTime: 1020, EndTime: 1260}}
+	expected.PreferredWindows = []models.SessionWindow{{Day: &monday, StartTime: 1080, EndTime: 1200}}
+
+	_, err := s.repo.Create(s.ctx, expected)
+	s.Require().NoError(err)
+
+	actual, err := s.repo.GetByID(s.ctx, expected.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.AllowedWindows, actual.AllowedWindows)
+	s.Require().Equal(expected.PreferredWindows, actual.PreferredWindows)
+}
+
+func (s *CourseSessionRepositorySuite) TestCreate_Spread() {
+	expected := s.createTestSession()
+	minDays := int32(2)
+	expected.Spread = &models.SpreadRules{MinDaysBetween: &minDays, DayPatterns: [][]int32{{0, 2, 4}}}
+
+	_, err := s.repo.Create(s.ctx, expected)
+	s.Require().NoError(err)
+
+	actual, err := s.repo.GetByID(s.ctx, expected.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.Spread, actual.Spread)
+}
+
 func (s *CourseSessionRepositorySuite) TestCreate_ValidationError() {
 	duration := int32(60)
 	numSessions := int32(2)
@@ -96,6 +130,10 @@ func (s *CourseSessionRepositorySuite) TestCreate_ValidationError() {
 		&numSessions,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	actual, err := s.repo.Create(s.ctx, session)
@@ -113,8 +151,8 @@ func (s *CourseSessionRepositorySuite) TestCreateBatch_Success() {
 	numSessions2 := int32(1)
 
 	expected := []*models.CourseSession{
-		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lecture", &duration1, &numSessions1, nil, nil),
-		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "tutorial", &duration2, &numSessions2, nil, nil),
+		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lecture", &duration1, &numSessions1, nil, nil, nil, nil, nil, nil),
+		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "tutorial", &duration2, &numSessions2, nil, nil, nil, nil, nil, nil),
 	}
 
 	actual, err := s.repo.CreateBatch(s.ctx, expected)
@@ -133,8 +171,8 @@ func (s *CourseSessionRepositorySuite) TestCreateBatch_RollbackOnError() {
 	numSessions := int32(2)
 
 	sessions := []*models.CourseSession{
-		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lecture", &duration, &numSessions, nil, nil),
-		models.NewCourseSession(uuid.New(), s.testCourse.ID, "", "lecture", &duration, &numSessions, nil, nil), // Invalid - empty required room
+		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil),
+		models.NewCourseSession(uuid.New(), s.testCourse.ID, "", "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil), // Invalid - empty required room
 	}
 
 	_, createErr := s.repo.CreateBatch(s.ctx, sessions)
@@ -153,8 +191,8 @@ func (s *CourseSessionRepositorySuite) TestCreateBatch_ValidationError() {
 	numSessions := int32(2)
 
 	sessions := []*models.CourseSession{
-		models.NewCourseSession(uuid.New(), s.testCourse.ID, " ", "lecture", &duration, &numSessions, nil, nil), // Invalid
-		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lecture", &duration, &numSessions, nil, nil),
+		models.NewCourseSession(uuid.New(), s.testCourse.ID, " ", "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil), // Invalid
+		models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil),
 	}
 
 	actual, err := s.repo.CreateBatch(s.ctx, sessions)
@@ -191,8 +229,8 @@ func (s *CourseSessionRepositorySuite) TestGetByCourseID_Success() {
 	// Note: PostgreSQL enums are ordered by definition position, not alphabetically
 	// The enum is defined as: ('lab', 'tutorial', 'lecture')
 	// So order is: lab (0) < tutorial (1) < lecture (2)
-	session1, _ := s.repo.Create(s.ctx, models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lab", &duration, &numSessions, nil, nil))
-	session2, _ := s.repo.Create(s.ctx, models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "tutorial", &duration, &numSessions, nil, nil))
+	session1, _ := s.repo.Create(s.ctx, models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "lab", &duration, &numSessions, nil, nil, nil, nil, nil, nil))
+	session2, _ := s.repo.Create(s.ctx, models.NewCourseSession(uuid.New(), s.testCourse.ID, s.testRoomType.Name, "tutorial", &duration, &numSessions, nil, nil, nil, nil, nil, nil))
 
 	actual, err := s.repo.GetByCourseID(s.ctx, s.testCourse.ID)
 
@@ -273,6 +311,51 @@ func (s *CourseSessionRepositorySuite) TestUpdate_Success() {
 	s.Require().Equal(session.CourseID, actual.CourseID) // Unchanged
 }
 
+func (s *CourseSessionRepositorySuite) TestUpdate_Enrollment() {
+	session, _ := s.repo.Create(s.ctx, s.createTestSession())
+	s.Require().Nil(session.Enrollment)
+
+	enrollment := int32(24)
+	actual, err := s.repo.Update(s.ctx, session.ID, &models.CourseSessionUpdate{
+		Enrollment: &enrollment,
+	})
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual.Enrollment)
+	s.Require().Equal(enrollment, *actual.Enrollment)
+}
+
+func (s *CourseSessionRepositorySuite) TestUpdate_Windows() {
+	session := s.createTestSession()
+	session.PreferredWindows = []models.SessionWindow{{StartTime: 480, EndTime: 720}}
+	session, _ = s.repo.Create(s.ctx, session)
+
+	allowed := []models.SessionWindow{{StartTime: 1020, EndTime: 1260}}
+	cleared := []models.SessionWindow{}
+	actual, err := s.repo.Update(s.ctx, session.ID, &models.CourseSessionUpdate{
+		AllowedWindows:   &allowed,
+		PreferredWindows: &cleared,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(allowed, actual.AllowedWindows)
+	s.Require().Empty(actual.PreferredWindows)
+}
+
+func (s *CourseSessionRepositorySuite) TestUpdate_Spread() {
+	session := s.createTestSession()
+	maxPerDay := int32(1)
+	session.Spread = &models.SpreadRules{MaxPerDay: &maxPerDay}
+	session, _ = s.repo.Create(s.ctx, session)
+
+	actual, err := s.repo.Update(s.ctx, session.ID, &models.CourseSessionUpdate{
+		Spread: &models.SpreadRules{},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Nil(actual.Spread)
+}
+
 func (s *CourseSessionRepositorySuite) TestUpdate_NotFound() {
 	newDuration := int32(90)
 	updates := &models.CourseSessionUpdate{
@@ -0,0 +1,185 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type InstructorAvailabilityRepositorySuite struct {
+	suite.Suite
+	ctx            context.Context
+	testDB         *utils.TestDB
+	repo           repository.InstructorAvailabilityRepositoryInterface
+	instructorRepo repository.InstructorRepositoryInterface
+	testInstructor *models.Instructor
+}
+
+func (s *InstructorAvailabilityRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewInstructorAvailabilityRepository(s.testDB.DB, s.testDB.Logger)
+	s.instructorRepo = repository.NewInstructorRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *InstructorAvailabilityRepositorySuite) SetupTest() {
+	// Create a fresh instructor before each test
+	instructor, err := s.instructorRepo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Smith", nil, nil, nil))
+	s.Require().NoError(err)
+	s.testInstructor = instructor
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.instructor_availability")
+	s.testDB.Truncate("scheduler.instructors")
+}
+
+func (s *InstructorAvailabilityRepositorySuite) createTestAvailability(kind string, day, start, end int32) *models.InstructorAvailability {
+	availability, err := s.repo.Create(s.ctx, models.NewInstructorAvailability(uuid.New(), s.testInstructor.ID, kind, day, start, end, nil, nil))
+	s.Require().NoError(err)
+	return availability
+}
+
+// TestCreate
+func (s *InstructorAvailabilityRepositorySuite) TestCreate_Success() {
+	expected := models.NewInstructorAvailability(uuid.New(), s.testInstructor.ID, models.AvailabilityPreferred, 1, 480, 720, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.InstructorID, actual.InstructorID)
+	s.Require().Equal(models.AvailabilityPreferred, actual.Kind)
+	s.Require().Equal(expected.Day, actual.Day)
+	s.Require().Equal(expected.StartTime, actual.StartTime)
+	s.Require().Equal(expected.EndTime, actual.EndTime)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewInstructorAvailability(uuid.New(), s.testInstructor.ID, "sometimes", 0, 480, 600, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TestCreate_UnknownInstructor() {
+	_, err := s.repo.Create(s.ctx, models.NewInstructorAvailability(uuid.New(), uuid.New(), models.AvailabilityUnavailable, 0, 480, 540, nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestGetByID
+func (s *InstructorAvailabilityRepositorySuite) TestGetByID_Success() {
+	availability := s.createTestAvailability(models.AvailabilityUnavailable, 0, 480, 600)
+
+	actual, err := s.repo.GetByID(s.ctx, s.testInstructor.ID, availability.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(availability.ID, actual.ID)
+	s.Require().Equal(models.AvailabilityUnavailable, actual.Kind)
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TestGetByID_WrongInstructor() {
+	availability := s.createTestAvailability(models.AvailabilityUnavailable, 0, 480, 600)
+
+	_, err := s.repo.GetByID(s.ctx, uuid.New(), availability.ID)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestGetByInstructorID
+func (s *InstructorAvailabilityRepositorySuite) TestGetByInstructorID_Success() {
+	s.createTestAvailability(models.AvailabilityPreferred, 4, 780, 1020)
+	s.createTestAvailability(models.AvailabilityUnavailable, 0, 480, 600)
+
+	actual, err := s.repo.GetByInstructorID(s.ctx, s.testInstructor.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(int32(0), actual[0].Day) // Ordered by day
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TestGetByInstructorID_Empty() {
+	actual, err := s.repo.GetByInstructorID(s.ctx, s.testInstructor.ID)
+
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestList
+func (s *InstructorAvailabilityRepositorySuite) TestList_Success() {
+	s.createTestAvailability(models.AvailabilityUnavailable, 0, 480, 600)
+	s.createTestAvailability(models.AvailabilityPreferred, 4, 780, 1020)
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+// TestDelete
+func (s *InstructorAvailabilityRepositorySuite) TestDelete_Success() {
+	availability := s.createTestAvailability(models.AvailabilityUnavailable, 0, 480, 600)
+
+	err := s.repo.Delete(s.ctx, s.testInstructor.ID, availability.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, s.testInstructor.ID, availability.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, s.testInstructor.ID, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TestDelete_CascadesFromInstructor() {
+	s.createTestAvailability(models.AvailabilityUnavailable, 0, 480, 600)
+
+	s.Require().NoError(s.instructorRepo.Delete(s.ctx, s.testInstructor.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestUpdate
+func (s *InstructorAvailabilityRepositorySuite) TestUpdate_Success() {
+	availability := s.createTestAvailability(models.AvailabilityUnavailable, 0, 480, 600)
+
+	kind := models.AvailabilityPreferred
+	actual, err := s.repo.Update(s.ctx, s.testInstructor.ID, availability.ID, &models.InstructorAvailabilityUpdate{Kind: &kind})
+
+	s.Require().NoError(err)
+	s.Require().Equal(models.AvailabilityPreferred, actual.Kind)
+	s.Require().Equal(availability.EndTime, actual.EndTime) // Unchanged
+}
+
+func (s *InstructorAvailabilityRepositorySuite) TestUpdate_NotFound() {
+	newEnd := int32(660)
+	_, err := s.repo.Update(s.ctx, s.testInstructor.ID, uuid.New(), &models.InstructorAvailabilityUpdate{EndTime: &newEnd})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestInstructorAvailabilityRepositorySuite
+func TestInstructorAvailabilityRepositorySuite(t *testing.T) {
+	suite.Run(t, new(InstructorAvailabilityRepositorySuite))
+}
@@ -0,0 +1,276 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type InstructorRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.InstructorRepositoryInterface
+	courseRepo   repository.CourseRepositoryInterface
+	sessionRepo  repository.CourseSessionRepositoryInterface
+	roomTypeRepo repository.RoomTypeRepositoryInterface
+	testSession  *models.CourseSession
+}
+
+func (s *InstructorRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewInstructorRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.sessionRepo = repository.NewCourseSessionRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomTypeRepo = repository.NewRoomTypeRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *InstructorRepositorySuite) SetupTest() {
+	// Create a fresh course session to assign instructors to
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Test Course", 0, 0, nil, nil))
+	s.Require().NoError(err)
+
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil, nil))
+	s.Require().NoError(err)
+
+	duration := int32(60)
+	numSessions := int32(1)
+	session, err := s.sessionRepo.Create(s.ctx, models.NewCourseSession(
+		uuid.New(), course.ID, roomType.Name, "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil,
+	))
+	s.Require().NoError(err)
+	s.testSession = session
+}
+
+func (s *InstructorRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *InstructorRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.course_session_instructors")
+	s.testDB.Truncate("scheduler.instructors")
+	s.testDB.Truncate("scheduler.course_sessions")
+	s.testDB.Truncate("scheduler.courses")
+	s.testDB.Truncate("scheduler.room_types")
+}
+
+func (s *InstructorRepositorySuite) createTestInstructor(name string) *models.Instructor {
+	instructor, err := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), name, nil, nil, nil))
+	s.Require().NoError(err)
+	return instructor
+}
+
+// TestCreate
+func (s *InstructorRepositorySuite) TestCreate_Success() {
+	email := "smith@example.com"
+	expected := models.NewInstructor(uuid.New(), "Dr. Smith", &email, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.Name, actual.Name)
+	s.Require().Equal(email, *actual.Email)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *InstructorRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), " ", nil, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *InstructorRepositorySuite) TestCreate_DuplicateEmail() {
+	email := "smith@example.com"
+	_, err := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Smith", &email, nil, nil))
+	s.Require().NoError(err)
+
+	_, err = s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Smyth", &email, nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestCreateBatch
+func (s *InstructorRepositorySuite) TestCreateBatch_Success() {
+	expected := []*models.Instructor{
+		models.NewInstructor(uuid.New(), "Dr. Smith", nil, nil, nil),
+		models.NewInstructor(uuid.New(), "Dr. Jones", nil, nil, nil),
+	}
+
+	actual, err := s.repo.CreateBatch(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+func (s *InstructorRepositorySuite) TestCreateBatch_RollbackOnError() {
+	instructors := []*models.Instructor{
+		models.NewInstructor(uuid.New(), "Dr. Smith", nil, nil, nil),
+		models.NewInstructor(uuid.New(), "", nil, nil, nil), // Invalid - empty name
+	}
+
+	_, err := s.repo.CreateBatch(s.ctx, instructors)
+	s.Require().Error(err)
+
+	// Verify nothing was committed
+	list, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(list)
+}
+
+// TestGetByID
+func (s *InstructorRepositorySuite) TestGetByID_Success() {
+	instructor := s.createTestInstructor("Dr. Smith")
+
+	actual, err := s.repo.GetByID(s.ctx, instructor.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(instructor.ID, actual.ID)
+}
+
+func (s *InstructorRepositorySuite) TestGetByID_NotFoundError() {
+	_, err := s.repo.GetByID(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestList
+func (s *InstructorRepositorySuite) TestList_Success() {
+	s.createTestInstructor("Dr. Smith")
+	s.createTestInstructor("Dr. Jones")
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal("Dr. Jones", actual[0].Name) // Ordered by name
+}
+
+// TestDelete
+func (s *InstructorRepositorySuite) TestDelete_Success() {
+	instructor := s.createTestInstructor("Dr. Smith")
+
+	err := s.repo.Delete(s.ctx, instructor.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, instructor.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *InstructorRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *InstructorRepositorySuite) TestDelete_RemovesAssignments() {
+	instructor := s.createTestInstructor("Dr. Smith")
+	_, err := s.repo.Assign(s.ctx, s.testSession.ID, instructor.ID)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.Delete(s.ctx, instructor.ID))
+
+	assignments, err := s.repo.ListAssignments(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(assignments)
+}
+
+// TestUpdate
+func (s *InstructorRepositorySuite) TestUpdate_Success() {
+	instructor := s.createTestInstructor("Dr. Smith")
+
+	newName := "Prof. Smith"
+	actual, err := s.repo.Update(s.ctx, instructor.ID, &models.InstructorUpdate{Name: &newName})
+
+	s.Require().NoError(err)
+	s.Require().Equal(newName, actual.Name)
+}
+
+func (s *InstructorRepositorySuite) TestUpdate_NotFound() {
+	newName := "Prof. Smith"
+	_, err := s.repo.Update(s.ctx, uuid.New(), &models.InstructorUpdate{Name: &newName})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *InstructorRepositorySuite) TestUpdate_ValidationError() {
+	instructor := s.createTestInstructor("Dr. Smith")
+
+	badEmail := "not-an-email"
+	_, err := s.repo.Update(s.ctx, instructor.ID, &models.InstructorUpdate{Email: &badEmail})
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+}
+
+// TestAssign
+func (s *InstructorRepositorySuite) TestAssign_Success() {
+	instructor := s.createTestInstructor("Dr. Smith")
+
+	assignment, err := s.repo.Assign(s.ctx, s.testSession.ID, instructor.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(s.testSession.ID, assignment.CourseSessionID)
+	s.Require().Equal(instructor.ID, assignment.InstructorID)
+
+	instructors, err := s.repo.GetBySessionID(s.ctx, s.testSession.ID)
+	s.Require().NoError(err)
+	s.Require().Len(instructors, 1)
+	s.Require().Equal(instructor.ID, instructors[0].ID)
+}
+
+func (s *InstructorRepositorySuite) TestAssign_Duplicate() {
+	instructor := s.createTestInstructor("Dr. Smith")
+	_, err := s.repo.Assign(s.ctx, s.testSession.ID, instructor.ID)
+	s.Require().NoError(err)
+
+	_, err = s.repo.Assign(s.ctx, s.testSession.ID, instructor.ID)
+
+	s.Require().Error(err)
+}
+
+func (s *InstructorRepositorySuite) TestAssign_UnknownInstructor() {
+	_, err := s.repo.Assign(s.ctx, s.testSession.ID, uuid.New())
+
+	s.Require().Error(err)
+}
+
+// TestUnassign
+func (s *InstructorRepositorySuite) TestUnassign_Success() {
+	instructor := s.createTestInstructor("Dr. Smith")
+	_, err := s.repo.Assign(s.ctx, s.testSession.ID, instructor.ID)
+	s.Require().NoError(err)
+
+	err = s.repo.Unassign(s.ctx, s.testSession.ID, instructor.ID)
+
+	s.Require().NoError(err)
+
+	instructors, err := s.repo.GetBySessionID(s.ctx, s.testSession.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(instructors)
+}
+
+func (s *InstructorRepositorySuite) TestUnassign_NotFound() {
+	err := s.repo.Unassign(s.ctx, s.testSession.ID, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestInstructorRepositorySuite
+func TestInstructorRepositorySuite(t *testing.T) {
+	suite.Run(t, new(InstructorRepositorySuite))
+}
@@ -38,7 +38,7 @@ func (s *RoomRepositorySuite) SetupTest() {
 	s.testBuilding = building
 
 	// Create a fresh room type before each test
-	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil))
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil, nil))
 	s.Require().NoError(err)
 	s.testRoomType = roomType
 }
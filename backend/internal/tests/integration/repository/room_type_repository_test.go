@@ -33,7 +33,7 @@ func (s *RoomTypeRepositorySuite) TearDownSuite() {
 
 // TestCreate
 func (s *RoomTypeRepositorySuite) TestCreate_Success() {
-	expected := models.NewRoomType("lecture_hall", nil, nil)
+	expected := models.NewRoomType("lecture_hall", nil, nil, nil)
 
 	actual, err := s.repo.Create(s.ctx, expected)
 
@@ -44,7 +44,7 @@ func (s *RoomTypeRepositorySuite) TestCreate_Success() {
 }
 
 func (s *RoomTypeRepositorySuite) TestCreate_ValidationError() {
-	expected := models.NewRoomType(" ", nil, nil)
+	expected := models.NewRoomType(" ", nil, nil, nil)
 
 	actual, err := s.repo.Create(s.ctx, expected)
 
@@ -53,11 +53,38 @@ func (s *RoomTypeRepositorySuite) TestCreate_ValidationError() {
 	s.Require().Nil(actual)
 }
 
+func (s *RoomTypeRepositorySuite) TestCreate_Substitutes() {
+	_, err := s.repo.CreateBatch(s.ctx, []*models.RoomType{
+		models.NewRoomType("lecture_room", nil, nil, nil),
+		models.NewRoomType("lecture_hall", nil, nil, nil),
+	})
+	s.Require().NoError(err)
+
+	_, err = s.repo.Create(s.ctx, models.NewRoomType("seminar_room", []string{"lecture_room", "lecture_hall"}, nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.GetByName(s.ctx, "seminar_room")
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"lecture_room", "lecture_hall"}, actual.Substitutes) // In preference order
+}
+
+func (s *RoomTypeRepositorySuite) TestCreate_UnknownSubstitute() {
+	actual, err := s.repo.Create(s.ctx, models.NewRoomType("seminar_room", []string{"nonexistent_type"}, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+
+	// The room type is not created without its substitutes
+	_, getErr := s.repo.GetByName(s.ctx, "seminar_room")
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
 // TestCreateBatch
 func (s *RoomTypeRepositorySuite) TestCreateBatch_Success() {
 	expected := []*models.RoomType{
-		models.NewRoomType("lecture_hall", nil, nil),
-		models.NewRoomType("computer_lab", nil, nil),
+		models.NewRoomType("lecture_hall", nil, nil, nil),
+		models.NewRoomType("computer_lab", nil, nil, nil),
 	}
 
 	actual, err := s.repo.CreateBatch(s.ctx, expected)
@@ -72,8 +99,8 @@ func (s *RoomTypeRepositorySuite) TestCreateBatch_Success() {
 
 func (s *RoomTypeRepositorySuite) TestCreateBatch_RollbackOnError() {
 	roomTypes := []*models.RoomType{
-		models.NewRoomType("lecture_hall", nil, nil),
-		models.NewRoomType("", nil, nil), // Invalid - empty name
+		models.NewRoomType("lecture_hall", nil, nil, nil),
+		models.NewRoomType("", nil, nil, nil), // Invalid - empty name
 	}
 
 	_, createErr := s.repo.CreateBatch(s.ctx, roomTypes)
@@ -89,8 +116,8 @@ func (s *RoomTypeRepositorySuite) TestCreateBatch_RollbackOnError() {
 
 func (s *RoomTypeRepositorySuite) TestCreateBatch_ValidationError() {
 	roomTypes := []*models.RoomType{
-		models.NewRoomType(" ", nil, nil), // Invalid
-		models.NewRoomType("computer_lab", nil, nil),
+		models.NewRoomType(" ", nil, nil, nil), // Invalid
+		models.NewRoomType("computer_lab", nil, nil, nil),
 	}
 
 	actual, err := s.repo.CreateBatch(s.ctx, roomTypes)
@@ -102,7 +129,7 @@ func (s *RoomTypeRepositorySuite) TestCreateBatch_ValidationError() {
 
 // TestGetByName
 func (s *RoomTypeRepositorySuite) TestGetByName_Success() {
-	expected, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil))
+	expected, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil, nil))
 
 	actual, err := s.repo.GetByName(s.ctx, expected.Name)
 
@@ -120,8 +147,8 @@ func (s *RoomTypeRepositorySuite) TestGetByName_NotFoundError() {
 // TestList
 func (s *RoomTypeRepositorySuite) TestList_Success() {
 	// List orders by Name ASC
-	expected1, _ := s.repo.Create(s.ctx, models.NewRoomType("computer_lab", nil, nil))
-	expected2, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil))
+	expected1, _ := s.repo.Create(s.ctx, models.NewRoomType("computer_lab", nil, nil, nil))
+	expected2, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil, nil))
 
 	actual, err := s.repo.List(s.ctx)
 
@@ -141,7 +168,7 @@ func (s *RoomTypeRepositorySuite) TestList_Empty() {
 
 // TestDelete
 func (s *RoomTypeRepositorySuite) TestDelete_Success() {
-	roomType, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil))
+	roomType, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil, nil))
 
 	err := s.repo.Delete(s.ctx, roomType.Name)
 
@@ -162,7 +189,7 @@ func (s *RoomTypeRepositorySuite) TestDelete_NotFound() {
 
 // TestUpdate
 func (s *RoomTypeRepositorySuite) TestUpdate_Success() {
-	roomType, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil))
+	roomType, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil, nil))
 
 	newName := "large_lecture_hall"
 	updates := &models.UpdateRoomType{
@@ -175,6 +202,30 @@ func (s *RoomTypeRepositorySuite) TestUpdate_Success() {
 	s.Require().Equal(newName, actual.Name)
 }
 
+func (s *RoomTypeRepositorySuite) TestUpdate_Substitutes() {
+	_, err := s.repo.CreateBatch(s.ctx, []*models.RoomType{
+		models.NewRoomType("lecture_room", nil, nil, nil),
+		models.NewRoomType("lecture_hall", []string{"lecture_room"}, nil, nil),
+	})
+	s.Require().NoError(err)
+
+	substitutes := []string{"lecture_hall"}
+	actual, err := s.repo.Update(s.ctx, "lecture_room", &models.UpdateRoomType{Substitutes: &substitutes})
+
+	s.Require().NoError(err)
+	s.Require().Equal(substitutes, actual.Substitutes)
+
+	self := []string{"lecture_room"}
+	_, err = s.repo.Update(s.ctx, "lecture_room", &models.UpdateRoomType{Substitutes: &self})
+	s.Require().ErrorContains(err, "validation failed")
+
+	// Deleting a room type removes it from the substitutes of others
+	s.Require().NoError(s.repo.Delete(s.ctx, "lecture_hall"))
+	actual, err = s.repo.GetByName(s.ctx, "lecture_room")
+	s.Require().NoError(err)
+	s.Require().Empty(actual.Substitutes)
+}
+
 func (s *RoomTypeRepositorySuite) TestUpdate_NotFound() {
 	newName := "updated_type"
 	updates := &models.UpdateRoomType{
@@ -188,7 +239,7 @@ func (s *RoomTypeRepositorySuite) TestUpdate_NotFound() {
 }
 
 func (s *RoomTypeRepositorySuite) TestUpdate_ValidationError() {
-	roomType, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil))
+	roomType, _ := s.repo.Create(s.ctx, models.NewRoomType("lecture_hall", nil, nil, nil))
 
 	emptyName := " "
 	updates := &models.UpdateRoomType{
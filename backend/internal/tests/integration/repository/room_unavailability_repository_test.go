@@ -0,0 +1,207 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoomUnavailabilityRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.RoomUnavailabilityRepositoryInterface
+	roomRepo     repository.RoomRepositoryInterface
+	buildingRepo repository.BuildingRepositoryInterface
+	roomTypeRepo repository.RoomTypeRepositoryInterface
+	testRoom     *models.Room
+}
+
+func (s *RoomUnavailabilityRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewRoomUnavailabilityRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomRepo = repository.NewRoomRepository(s.testDB.DB, s.testDB.Logger)
+	s.buildingRepo = repository.NewBuildingRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomTypeRepo = repository.NewRoomTypeRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *RoomUnavailabilityRepositorySuite) SetupTest() {
+	// Create a fresh room before each test
+	building, err := s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Test Building", nil, nil))
+	s.Require().NoError(err)
+
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil, nil))
+	s.Require().NoError(err)
+
+	room, err := s.roomRepo.Create(s.ctx, models.NewRoom(uuid.New(), "Chapel", roomType.Name, building.ID, 80, nil, nil))
+	s.Require().NoError(err)
+	s.testRoom = room
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.room_unavailability")
+	s.testDB.Truncate("scheduler.rooms")
+	s.testDB.Truncate("scheduler.buildings")
+	s.testDB.Truncate("scheduler.room_types")
+}
+
+func (s *RoomUnavailabilityRepositorySuite) createTestUnavailability(day, start, end int32) *models.RoomUnavailability {
+	unavailability, err := s.repo.Create(s.ctx, models.NewRoomUnavailability(uuid.New(), s.testRoom.ID, day, start, end, nil, nil, nil))
+	s.Require().NoError(err)
+	return unavailability
+}
+
+// TestCreate
+func (s *RoomUnavailabilityRepositorySuite) TestCreate_Success() {
+	reason := "Friday service"
+	expected := models.NewRoomUnavailability(uuid.New(), s.testRoom.ID, 4, 780, 1020, &reason, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.RoomID, actual.RoomID)
+	s.Require().Equal(expected.Day, actual.Day)
+	s.Require().Equal(expected.StartTime, actual.StartTime)
+	s.Require().Equal(expected.EndTime, actual.EndTime)
+	s.Require().Equal(reason, *actual.Reason)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewRoomUnavailability(uuid.New(), s.testRoom.ID, 0, 600, 540, nil, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestCreate_UnknownRoom() {
+	_, err := s.repo.Create(s.ctx, models.NewRoomUnavailability(uuid.New(), uuid.New(), 0, 480, 540, nil, nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestGetByID
+func (s *RoomUnavailabilityRepositorySuite) TestGetByID_Success() {
+	unavailability := s.createTestUnavailability(0, 480, 600)
+
+	actual, err := s.repo.GetByID(s.ctx, s.testRoom.ID, unavailability.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(unavailability.ID, actual.ID)
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestGetByID_WrongRoom() {
+	unavailability := s.createTestUnavailability(0, 480, 600)
+
+	_, err := s.repo.GetByID(s.ctx, uuid.New(), unavailability.ID)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestGetByRoomID
+func (s *RoomUnavailabilityRepositorySuite) TestGetByRoomID_Success() {
+	s.createTestUnavailability(4, 780, 1020)
+	s.createTestUnavailability(0, 480, 600)
+
+	actual, err := s.repo.GetByRoomID(s.ctx, s.testRoom.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(int32(0), actual[0].Day) // Ordered by day
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestGetByRoomID_Empty() {
+	actual, err := s.repo.GetByRoomID(s.ctx, s.testRoom.ID)
+
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestList
+func (s *RoomUnavailabilityRepositorySuite) TestList_Success() {
+	s.createTestUnavailability(0, 480, 600)
+	s.createTestUnavailability(4, 780, 1020)
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+// TestDelete
+func (s *RoomUnavailabilityRepositorySuite) TestDelete_Success() {
+	unavailability := s.createTestUnavailability(0, 480, 600)
+
+	err := s.repo.Delete(s.ctx, s.testRoom.ID, unavailability.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, s.testRoom.ID, unavailability.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, s.testRoom.ID, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestDelete_CascadesFromRoom() {
+	s.createTestUnavailability(0, 480, 600)
+
+	s.Require().NoError(s.roomRepo.Delete(s.ctx, s.testRoom.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestUpdate
+func (s *RoomUnavailabilityRepositorySuite) TestUpdate_Success() {
+	unavailability := s.createTestUnavailability(0, 480, 600)
+
+	newEnd := int32(660)
+	actual, err := s.repo.Update(s.ctx, s.testRoom.ID, unavailability.ID, &models.RoomUnavailabilityUpdate{EndTime: &newEnd})
+
+	s.Require().NoError(err)
+	s.Require().Equal(newEnd, actual.EndTime)
+	s.Require().Equal(unavailability.StartTime, actual.StartTime) // Unchanged
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestUpdate_NotFound() {
+	newEnd := int32(660)
+	_, err := s.repo.Update(s.ctx, s.testRoom.ID, uuid.New(), &models.RoomUnavailabilityUpdate{EndTime: &newEnd})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *RoomUnavailabilityRepositorySuite) TestUpdate_InvertedRangeRejected() {
+	unavailability := s.createTestUnavailability(0, 480, 600)
+
+	// Valid on its own, but ends before the stored start time
+	newEnd := int32(420)
+	_, err := s.repo.Update(s.ctx, s.testRoom.ID, unavailability.ID, &models.RoomUnavailabilityUpdate{EndTime: &newEnd})
+
+	s.Require().Error(err)
+}
+
+// TestRoomUnavailabilityRepositorySuite
+func TestRoomUnavailabilityRepositorySuite(t *testing.T) {
+	suite.Run(t, new(RoomUnavailabilityRepositorySuite))
+}
@@ -0,0 +1,232 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionGroupRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.SessionGroupRepositoryInterface
+	courseRepo   repository.CourseRepositoryInterface
+	sessionRepo  repository.CourseSessionRepositoryInterface
+	roomTypeRepo repository.RoomTypeRepositoryInterface
+	testSessions []*models.CourseSession
+}
+
+func (s *SessionGroupRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewSessionGroupRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.sessionRepo = repository.NewCourseSessionRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomTypeRepo = repository.NewRoomTypeRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *SessionGroupRepositorySuite) SetupTest() {
+	// Create a fresh lecture for each of three cross-listed courses
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil, nil))
+	s.Require().NoError(err)
+
+	duration := int32(60)
+	numSessions := int32(2)
+	s.testSessions = nil
+	for _, name := range []string{"Databases", "Advanced Databases", "Database Systems Research"} {
+		course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), name, 0, 0, nil, nil))
+		s.Require().NoError(err)
+
+		session, err := s.sessionRepo.Create(s.ctx, models.NewCourseSession(
+			uuid.New(), course.ID, roomType.Name, "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil,
+		))
+		s.Require().NoError(err)
+		s.testSessions = append(s.testSessions, session)
+	}
+}
+
+func (s *SessionGroupRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *SessionGroupRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.session_group_members")
+	s.testDB.Truncate("scheduler.session_groups")
+	s.testDB.Truncate("scheduler.course_sessions")
+	s.testDB.Truncate("scheduler.courses")
+	s.testDB.Truncate("scheduler.room_types")
+}
+
+func (s *SessionGroupRepositorySuite) sessionIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(s.testSessions))
+	for i, session := range s.testSessions {
+		ids[i] = session.ID
+	}
+	return ids
+}
+
+// TestCreate
+func (s *SessionGroupRepositorySuite) TestCreate_Success() {
+	expected := models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs(), nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.Name, actual.Name)
+	s.Require().Equal(expected.CourseSessionIDs, actual.CourseSessionIDs)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *SessionGroupRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs()[:1], nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *SessionGroupRepositorySuite) TestCreate_UnknownSession() {
+	group := models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", []uuid.UUID{s.testSessions[0].ID, uuid.New()}, nil, nil)
+
+	_, err := s.repo.Create(s.ctx, group)
+	s.Require().Error(err)
+
+	// The group row must be rolled back with its members
+	_, getErr := s.repo.GetByID(s.ctx, group.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *SessionGroupRepositorySuite) TestCreate_SessionAlreadyGrouped() {
+	_, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs()[:2], nil, nil))
+	s.Require().NoError(err)
+
+	_, err = s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Database Research (cross-listed)", s.sessionIDs()[1:], nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestCreateBatch
+func (s *SessionGroupRepositorySuite) TestCreateBatch_Success() {
+	otherSession, err := s.sessionRepo.Create(s.ctx, models.NewCourseSession(
+		uuid.New(), s.testSessions[0].CourseID, "lecture_room", "tutorial", s.testSessions[0].Duration, s.testSessions[0].NumberOfSessions, nil, nil, nil, nil, nil, nil,
+	))
+	s.Require().NoError(err)
+
+	expected := []*models.SessionGroup{
+		models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs()[:2], nil, nil),
+		models.NewSessionGroup(uuid.New(), "Database Tutorials (combined)", []uuid.UUID{otherSession.ID, s.testSessions[2].ID}, nil, nil),
+	}
+
+	actual, err := s.repo.CreateBatch(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+// TestGetByID
+func (s *SessionGroupRepositorySuite) TestGetByID_Success() {
+	group, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.GetByID(s.ctx, group.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(group.ID, actual.ID)
+	s.Require().Equal(s.sessionIDs(), actual.CourseSessionIDs) // Members keep their order
+}
+
+func (s *SessionGroupRepositorySuite) TestGetByID_NotFoundError() {
+	_, err := s.repo.GetByID(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestList
+func (s *SessionGroupRepositorySuite) TestList_Success() {
+	_, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 1)
+	s.Require().Equal(s.sessionIDs(), actual[0].CourseSessionIDs)
+}
+
+// TestDelete
+func (s *SessionGroupRepositorySuite) TestDelete_Success() {
+	group, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	err = s.repo.Delete(s.ctx, group.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, group.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *SessionGroupRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestUpdate
+func (s *SessionGroupRepositorySuite) TestUpdate_Name() {
+	group, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	newName := "COMP 3161 / COMP 6161"
+	actual, err := s.repo.Update(s.ctx, group.ID, &models.SessionGroupUpdate{Name: &newName})
+
+	s.Require().NoError(err)
+	s.Require().Equal(newName, actual.Name)
+	s.Require().Equal(s.sessionIDs(), actual.CourseSessionIDs) // Unchanged
+}
+
+func (s *SessionGroupRepositorySuite) TestUpdate_ReplacesMembers() {
+	group, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	sessionIDs := []uuid.UUID{s.testSessions[2].ID, s.testSessions[0].ID}
+	actual, err := s.repo.Update(s.ctx, group.ID, &models.SessionGroupUpdate{CourseSessionIDs: &sessionIDs})
+
+	s.Require().NoError(err)
+	s.Require().Equal(group.Name, actual.Name)
+	s.Require().Equal(sessionIDs, actual.CourseSessionIDs)
+}
+
+func (s *SessionGroupRepositorySuite) TestUpdate_NotFound() {
+	sessionIDs := s.sessionIDs()
+	_, err := s.repo.Update(s.ctx, uuid.New(), &models.SessionGroupUpdate{CourseSessionIDs: &sessionIDs})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *SessionGroupRepositorySuite) TestUpdate_ValidationError() {
+	group, err := s.repo.Create(s.ctx, models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", s.sessionIDs(), nil, nil))
+	s.Require().NoError(err)
+
+	emptyName := " "
+	_, err = s.repo.Update(s.ctx, group.ID, &models.SessionGroupUpdate{Name: &emptyName})
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+}
+
+// TestSessionGroupRepositorySuite
+func TestSessionGroupRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SessionGroupRepositorySuite))
+}
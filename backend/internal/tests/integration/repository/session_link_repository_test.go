@@ -0,0 +1,211 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionLinkRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.SessionLinkRepositoryInterface
+	courseRepo   repository.CourseRepositoryInterface
+	sessionRepo  repository.CourseSessionRepositoryInterface
+	roomTypeRepo repository.RoomTypeRepositoryInterface
+	lecture      *models.CourseSession
+	lab          *models.CourseSession
+}
+
+func (s *SessionLinkRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewSessionLinkRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.sessionRepo = repository.NewCourseSessionRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomTypeRepo = repository.NewRoomTypeRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *SessionLinkRepositorySuite) SetupTest() {
+	// Create a fresh lecture and lab to link
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil, nil))
+	s.Require().NoError(err)
+
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Chemistry", 0, 0, nil, nil))
+	s.Require().NoError(err)
+
+	duration := int32(60)
+	numSessions := int32(1)
+	s.lecture, err = s.sessionRepo.Create(s.ctx, models.NewCourseSession(
+		uuid.New(), course.ID, roomType.Name, "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil,
+	))
+	s.Require().NoError(err)
+
+	s.lab, err = s.sessionRepo.Create(s.ctx, models.NewCourseSession(
+		uuid.New(), course.ID, roomType.Name, "lab", &duration, &numSessions, nil, nil, nil, nil, nil, nil,
+	))
+	s.Require().NoError(err)
+}
+
+func (s *SessionLinkRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *SessionLinkRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.session_links")
+	s.testDB.Truncate("scheduler.course_sessions")
+	s.testDB.Truncate("scheduler.courses")
+	s.testDB.Truncate("scheduler.room_types")
+}
+
+func (s *SessionLinkRepositorySuite) createTestLink(kind string) *models.SessionLink {
+	link, err := s.repo.Create(s.ctx, models.NewSessionLink(uuid.New(), s.lecture.ID, s.lab.ID, kind, nil, nil))
+	s.Require().NoError(err)
+	return link
+}
+
+// TestCreate
+func (s *SessionLinkRepositorySuite) TestCreate_Success() {
+	expected := models.NewSessionLink(uuid.New(), s.lecture.ID, s.lab.ID, models.SessionLinkBefore, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.CourseSessionID, actual.CourseSessionID)
+	s.Require().Equal(expected.OtherSessionID, actual.OtherSessionID)
+	s.Require().Equal(expected.Kind, actual.Kind)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *SessionLinkRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewSessionLink(uuid.New(), s.lecture.ID, s.lecture.ID, models.SessionLinkBefore, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *SessionLinkRepositorySuite) TestCreate_UnknownSession() {
+	_, err := s.repo.Create(s.ctx, models.NewSessionLink(uuid.New(), s.lecture.ID, uuid.New(), models.SessionLinkBefore, nil, nil))
+
+	s.Require().Error(err)
+}
+
+func (s *SessionLinkRepositorySuite) TestCreate_Duplicate() {
+	s.createTestLink(models.SessionLinkSameDay)
+
+	_, err := s.repo.Create(s.ctx, models.NewSessionLink(uuid.New(), s.lecture.ID, s.lab.ID, models.SessionLinkSameDay, nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestGetByID
+func (s *SessionLinkRepositorySuite) TestGetByID_Success() {
+	link := s.createTestLink(models.SessionLinkBefore)
+
+	actual, err := s.repo.GetByID(s.ctx, s.lecture.ID, link.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(link.ID, actual.ID)
+}
+
+func (s *SessionLinkRepositorySuite) TestGetByID_WrongSession() {
+	link := s.createTestLink(models.SessionLinkBefore)
+
+	_, err := s.repo.GetByID(s.ctx, s.lab.ID, link.ID)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestGetByCourseSessionID
+func (s *SessionLinkRepositorySuite) TestGetByCourseSessionID_Success() {
+	s.createTestLink(models.SessionLinkSameRoom)
+	s.createTestLink(models.SessionLinkBefore)
+
+	actual, err := s.repo.GetByCourseSessionID(s.ctx, s.lecture.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(models.SessionLinkBefore, actual[0].Kind) // Ordered by kind
+}
+
+func (s *SessionLinkRepositorySuite) TestGetByCourseSessionID_Empty() {
+	actual, err := s.repo.GetByCourseSessionID(s.ctx, s.lab.ID)
+
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestList
+func (s *SessionLinkRepositorySuite) TestList_Success() {
+	s.createTestLink(models.SessionLinkBefore)
+	s.createTestLink(models.SessionLinkSameRoom)
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+// TestDelete
+func (s *SessionLinkRepositorySuite) TestDelete_Success() {
+	link := s.createTestLink(models.SessionLinkBefore)
+
+	err := s.repo.Delete(s.ctx, s.lecture.ID, link.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, s.lecture.ID, link.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *SessionLinkRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, s.lecture.ID, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *SessionLinkRepositorySuite) TestDelete_CascadesFromOtherSession() {
+	s.createTestLink(models.SessionLinkBefore)
+
+	s.Require().NoError(s.sessionRepo.Delete(s.ctx, s.lab.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestUpdate
+func (s *SessionLinkRepositorySuite) TestUpdate_Success() {
+	link := s.createTestLink(models.SessionLinkBefore)
+
+	newKind := models.SessionLinkConsecutive
+	actual, err := s.repo.Update(s.ctx, s.lecture.ID, link.ID, &models.SessionLinkUpdate{Kind: &newKind})
+
+	s.Require().NoError(err)
+	s.Require().Equal(newKind, actual.Kind)
+	s.Require().Equal(link.OtherSessionID, actual.OtherSessionID) // Unchanged
+}
+
+func (s *SessionLinkRepositorySuite) TestUpdate_NotFound() {
+	newKind := models.SessionLinkConsecutive
+	_, err := s.repo.Update(s.ctx, s.lecture.ID, uuid.New(), &models.SessionLinkUpdate{Kind: &newKind})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestSessionLinkRepositorySuite
+func TestSessionLinkRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SessionLinkRepositorySuite))
+}
@@ -0,0 +1,213 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionPinRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.SessionPinRepositoryInterface
+	courseRepo   repository.CourseRepositoryInterface
+	sessionRepo  repository.CourseSessionRepositoryInterface
+	roomRepo     repository.RoomRepositoryInterface
+	buildingRepo repository.BuildingRepositoryInterface
+	roomTypeRepo repository.RoomTypeRepositoryInterface
+	testSession  *models.CourseSession
+	testRoom     *models.Room
+}
+
+func (s *SessionPinRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewSessionPinRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.sessionRepo = repository.NewCourseSessionRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomRepo = repository.NewRoomRepository(s.testDB.DB, s.testDB.Logger)
+	s.buildingRepo = repository.NewBuildingRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomTypeRepo = repository.NewRoomTypeRepository(s.testDB.DB, s.testDB.Logger)
+}
+
+func (s *SessionPinRepositorySuite) SetupTest() {
+	// Create a fresh course session and room to pin it to
+	building, err := s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Test Building", nil, nil))
+	s.Require().NoError(err)
+
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil, nil))
+	s.Require().NoError(err)
+
+	room, err := s.roomRepo.Create(s.ctx, models.NewRoom(uuid.New(), "Senate Room", roomType.Name, building.ID, 40, nil, nil))
+	s.Require().NoError(err)
+	s.testRoom = room
+
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Dean's Seminar", 0, 0, nil, nil))
+	s.Require().NoError(err)
+
+	duration := int32(60)
+	numSessions := int32(2)
+	session, err := s.sessionRepo.Create(s.ctx, models.NewCourseSession(
+		uuid.New(), course.ID, roomType.Name, "lecture", &duration, &numSessions, nil, nil, nil, nil, nil, nil,
+	))
+	s.Require().NoError(err)
+	s.testSession = session
+}
+
+func (s *SessionPinRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *SessionPinRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.session_pins")
+	s.testDB.Truncate("scheduler.course_sessions")
+	s.testDB.Truncate("scheduler.courses")
+	s.testDB.Truncate("scheduler.rooms")
+	s.testDB.Truncate("scheduler.buildings")
+	s.testDB.Truncate("scheduler.room_types")
+}
+
+func (s *SessionPinRepositorySuite) createTestPin(day, start int32) *models.SessionPin {
+	pin, err := s.repo.Create(s.ctx, models.NewSessionPin(uuid.New(), s.testSession.ID, s.testRoom.ID, day, start, nil, nil))
+	s.Require().NoError(err)
+	return pin
+}
+
+// TestCreate
+func (s *SessionPinRepositorySuite) TestCreate_Success() {
+	expected := models.NewSessionPin(uuid.New(), s.testSession.ID, s.testRoom.ID, 0, 540, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.CourseSessionID, actual.CourseSessionID)
+	s.Require().Equal(expected.RoomID, actual.RoomID)
+	s.Require().Equal(expected.Day, actual.Day)
+	s.Require().Equal(expected.StartTime, actual.StartTime)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *SessionPinRepositorySuite) TestCreate_ValidationError() {
+	actual, err := s.repo.Create(s.ctx, models.NewSessionPin(uuid.New(), s.testSession.ID, s.testRoom.ID, 7, 540, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
+func (s *SessionPinRepositorySuite) TestCreate_UnknownRoom() {
+	_, err := s.repo.Create(s.ctx, models.NewSessionPin(uuid.New(), s.testSession.ID, uuid.New(), 0, 540, nil, nil))
+
+	s.Require().Error(err)
+}
+
+// TestGetByID
+func (s *SessionPinRepositorySuite) TestGetByID_Success() {
+	pin := s.createTestPin(0, 540)
+
+	actual, err := s.repo.GetByID(s.ctx, s.testSession.ID, pin.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(pin.ID, actual.ID)
+}
+
+func (s *SessionPinRepositorySuite) TestGetByID_WrongSession() {
+	pin := s.createTestPin(0, 540)
+
+	_, err := s.repo.GetByID(s.ctx, uuid.New(), pin.ID)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestGetByCourseSessionID
+func (s *SessionPinRepositorySuite) TestGetByCourseSessionID_Success() {
+	s.createTestPin(3, 540)
+	s.createTestPin(0, 540)
+
+	actual, err := s.repo.GetByCourseSessionID(s.ctx, s.testSession.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(int32(0), actual[0].Day) // Ordered by day
+}
+
+func (s *SessionPinRepositorySuite) TestGetByCourseSessionID_Empty() {
+	actual, err := s.repo.GetByCourseSessionID(s.ctx, s.testSession.ID)
+
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestList
+func (s *SessionPinRepositorySuite) TestList_Success() {
+	s.createTestPin(0, 540)
+	s.createTestPin(3, 540)
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+}
+
+// TestDelete
+func (s *SessionPinRepositorySuite) TestDelete_Success() {
+	pin := s.createTestPin(0, 540)
+
+	err := s.repo.Delete(s.ctx, s.testSession.ID, pin.ID)
+
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, s.testSession.ID, pin.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *SessionPinRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, s.testSession.ID, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *SessionPinRepositorySuite) TestDelete_CascadesFromCourseSession() {
+	s.createTestPin(0, 540)
+
+	s.Require().NoError(s.sessionRepo.Delete(s.ctx, s.testSession.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(actual)
+}
+
+// TestUpdate
+func (s *SessionPinRepositorySuite) TestUpdate_Success() {
+	pin := s.createTestPin(0, 540)
+
+	newStart := int32(600)
+	actual, err := s.repo.Update(s.ctx, s.testSession.ID, pin.ID, &models.SessionPinUpdate{StartTime: &newStart})
+
+	s.Require().NoError(err)
+	s.Require().Equal(newStart, actual.StartTime)
+	s.Require().Equal(pin.Day, actual.Day) // Unchanged
+}
+
+func (s *SessionPinRepositorySuite) TestUpdate_NotFound() {
+	newStart := int32(600)
+	_, err := s.repo.Update(s.ctx, s.testSession.ID, uuid.New(), &models.SessionPinUpdate{StartTime: &newStart})
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestSessionPinRepositorySuite
+func TestSessionPinRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SessionPinRepositorySuite))
+}
@@ -0,0 +1,260 @@
+package annealing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/annealing"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(name, roomType string) *models.Room {
+	return models.NewRoom(uuid.New(), name, roomType, uuid.New(), 30, nil, nil)
+}
+
+func makeCourse(name string) *models.Course {
+	return models.NewCourse(uuid.New(), name, 0, 0, nil, nil)
+}
+
+func makeSession(courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(uuid.New(), courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil, nil, nil, nil, nil)
+}
+
+func newScheduler(config *annealing.Config) scheduler.Scheduler {
+	return annealing.NewAnnealingScheduler(greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}), config)
+}
+
+// greedyTrap is an input the greedy scheduler gets wrong: it puts the heavier course first thing
+// in the morning, which is the only time the lighter course's instructor is available
+func greedyTrap() *scheduler.Input {
+	room := makeRoom("Room 101", "lecture")
+	heavy := makeCourse("Statistics")
+	light := makeCourse("Ethics")
+	lightSession := makeSession(light.ID, "lecture", 50, 1)
+	instructorID := uuid.New()
+
+	return &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{heavy, light},
+		CourseSessions: []*models.CourseSession{makeSession(heavy.ID, "lecture", 60, 1), lightSession},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(lightSession.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, int32(scheduler.Monday), 540, 600, nil, nil),
+		},
+	}
+}
+
+// TestAnnealing_FixesGreedyFailure tests that the search revisits an early placement to fit a failed session
+func TestAnnealing_FixesGreedyFailure(t *testing.T) {
+	input := greedyTrap()
+
+	greedyOutput, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	require.Len(t, greedyOutput.Failures, 1, "Greedy should fail on this input")
+
+	output, err := newScheduler(&annealing.Config{InitialTemperature: 20, CoolingRate: 0.999, MaxIterations: 2000, Seed: 1}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 2)
+	a, b := output.ScheduledSessions[0], output.ScheduledSessions[1]
+	assert.True(t, a.EndTime <= b.StartTime || b.EndTime <= a.StartTime, "Sessions in the same room must not overlap")
+}
+
+// TestAnnealing_SameSeedSameResult tests that runs are reproducible
+func TestAnnealing_SameSeedSameResult(t *testing.T) {
+	room1 := makeRoom("Room 101", "lecture")
+	room2 := makeRoom("Room 102", "lecture")
+	courses := []*models.Course{makeCourse("A"), makeCourse("B"), makeCourse("C"), makeCourse("D")}
+	sessions := make([]*models.CourseSession, len(courses))
+	for i, course := range courses {
+		sessions[i] = makeSession(course.ID, "lecture", 60, 2)
+	}
+
+	input := &scheduler.Input{
+		Rooms:          []*models.Room{room1, room2},
+		Courses:        courses,
+		CourseSessions: sessions,
+	}
+	config := &annealing.Config{InitialTemperature: 20, CoolingRate: 0.999, MaxIterations: 3000, Seed: 42}
+
+	first, err := newScheduler(config).Generate(context.Background(), input)
+	require.NoError(t, err)
+	second, err := newScheduler(config).Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ScheduledSessions, second.ScheduledSessions)
+}
+
+// TestAnnealing_ZeroIterationsKeepsInitial tests that without a budget the starting timetable is returned unchanged
+func TestAnnealing_ZeroIterationsKeepsInitial(t *testing.T) {
+	input := greedyTrap()
+
+	greedyOutput, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	output, err := newScheduler(&annealing.Config{Seed: 1}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, greedyOutput.ScheduledSessions, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorConflict, output.Failures[0].Reason)
+}
+
+// TestAnnealing_RespectsInstructors tests that improved timetables never double-book an instructor
+func TestAnnealing_RespectsInstructors(t *testing.T) {
+	roomA := makeRoom("Room A", "lecture")
+	roomB := makeRoom("Room B", "lecture")
+	instructorID := uuid.New()
+
+	var courses []*models.Course
+	var sessions []*models.CourseSession
+	var assignments []*models.InstructorAssignment
+	for _, name := range []string{"Algorithms", "Compilers", "Databases"} {
+		course := makeCourse(name)
+		session := makeSession(course.ID, "lecture", 90, 2)
+		courses = append(courses, course)
+		sessions = append(sessions, session)
+		assignments = append(assignments, models.NewInstructorAssignment(session.ID, instructorID, nil))
+	}
+
+	output, err := newScheduler(&annealing.Config{InitialTemperature: 50, CoolingRate: 0.999, MaxIterations: 5000, Seed: 7}).Generate(context.Background(), &scheduler.Input{
+		Rooms:                 []*models.Room{roomA, roomB},
+		Courses:               courses,
+		CourseSessions:        sessions,
+		InstructorAssignments: assignments,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 6)
+	for i, a := range output.ScheduledSessions {
+		for _, b := range output.ScheduledSessions[i+1:] {
+			overlap := a.Day == b.Day && a.StartTime < b.EndTime && b.StartTime < a.EndTime
+			assert.False(t, overlap, "Sessions taught by the same instructor must not overlap")
+		}
+	}
+}
+
+// TestAnnealing_TimeLimit tests that the search stops once its time budget is spent
+func TestAnnealing_TimeLimit(t *testing.T) {
+	config := &annealing.Config{InitialTemperature: 20, CoolingRate: 0.9999, MaxIterations: 1 << 30, TimeLimit: 50 * time.Millisecond, Seed: 1}
+
+	output, err := newScheduler(config).Generate(context.Background(), greedyTrap())
+
+	require.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+// TestAnnealing_ContextDeadline tests that the search stops at the context's deadline and reports it,
+// keeping the best timetable found
+func TestAnnealing_ContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	config := &annealing.Config{InitialTemperature: 20, CoolingRate: 0.9999, MaxIterations: 1 << 30, Seed: 1}
+
+	output, err := newScheduler(config).Generate(ctx, greedyTrap())
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusTimedOut, output.Status)
+	assert.NotEmpty(t, output.ScheduledSessions)
+}
+
+// TestAnnealing_ReportsProgress tests that the search reports when it places more than the initial timetable
+func TestAnnealing_ReportsProgress(t *testing.T) {
+	input := greedyTrap()
+	var reports []scheduler.Progress
+	input.Progress = func(p scheduler.Progress) {
+		reports = append(reports, p)
+	}
+
+	output, err := newScheduler(&annealing.Config{InitialTemperature: 20, CoolingRate: 0.999, MaxIterations: 2000, Seed: 1}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Equal(t, []scheduler.Progress{
+		{Placed: 0, Total: 2},
+		{Placed: 1, Total: 2}, // the greedy pass
+		{Placed: 2, Total: 2}, // the search
+	}, reports)
+}
+
+// TestAnnealing_KeepsLinks tests that the search never trades a link for a preference: the lab
+// prefers the slot its linked lecture has to take
+func TestAnnealing_KeepsLinks(t *testing.T) {
+	room := makeRoom("Room 101", "lecture")
+	chemistry := makeCourse("Chemistry")
+	chemistryLab := makeCourse("Chemistry Lab")
+	lecture := makeSession(chemistry.ID, "lecture", 60, 1)
+	lab := makeSession(chemistryLab.ID, "lecture", 60, 1)
+	lab.PreferredWindows = []models.SessionWindow{{StartTime: 480, EndTime: 540}}
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{chemistry, chemistryLab},
+		CourseSessions: []*models.CourseSession{lecture, lab},
+		Links: []*models.SessionLink{
+			models.NewSessionLink(uuid.New(), lecture.ID, lab.ID, models.SessionLinkBefore, nil, nil),
+		},
+	}
+
+	for seed := range int64(20) {
+		output, err := newScheduler(&annealing.Config{InitialTemperature: 20, CoolingRate: 0.999, MaxIterations: 2000, Seed: seed}).Generate(context.Background(), input)
+
+		require.NoError(t, err)
+		assert.Empty(t, output.Failures, "seed %d", seed)
+		require.Len(t, output.ScheduledSessions, 2)
+		for _, ss := range output.ScheduledSessions {
+			if ss.CourseSessionID == lab.ID {
+				assert.Equal(t, 540, ss.StartTime, "seed %d: the lab follows the lecture", seed)
+			}
+		}
+	}
+}
+
+// TestAnnealing_KeepsSpreadRules tests that the search never stacks a course on one day against
+// its per-day cap, even for a session that would rather meet on Monday every time
+func TestAnnealing_KeepsSpreadRules(t *testing.T) {
+	course := makeCourse("Algorithms")
+	session := makeSession(course.ID, "lecture", 60, 2)
+	session.PreferredWindows = []models.SessionWindow{{Day: ptr(int32(scheduler.Monday)), StartTime: 480, EndTime: 1200}}
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:    scheduler.TimeRange{Start: 480, End: 1200},
+			OperatingDays:     []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			MaxSessionsPerDay: 1,
+		},
+		Rooms:          []*models.Room{makeRoom("Room 101", "lecture")},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+	}
+
+	for seed := range int64(20) {
+		output, err := newScheduler(&annealing.Config{InitialTemperature: 20, CoolingRate: 0.999, MaxIterations: 2000, Seed: seed}).Generate(context.Background(), input)
+
+		require.NoError(t, err)
+		assert.Empty(t, output.Failures, "seed %d", seed)
+		require.Len(t, output.ScheduledSessions, 2)
+		assert.NotEqual(t, output.ScheduledSessions[0].Day, output.ScheduledSessions[1].Day, "seed %d: one meeting a day", seed)
+	}
+}
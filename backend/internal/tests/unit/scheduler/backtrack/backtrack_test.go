@@ -0,0 +1,379 @@
+package backtrack_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/backtrack"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(name, roomType string) *models.Room {
+	return models.NewRoom(uuid.New(), name, roomType, uuid.New(), 30, nil, nil)
+}
+
+func makeCourse(name string) *models.Course {
+	return models.NewCourse(uuid.New(), name, 0, 0, nil, nil)
+}
+
+func makeSession(courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(uuid.New(), courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil, nil, nil, nil, nil)
+}
+
+// singleRoomInput schedules one-hour courses into a single room open for the given number of hours
+func singleRoomInput(courses, hours int) *scheduler.Input {
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:        scheduler.TimeRange{Start: 480, End: 480 + hours*60},
+			OperatingDays:         []scheduler.Day{scheduler.Monday},
+			PreferredSlotDuration: 60,
+		},
+		Rooms: []*models.Room{makeRoom("Room 101", "lecture")},
+	}
+
+	for range courses {
+		course := makeCourse("Course")
+		input.Courses = append(input.Courses, course)
+		input.CourseSessions = append(input.CourseSessions, makeSession(course.ID, "lecture", 60, 1))
+	}
+
+	return input
+}
+
+// TestBacktrack_PlacesEverySession tests that a solvable problem is solved completely and marked optimal
+func TestBacktrack_PlacesEverySession(t *testing.T) {
+	roomA := makeRoom("Room A", "lecture")
+	lab := makeRoom("Lab", "lab")
+	instructorID := uuid.New()
+
+	algorithms := makeCourse("Algorithms")
+	lecture := makeSession(algorithms.ID, "lecture", 90, 2)
+	practical := makeSession(algorithms.ID, "lab", 120, 1)
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{roomA, lab},
+		Courses:        []*models.Course{algorithms},
+		CourseSessions: []*models.CourseSession{lecture, practical},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(lecture.ID, instructorID, nil),
+			models.NewInstructorAssignment(practical.ID, instructorID, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusOptimal, output.Status)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 3)
+
+	for i, a := range output.ScheduledSessions {
+		for _, b := range output.ScheduledSessions[i+1:] {
+			overlap := a.Day == b.Day && a.StartTime < b.EndTime && b.StartTime < a.EndTime
+			assert.False(t, overlap, "Sessions taught by the same instructor must not overlap")
+		}
+	}
+}
+
+// TestBacktrack_WindowWeights tests that the search favours a session's heavier preferred window
+func TestBacktrack_WindowWeights(t *testing.T) {
+	input := singleRoomInput(1, 6)
+	input.CourseSessions[0].PreferredWindows = []models.SessionWindow{
+		{StartTime: 480, EndTime: 600, Weight: ptr(int32(1))},
+		{StartTime: 660, EndTime: 780, Weight: ptr(int32(2))},
+	}
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.GreaterOrEqual(t, output.ScheduledSessions[0].StartTime, 660)
+	assert.Zero(t, output.PreferenceViolations)
+}
+
+// TestBacktrack_SolvesWhatGreedyMisses tests that the exact search finds the placement first-fit misses
+func TestBacktrack_SolvesWhatGreedyMisses(t *testing.T) {
+	room := makeRoom("Room 101", "lecture")
+	heavy := makeCourse("Statistics")
+	light := makeCourse("Ethics")
+	lightSession := makeSession(light.ID, "lecture", 50, 1)
+	instructorID := uuid.New()
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{heavy, light},
+		CourseSessions: []*models.CourseSession{makeSession(heavy.ID, "lecture", 60, 1), lightSession},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(lightSession.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, int32(scheduler.Monday), 540, 600, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusOptimal, output.Status)
+	assert.Len(t, output.ScheduledSessions, 2)
+}
+
+// TestBacktrack_DayHours tests that meetings are only placed within each day's own hours, outside blocked
+// windows, and that the lost hours make the problem infeasible
+func TestBacktrack_DayHours(t *testing.T) {
+	input := singleRoomInput(3, 4)
+	input.Config.DayHours = map[scheduler.Day][]scheduler.TimeRange{
+		scheduler.Saturday: {{Start: 540, End: 600}},
+	}
+	input.Config.BlockedWindows = []scheduler.TimeRange{{Start: 540, End: 660}}
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusInfeasible, output.Status)
+	assert.Len(t, output.Failures, 1)
+	require.Len(t, output.ScheduledSessions, 2, "Monday keeps 8:00-9:00 and 11:00-12:00; Saturday is fully blocked")
+
+	starts := []int{output.ScheduledSessions[0].StartTime, output.ScheduledSessions[1].StartTime}
+	assert.ElementsMatch(t, []int{480, 660}, starts)
+}
+
+// TestBacktrack_ProvesInfeasible tests that an over-full room is proven infeasible with the best partial timetable
+func TestBacktrack_ProvesInfeasible(t *testing.T) {
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), singleRoomInput(3, 2))
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusInfeasible, output.Status)
+	assert.Len(t, output.ScheduledSessions, 2)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonNoTimeSlot, output.Failures[0].Reason)
+}
+
+// TestBacktrack_NoSuitableRoom tests that a session with no room of its type is infeasible without blocking the rest
+func TestBacktrack_NoSuitableRoom(t *testing.T) {
+	input := singleRoomInput(2, 2)
+	course := makeCourse("Chemistry")
+	input.Courses = append(input.Courses, course)
+	input.CourseSessions = append(input.CourseSessions, makeSession(course.ID, "lab", 60, 1))
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusInfeasible, output.Status)
+	assert.Len(t, output.ScheduledSessions, 2)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, course.ID, output.Failures[0].CourseSession.CourseID)
+}
+
+// TestBacktrack_Substitutes tests that a session takes a substitute room type when its own is full,
+// and that the placement is flagged
+func TestBacktrack_Substitutes(t *testing.T) {
+	input := singleRoomInput(2, 1)
+	hall := makeRoom("Great Hall", "lecture_hall")
+	input.Rooms = append(input.Rooms, hall)
+	input.RoomTypes = []*models.RoomType{models.NewRoomType("lecture", []string{"lecture_hall"}, nil, nil)}
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 2)
+
+	substituted := 0
+	for _, ss := range output.ScheduledSessions {
+		assert.Equal(t, ss.RoomID == hall.ID, ss.Substituted)
+		if ss.Substituted {
+			substituted++
+		}
+	}
+	assert.Equal(t, 1, substituted, "Only one session needs the hall")
+}
+
+// TestBacktrack_TimesOut tests that a search too large for its time limit reports a timeout and a partial timetable
+func TestBacktrack_TimesOut(t *testing.T) {
+	// Twelve courses into eleven slots takes billions of steps to prove infeasible
+	output, err := backtrack.NewBacktrackScheduler(&backtrack.Config{TimeLimit: 20 * time.Millisecond}).Generate(context.Background(), singleRoomInput(12, 11))
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusTimedOut, output.Status)
+	assert.Len(t, output.ScheduledSessions, 11)
+}
+
+// TestBacktrack_Canceled tests that a canceled search stops with the best partial timetable
+func TestBacktrack_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(ctx, singleRoomInput(12, 11))
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusCanceled, output.Status)
+	assert.Len(t, output.ScheduledSessions, 11, "The first dive fills the room before the context is checked")
+}
+
+// TestBacktrack_ContextDeadline tests that a deadline on the context shortens the configured time limit
+func TestBacktrack_ContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	output, err := backtrack.NewBacktrackScheduler(&backtrack.Config{TimeLimit: time.Minute}).Generate(ctx, singleRoomInput(12, 11))
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusTimedOut, output.Status)
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
+
+// TestBacktrack_ReportsProgress tests that every improvement on the best partial timetable is reported
+func TestBacktrack_ReportsProgress(t *testing.T) {
+	input := singleRoomInput(3, 3)
+	var reports []scheduler.Progress
+	input.Progress = func(p scheduler.Progress) {
+		reports = append(reports, p)
+	}
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusOptimal, output.Status)
+	assert.Equal(t, []scheduler.Progress{
+		{Placed: 0, Total: 3},
+		{Placed: 1, Total: 3},
+		{Placed: 2, Total: 3},
+		{Placed: 3, Total: 3},
+	}, reports)
+}
+
+// TestBacktrack_Links tests that links are searched like any other constraint: a lab following its
+// lecture is placed after it, and one kept off the lecture's only day is proven infeasible
+func TestBacktrack_Links(t *testing.T) {
+	for _, tc := range []struct {
+		kind   string
+		status scheduler.Status
+		placed int
+	}{
+		{kind: models.SessionLinkBefore, status: scheduler.StatusOptimal, placed: 2},
+		{kind: models.SessionLinkDifferentDay, status: scheduler.StatusInfeasible, placed: 1},
+	} {
+		t.Run(tc.kind, func(t *testing.T) {
+			input := singleRoomInput(2, 2)
+			lecture, lab := input.CourseSessions[0], input.CourseSessions[1]
+			lab.PreferredWindows = []models.SessionWindow{{StartTime: 480, EndTime: 540}}
+			input.Links = []*models.SessionLink{
+				models.NewSessionLink(uuid.New(), lecture.ID, lab.ID, tc.kind, nil, nil),
+			}
+
+			output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.status, output.Status)
+			require.Len(t, output.ScheduledSessions, tc.placed)
+			for _, ss := range output.ScheduledSessions {
+				if ss.CourseSessionID == lab.ID {
+					assert.Equal(t, 540, ss.StartTime, "The lab follows the lecture")
+				}
+			}
+		})
+	}
+}
+
+// TestBacktrack_Spread tests that the course's spreading rules are searched like any other
+// constraint, even against a session that would rather meet on Monday every time
+func TestBacktrack_Spread(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config *scheduler.Config
+		status scheduler.Status
+		days   []int
+	}{
+		{
+			name:   "max per day",
+			config: &scheduler.Config{OperatingDays: []scheduler.Day{scheduler.Monday}, MaxSessionsPerDay: 1},
+			status: scheduler.StatusInfeasible,
+			days:   []int{0},
+		},
+		{
+			name:   "min days between",
+			config: &scheduler.Config{OperatingDays: []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday}, MinDaysBetweenSessions: 2},
+			status: scheduler.StatusOptimal,
+			days:   []int{0, 2},
+		},
+		{
+			name: "day patterns",
+			config: &scheduler.Config{
+				OperatingDays: []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday, scheduler.Thursday, scheduler.Friday},
+				DayPatterns:   [][]scheduler.Day{{scheduler.Tuesday, scheduler.Thursday}},
+			},
+			status: scheduler.StatusOptimal,
+			days:   []int{1, 3},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			course := makeCourse("Algorithms")
+			session := makeSession(course.ID, "lecture", 60, 2)
+			if tc.config.DayPatterns == nil {
+				session.PreferredWindows = []models.SessionWindow{{Day: ptr(int32(scheduler.Monday)), StartTime: 480, EndTime: 1200}}
+			}
+			tc.config.OperatingHours = scheduler.TimeRange{Start: 480, End: 1200}
+			tc.config.PreferredSlotDuration = 60
+
+			output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), &scheduler.Input{
+				Config:         tc.config,
+				Rooms:          []*models.Room{makeRoom("Room 101", "lecture")},
+				Courses:        []*models.Course{course},
+				CourseSessions: []*models.CourseSession{session},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.status, output.Status)
+
+			var days []int
+			for _, ss := range output.ScheduledSessions {
+				days = append(days, ss.Day)
+			}
+			assert.ElementsMatch(t, tc.days, days)
+			if tc.status == scheduler.StatusInfeasible {
+				require.Len(t, output.Failures, 1)
+				assert.Equal(t, scheduler.ReasonSpreadRule, output.Failures[0].Reason)
+			}
+		})
+	}
+}
+
+// TestBacktrack_GroupSpread tests that a combined session group's meetings count against the
+// spreading rules of every course it serves
+func TestBacktrack_GroupSpread(t *testing.T) {
+	undergrad, grad := makeCourse("Databases"), makeCourse("Advanced Databases")
+	undergradLecture := makeSession(undergrad.ID, "lecture", 60, 1)
+	gradLecture := makeSession(grad.ID, "lecture", 60, 1)
+	undergradTutorial := makeSession(undergrad.ID, "lecture", 60, 1)
+
+	input, _, err := scheduler.CombineSessions(&scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:        scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:         []scheduler.Day{scheduler.Monday},
+			PreferredSlotDuration: 60,
+			MaxSessionsPerDay:     1,
+		},
+		Rooms:          []*models.Room{makeRoom("Room 101", "lecture")},
+		Courses:        []*models.Course{undergrad, grad},
+		CourseSessions: []*models.CourseSession{undergradLecture, gradLecture, undergradTutorial},
+	}, []*models.SessionGroup{
+		models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", []uuid.UUID{undergradLecture.ID, gradLecture.ID}, nil, nil),
+	})
+	require.NoError(t, err)
+
+	output, err := backtrack.NewBacktrackScheduler(nil).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusInfeasible, output.Status)
+	assert.Len(t, output.ScheduledSessions, 1, "The undergraduate course may only meet once on Monday")
+}
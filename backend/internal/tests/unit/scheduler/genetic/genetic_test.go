@@ -0,0 +1,228 @@
+package genetic_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/genetic"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(name, roomType string) *models.Room {
+	return models.NewRoom(uuid.New(), name, roomType, uuid.New(), 30, nil, nil)
+}
+
+func makeCourse(name string) *models.Course {
+	return models.NewCourse(uuid.New(), name, 0, 0, nil, nil)
+}
+
+func makeSession(courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(uuid.New(), courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil, nil, nil, nil, nil)
+}
+
+// departmentInput is a small department where one instructor teaches three courses,
+// each needing two lectures and a lab
+func departmentInput() (*scheduler.Input, map[uuid.UUID]string) {
+	rooms := []*models.Room{makeRoom("Room A", "lecture"), makeRoom("Room B", "lecture"), makeRoom("Lab", "lab")}
+	roomTypes := make(map[uuid.UUID]string)
+	for _, room := range rooms {
+		roomTypes[room.ID] = room.Type
+	}
+
+	instructorID := uuid.New()
+	input := &scheduler.Input{Rooms: rooms}
+	for _, name := range []string{"Algorithms", "Compilers", "Databases"} {
+		course := makeCourse(name)
+		lecture := makeSession(course.ID, "lecture", 90, 2)
+		lab := makeSession(course.ID, "lab", 120, 1)
+
+		input.Courses = append(input.Courses, course)
+		input.CourseSessions = append(input.CourseSessions, lecture, lab)
+		input.InstructorAssignments = append(input.InstructorAssignments, models.NewInstructorAssignment(lecture.ID, instructorID, nil))
+	}
+
+	return input, roomTypes
+}
+
+// TestGenetic_ProducesValidTimetable tests that the fittest timetable is valid and uses rooms of the right type
+func TestGenetic_ProducesValidTimetable(t *testing.T) {
+	input, roomTypes := departmentInput()
+	sessionTypes := make(map[uuid.UUID]string)
+	for _, cs := range input.CourseSessions {
+		sessionTypes[cs.ID] = cs.RequiredRoom
+	}
+
+	output, err := genetic.NewGeneticScheduler(&genetic.Config{PopulationSize: 40, Generations: 100, MutationRate: 0.05, Seed: 3}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 9)
+
+	for i, a := range output.ScheduledSessions {
+		assert.Equal(t, sessionTypes[a.CourseSessionID], roomTypes[a.RoomID], "Sessions must be in a room of the required type")
+
+		for _, b := range output.ScheduledSessions[i+1:] {
+			overlap := a.Day == b.Day && a.StartTime < b.EndTime && b.StartTime < a.EndTime
+			assert.False(t, overlap && a.RoomID == b.RoomID, "Sessions in the same room must not overlap")
+		}
+	}
+}
+
+// TestGenetic_ReportsFitnessHistory tests that the best fitness is reported for every generation and never gets worse
+func TestGenetic_ReportsFitnessHistory(t *testing.T) {
+	input, _ := departmentInput()
+
+	output, err := genetic.NewGeneticScheduler(&genetic.Config{PopulationSize: 20, Generations: 30, MutationRate: 0.1, Seed: 1}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, output.FitnessHistory, 31, "The first generation is reported as well as every bred one")
+	for g := 1; g < len(output.FitnessHistory); g++ {
+		assert.LessOrEqual(t, output.FitnessHistory[g], output.FitnessHistory[g-1])
+	}
+}
+
+// TestGenetic_SameSeedSameResult tests that runs are reproducible
+func TestGenetic_SameSeedSameResult(t *testing.T) {
+	input, _ := departmentInput()
+	config := &genetic.Config{PopulationSize: 20, Generations: 40, MutationRate: 0.05, Seed: 42}
+
+	first, err := genetic.NewGeneticScheduler(config).Generate(context.Background(), input)
+	require.NoError(t, err)
+	second, err := genetic.NewGeneticScheduler(config).Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ScheduledSessions, second.ScheduledSessions)
+	assert.Equal(t, first.FitnessHistory, second.FitnessHistory)
+}
+
+// TestGenetic_RespectsInstructorAvailability tests that sessions are kept out of an instructor's unavailable windows
+func TestGenetic_RespectsInstructorAvailability(t *testing.T) {
+	room := makeRoom("Room 101", "lecture")
+	course := makeCourse("Ethics")
+	session := makeSession(course.ID, "lecture", 60, 1)
+	instructorID := uuid.New()
+
+	output, err := genetic.NewGeneticScheduler(&genetic.Config{PopulationSize: 10, Generations: 20, MutationRate: 0.1, Seed: 5}).Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, int32(scheduler.Monday), 480, 540, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.GreaterOrEqual(t, output.ScheduledSessions[0].StartTime, 540)
+}
+
+// TestGenetic_NoSuitableRoom tests that a session with no room of its type is reported as a failure
+func TestGenetic_NoSuitableRoom(t *testing.T) {
+	course := makeCourse("Chemistry")
+
+	output, err := genetic.NewGeneticScheduler(nil).Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom("Room 101", "lecture")},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(course.ID, "lab", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonNoRoomsOfType, output.Failures[0].Reason)
+}
+
+// TestGenetic_Canceled tests that a canceled search stops after the first generation and still
+// returns a valid timetable
+func TestGenetic_Canceled(t *testing.T) {
+	input, _ := departmentInput()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	output, err := genetic.NewGeneticScheduler(&genetic.Config{PopulationSize: 20, Generations: 100, MutationRate: 0.05, Seed: 1}).Generate(ctx, input)
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusCanceled, output.Status)
+	assert.Len(t, output.FitnessHistory, 1)
+	assert.NotEmpty(t, output.ScheduledSessions)
+}
+
+// TestGenetic_ReportsProgress tests that progress never goes backwards and ends at what the timetable places
+func TestGenetic_ReportsProgress(t *testing.T) {
+	input, _ := departmentInput()
+	var reports []scheduler.Progress
+	input.Progress = func(p scheduler.Progress) {
+		reports = append(reports, p)
+	}
+
+	output, err := genetic.NewGeneticScheduler(&genetic.Config{PopulationSize: 20, Generations: 30, MutationRate: 0.1, Seed: 1}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, reports)
+	for i := 1; i < len(reports); i++ {
+		assert.LessOrEqual(t, reports[i-1].Placed, reports[i].Placed)
+	}
+	assert.Equal(t, scheduler.Progress{Placed: len(output.ScheduledSessions), Total: 9}, reports[len(reports)-1])
+}
+
+// TestGenetic_KeepsLinks tests that every lab of the department starts after its course's first lecture ends
+func TestGenetic_KeepsLinks(t *testing.T) {
+	input, _ := departmentInput()
+	for k := 0; k < len(input.CourseSessions); k += 2 {
+		lecture, lab := input.CourseSessions[k], input.CourseSessions[k+1]
+		input.Links = append(input.Links, models.NewSessionLink(uuid.New(), lecture.ID, lab.ID, models.SessionLinkBefore, nil, nil))
+	}
+
+	output, err := genetic.NewGeneticScheduler(&genetic.Config{PopulationSize: 40, Generations: 100, MutationRate: 0.05, Seed: 3}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	for _, link := range input.Links {
+		var anchor []*models.ScheduledSession
+		for _, ss := range output.ScheduledSessions {
+			if ss.CourseSessionID == link.CourseSessionID {
+				anchor = append(anchor, ss)
+			}
+		}
+		for _, ss := range output.ScheduledSessions {
+			if ss.CourseSessionID == link.OtherSessionID {
+				assert.True(t, scheduler.LinkAllows(link.Kind, anchor, ss, 0), "The lab starts after its course's first lecture")
+			}
+		}
+	}
+}
+
+// TestGenetic_KeepsSpreadRules tests that no course meets twice on a day against its per-day cap,
+// or on days closer together than it allows
+func TestGenetic_KeepsSpreadRules(t *testing.T) {
+	input, _ := departmentInput()
+	input.Config = scheduler.DefaultConfig()
+	input.Config.MaxSessionsPerDay = 1
+	input.Config.MinDaysBetweenSessions = 2
+
+	output, err := genetic.NewGeneticScheduler(&genetic.Config{PopulationSize: 40, Generations: 100, MutationRate: 0.05, Seed: 3}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	for i, a := range output.ScheduledSessions {
+		for _, b := range output.ScheduledSessions[i+1:] {
+			if a.CourseID == b.CourseID {
+				assert.GreaterOrEqual(t, max(a.Day-b.Day, b.Day-a.Day), 2, "Meetings of a course are two days apart")
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestCapacity_SkipsRoomsTooSmall tests that rooms smaller than the enrollment are never used
+func TestCapacity_SkipsRoomsTooSmall(t *testing.T) {
+	smallRoom := makeRoomWithCapacity(uuid.New(), "Seminar", "lecture", 30)
+	largeRoom := makeRoomWithCapacity(uuid.New(), "Auditorium", "lecture", 250)
+
+	course := models.NewCourse(uuid.New(), "Intro to Psychology", 200, 0, nil, nil)
+	sessions := []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture", 60, 3)}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{smallRoom, largeRoom},
+		Courses:        []*models.Course{course},
+		CourseSessions: sessions,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+
+	for _, s := range output.ScheduledSessions {
+		assert.Equal(t, largeRoom.ID, s.RoomID, "Session should be placed in a room that fits the enrollment")
+	}
+}
+
+// TestCapacity_PrefersLeastWastedCapacity tests that the smallest adequate room is chosen
+func TestCapacity_PrefersLeastWastedCapacity(t *testing.T) {
+	hall := makeRoomWithCapacity(uuid.New(), "Hall", "lecture", 300)
+	tight := makeRoomWithCapacity(uuid.New(), "Room 201", "lecture", 45)
+	medium := makeRoomWithCapacity(uuid.New(), "Room 301", "lecture", 80)
+
+	course := models.NewCourse(uuid.New(), "Linear Algebra", 40, 0, nil, nil)
+	sessions := []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture", 60, 1)}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{hall, medium, tight},
+		Courses:        []*models.Course{course},
+		CourseSessions: sessions,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, tight.ID, output.ScheduledSessions[0].RoomID)
+}
+
+// TestCapacity_NoRoomLargeEnough tests the failure reason when no room can seat the enrollment
+func TestCapacity_NoRoomLargeEnough(t *testing.T) {
+	room := makeRoomWithCapacity(uuid.New(), "Room 101", "lecture", 30)
+
+	course := models.NewCourse(uuid.New(), "Economics 101", 200, 0, nil, nil)
+	sessions := []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture", 60, 2)}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: sessions,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInsufficientCapacity, output.Failures[0].Reason)
+}
+
+// TestCapacity_SessionEnrollmentOverridesCourse tests that a session-level enrollment takes precedence
+func TestCapacity_SessionEnrollmentOverridesCourse(t *testing.T) {
+	lab := makeRoomWithCapacity(uuid.New(), "Lab A", "lab", 25)
+
+	course := models.NewCourse(uuid.New(), "Chemistry 101", 200, 0, nil, nil)
+	labSession := models.NewCourseSession(uuid.New(), course.ID, "lab", "lab", ptr(int32(120)), ptr(int32(1)), ptr(int32(20)), nil, nil, nil, nil, nil)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{lab},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{labSession},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Empty(t, output.Failures)
+	assert.Equal(t, lab.ID, output.ScheduledSessions[0].RoomID)
+}
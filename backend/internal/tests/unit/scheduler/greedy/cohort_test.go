@@ -0,0 +1,158 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestCohort_CoursesNeverOverlap tests that courses in the same cohort are placed at different times
+func TestCohort_CoursesNeverOverlap(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+
+	course1 := makeCourse(uuid.New(), "Data Structures")
+	course2 := makeCourse(uuid.New(), "Discrete Maths")
+	course3 := makeCourse(uuid.New(), "Operating Systems")
+
+	cohort := models.NewCohort(uuid.New(), "Year 2 Computer Science", []uuid.UUID{course1.ID, course2.ID, course3.ID}, nil, nil)
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:  config,
+		Rooms:   []*models.Room{roomA, roomB},
+		Courses: []*models.Course{course1, course2, course3},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1.ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2.ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course3.ID, "lecture", 60, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+
+	for i, a := range output.ScheduledSessions {
+		for _, b := range output.ScheduledSessions[i+1:] {
+			assert.False(t, overlaps(a, b), "Courses in the same cohort must not overlap")
+		}
+	}
+}
+
+// TestCohort_ClashFailure tests that a session blocked only by its cohort is reported as a cohort clash
+func TestCohort_ClashFailure(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+
+	course1 := makeCourse(uuid.New(), "Data Structures")
+	course2 := makeCourse(uuid.New(), "Discrete Maths")
+
+	cohort := models.NewCohort(uuid.New(), "Year 2 Computer Science", []uuid.UUID{course1.ID, course2.ID}, nil, nil)
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:  config,
+		Rooms:   []*models.Room{roomA, roomB},
+		Courses: []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1.ID, "lecture", 240, 1),
+			makeSession(uuid.New(), course2.ID, "lecture", 240, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonCohortClash, output.Failures[0].Reason)
+}
+
+// TestCohort_SeparateCohortsShareTime tests that courses in different cohorts may run in parallel
+func TestCohort_SeparateCohortsShareTime(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+
+	course1 := makeCourse(uuid.New(), "Data Structures")
+	course2 := makeCourse(uuid.New(), "Organic Chemistry")
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:  config,
+		Rooms:   []*models.Room{roomA, roomB},
+		Courses: []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1.ID, "lecture", 240, 1),
+			makeSession(uuid.New(), course2.ID, "lecture", 240, 1),
+		},
+		Cohorts: []*models.Cohort{
+			models.NewCohort(uuid.New(), "Year 2 Computer Science", []uuid.UUID{course1.ID}, nil, nil),
+			models.NewCohort(uuid.New(), "Year 2 Chemistry", []uuid.UUID{course2.ID}, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+}
+
+// TestCohort_InstructorConflictReportedFirst tests that an instructor conflict takes precedence over a cohort clash
+func TestCohort_InstructorConflictReportedFirst(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+
+	course1 := makeCourse(uuid.New(), "Data Structures")
+	course2 := makeCourse(uuid.New(), "Discrete Maths")
+	session1 := makeSession(uuid.New(), course1.ID, "lecture", 240, 1)
+	session2 := makeSession(uuid.New(), course2.ID, "lecture", 240, 1)
+
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{roomA, roomB},
+		Courses:        []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{session1, session2},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session1.ID, instructorID, nil),
+			models.NewInstructorAssignment(session2.ID, instructorID, nil),
+		},
+		Cohorts: []*models.Cohort{
+			models.NewCohort(uuid.New(), "Year 2 Computer Science", []uuid.UUID{course1.ID, course2.ID}, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorConflict, output.Failures[0].Reason)
+}
@@ -0,0 +1,95 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// threeCourses is a room and three courses of two one-hour meetings each, the first with one pinned
+func threeCourses() *scheduler.Input {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	input := &scheduler.Input{Rooms: []*models.Room{room}}
+
+	for _, name := range []string{"Algebra", "Biology", "Chemistry"} {
+		course := makeCourse(uuid.New(), name)
+		input.Courses = append(input.Courses, course)
+		input.CourseSessions = append(input.CourseSessions, makeSession(uuid.New(), course.ID, "lecture", 60, 2))
+	}
+	input.Pins = []*models.SessionPin{
+		models.NewSessionPin(uuid.New(), input.CourseSessions[0].ID, room.ID, int32(scheduler.Friday), 600, nil, nil),
+	}
+
+	return input
+}
+
+// TestContext_Canceled tests that a canceled pass keeps its pins and stops before placing anything else
+func TestContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(ctx, threeCourses())
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusCanceled, output.Status)
+	require.Len(t, output.ScheduledSessions, 1, "Only the pin is placed")
+	assert.Equal(t, 600, output.ScheduledSessions[0].StartTime)
+	assert.Empty(t, output.Failures, "Sessions never tried are not failures")
+}
+
+// TestContext_DeadlinePassed tests that a pass whose deadline has passed reports a timeout
+func TestContext_DeadlinePassed(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(ctx, threeCourses())
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusTimedOut, output.Status)
+}
+
+// TestContext_PinConflict tests that pins are still checked when the context is done
+func TestContext_PinConflict(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	input := threeCourses()
+	input.Pins = append(input.Pins, models.NewSessionPin(uuid.New(), input.CourseSessions[1].ID, input.Rooms[0].ID, int32(scheduler.Friday), 600, nil, nil))
+
+	_, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(ctx, input)
+
+	var conflictErr *scheduler.PinConflictError
+	require.ErrorAs(t, err, &conflictErr)
+}
+
+// TestContext_ReportsProgress tests that a report is made for the pins and then for every meeting placed
+func TestContext_ReportsProgress(t *testing.T) {
+	input := threeCourses()
+	var reports []scheduler.Progress
+	input.Progress = func(p scheduler.Progress) {
+		reports = append(reports, p)
+	}
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Status)
+	require.Len(t, output.ScheduledSessions, 6)
+	assert.Equal(t, []scheduler.Progress{
+		{Placed: 1, Total: 6},
+		{Placed: 2, Total: 6},
+		{Placed: 3, Total: 6},
+		{Placed: 4, Total: 6},
+		{Placed: 5, Total: 6},
+		{Placed: 6, Total: 6},
+	}, reports)
+}
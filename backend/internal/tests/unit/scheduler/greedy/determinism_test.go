@@ -0,0 +1,110 @@
+package greedy_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// tiedInput builds courses of equal weight competing for rooms of equal capacity,
+// so every choice the scheduler makes comes down to a tie-breaker
+func tiedInput() *scheduler.Input {
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday},
+		},
+		Rooms: []*models.Room{
+			makeRoom(uuid.New(), "Room A", "lecture"),
+			makeRoom(uuid.New(), "Room B", "lecture"),
+		},
+	}
+
+	for _, name := range []string{"Algebra", "Biology", "Chemistry", "Drama", "Economics", "French"} {
+		course := makeCourse(uuid.New(), name)
+		input.Courses = append(input.Courses, course)
+		input.CourseSessions = append(input.CourseSessions, makeSession(uuid.New(), course.ID, "lecture", 60, 2))
+	}
+
+	return input
+}
+
+// TestDeterminism_IndependentOfInputOrder tests that reordering equally weighted courses and
+// equally sized rooms does not change the schedule
+func TestDeterminism_IndependentOfInputOrder(t *testing.T) {
+	input := tiedInput()
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+
+	first, err := sched.Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	slices.Reverse(input.Courses)
+	slices.Reverse(input.Rooms)
+	second, err := sched.Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ScheduledSessions, second.ScheduledSessions)
+	assert.Nil(t, first.Seed)
+}
+
+// TestDeterminism_FixedTieBreakers tests the documented tie-breakers: course name, Monday first, room name
+func TestDeterminism_FixedTieBreakers(t *testing.T) {
+	input := tiedInput()
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+
+	output, err := sched.Generate(context.Background(), input)
+	require.NoError(t, err)
+	require.NotEmpty(t, output.ScheduledSessions)
+
+	first := output.ScheduledSessions[0]
+	assert.Equal(t, input.Courses[0].ID, first.CourseID, "Algebra comes first by name")
+	assert.Equal(t, int(scheduler.Monday), first.Day)
+	assert.Equal(t, input.Rooms[0].ID, first.RoomID, "Room A comes first by name")
+}
+
+// TestDeterminism_Seed tests that a seed reproduces the same schedule and is recorded in the output
+func TestDeterminism_Seed(t *testing.T) {
+	input := tiedInput()
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+
+	seed := int64(42)
+	input.Config.Seed = &seed
+
+	first, err := sched.Generate(context.Background(), input)
+	require.NoError(t, err)
+	second, err := sched.Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ScheduledSessions, second.ScheduledSessions)
+	require.NotNil(t, first.Seed)
+	assert.Equal(t, seed, *first.Seed)
+}
+
+// TestDeterminism_SeedsBreakTiesDifferently tests that the seed actually drives the tie-breaks
+func TestDeterminism_SeedsBreakTiesDifferently(t *testing.T) {
+	input := tiedInput()
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+
+	firstSessions := make(map[uuid.UUID]bool)
+	for seed := int64(1); seed <= 10; seed++ {
+		input.Config.Seed = &seed
+
+		output, err := sched.Generate(context.Background(), input)
+		require.NoError(t, err)
+		require.NotEmpty(t, output.ScheduledSessions)
+		assert.Empty(t, output.Failures)
+
+		firstSessions[output.ScheduledSessions[0].CourseID] = true
+	}
+
+	assert.Greater(t, len(firstSessions), 1, "Different seeds should put different courses first")
+}
@@ -0,0 +1,161 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestDiagnosis_SlotsConsumed tests that a full room reports the largest free block left on each day
+func TestDiagnosis_SlotsConsumed(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	statistics := makeCourse(uuid.New(), "Statistics")
+	ethics := makeCourse(uuid.New(), "Ethics")
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 660},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:   []*models.Room{room},
+		Courses: []*models.Course{statistics, ethics},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), statistics.ID, "lecture", 150, 2),
+			makeSession(uuid.New(), ethics.ID, "lecture", 60, 1),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+
+	failure := output.Failures[0]
+	assert.Equal(t, scheduler.ReasonNoTimeSlot, failure.Reason)
+	require.NotNil(t, failure.Diagnosis)
+
+	d := failure.Diagnosis
+	assert.Equal(t, scheduler.CodeSlotsConsumed, d.Code)
+	assert.Equal(t, []scheduler.FailureCode{scheduler.CodeSlotsConsumed}, d.Blocking)
+	assert.Equal(t, 1, d.RoomsOfType)
+	assert.Equal(t, 1, d.SuitableRooms)
+	assert.Equal(t, 60, d.Duration)
+	assert.Equal(t, []*scheduler.FreeBlock{
+		{Day: scheduler.Monday, Minutes: 30},
+		{Day: scheduler.Tuesday, Minutes: 30},
+	}, d.FreeBlocks, "Only 30 minutes are left after each 150 minute Statistics session")
+}
+
+// TestDiagnosis_NoRoomsOfType tests that a session with no room of its type says so
+func TestDiagnosis_NoRoomsOfType(t *testing.T) {
+	course := makeCourse(uuid.New(), "Chemistry")
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lab", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonNoRoomsOfType, output.Failures[0].Reason)
+
+	d := output.Failures[0].Diagnosis
+	require.NotNil(t, d)
+	assert.Equal(t, scheduler.CodeNoRoomsOfType, d.Code)
+	assert.Zero(t, d.RoomsOfType)
+	for _, block := range d.FreeBlocks {
+		assert.Zero(t, block.Minutes)
+	}
+}
+
+// TestDiagnosis_EveryBlockingConstraint tests that all constraints blocking a session are listed
+func TestDiagnosis_EveryBlockingConstraint(t *testing.T) {
+	course := makeCourse(uuid.New(), "Field Trip")
+	course.Enrollment = 100
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture", 900, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInsufficientCapacity, output.Failures[0].Reason)
+
+	d := output.Failures[0].Diagnosis
+	require.NotNil(t, d)
+	assert.Equal(t, []scheduler.FailureCode{scheduler.CodeInsufficientCapacity, scheduler.CodeDurationTooLong}, d.Blocking)
+	assert.Equal(t, 1, d.RoomsOfType)
+	assert.Zero(t, d.SuitableRooms)
+}
+
+// TestDiagnosis_InstructorConflict tests that the largest free blocks account for the instructor being busy
+func TestDiagnosis_InstructorConflict(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Ethics")
+	session := makeSession(uuid.New(), course.ID, "lecture", 90, 1)
+	instructorID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, int32(scheduler.Monday), 540, 660, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorConflict, output.Failures[0].Reason)
+
+	d := output.Failures[0].Diagnosis
+	require.NotNil(t, d)
+	assert.Equal(t, []scheduler.FailureCode{scheduler.CodeInstructorConflict}, d.Blocking)
+	assert.Equal(t, []*scheduler.FreeBlock{{Day: scheduler.Monday, Minutes: 60}}, d.FreeBlocks)
+}
+
+// TestDiagnosis_SpreadRule tests that a session kept off the days its course already uses is reported as such
+func TestDiagnosis_SpreadRule(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Calculus")
+	other := makeCourse(uuid.New(), "Statistics")
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:   []*models.Room{room},
+		Courses: []*models.Course{course, other},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course.ID, "lecture", 60, 2),
+			makeSession(uuid.New(), other.ID, "lecture", 240, 1),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonSpreadRule, output.Failures[0].Reason)
+	assert.Equal(t, scheduler.CodeSpreadRule, output.Failures[0].Diagnosis.Code)
+}
@@ -1,6 +1,7 @@
 package greedy_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -20,12 +21,16 @@ func makeRoom(id uuid.UUID, name, roomType string) *models.Room {
 	return models.NewRoom(id, name, roomType, uuid.New(), 30, nil, nil)
 }
 
+func makeRoomWithCapacity(id uuid.UUID, name, roomType string, capacity int32) *models.Room {
+	return models.NewRoom(id, name, roomType, uuid.New(), capacity, nil, nil)
+}
+
 func makeCourse(id uuid.UUID, name string) *models.Course {
-	return models.NewCourse(id, name, nil, nil)
+	return models.NewCourse(id, name, 0, 0, nil, nil)
 }
 
 func makeSession(id, courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
-	return models.NewCourseSession(id, courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil)
+	return models.NewCourseSession(id, courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil, nil, nil, nil, nil)
 }
 
 // TestGenerate_SingleSession_Success tests scheduling a single session
@@ -38,7 +43,7 @@ func TestGenerate_SingleSession_Success(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -64,7 +69,7 @@ func TestGenerate_MultipleSessions_SpreadAcrossDays(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 3)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -91,10 +96,11 @@ func TestGenerate_NoAvailableSlot_Failure(t *testing.T) {
 	courses := []*models.Course{makeCourse(courseID, "Math 101")}
 	// Request more time than available: 5 days × 13 hours = 3900 minutes
 	// Request 6 sessions × 800 minutes = 4800 minutes (exceeds capacity)
+	// Each 800 minute session is also longer than the 780 minute operating day
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 800, 6)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -102,7 +108,7 @@ func TestGenerate_NoAvailableSlot_Failure(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, output.Failures)
-	assert.Contains(t, output.Failures[0].Reason, "no available time slot found")
+	assert.Equal(t, scheduler.ReasonDurationTooLong, output.Failures[0].Reason)
 }
 
 // TestGenerate_MultipleRoomTypes tests sessions are assigned to correct room types
@@ -118,10 +124,10 @@ func TestGenerate_MultipleRoomTypes(t *testing.T) {
 	courses := []*models.Course{makeCourse(courseID, "CS 101")}
 
 	lectureSession := makeSession(uuid.New(), courseID, "lecture", 60, 1)
-	labSession := models.NewCourseSession(uuid.New(), courseID, "lab", "lab", ptr(int32(90)), ptr(int32(1)), nil, nil)
+	labSession := models.NewCourseSession(uuid.New(), courseID, "lab", "lab", ptr(int32(90)), ptr(int32(1)), nil, nil, nil, nil, nil, nil)
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: []*models.CourseSession{lectureSession, labSession},
@@ -164,7 +170,7 @@ func TestGenerate_WithMinBreakBetweenSessions(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -200,7 +206,7 @@ func TestGenerate_WithPreferredSlotDuration(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -226,7 +232,7 @@ func TestGenerate_DefaultConfig(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         nil, // Should use default
 		Rooms:          rooms,
 		Courses:        courses,
@@ -248,7 +254,7 @@ func TestGenerate_DefaultConfig(t *testing.T) {
 // TestGenerate_EmptyInput tests handling of empty input
 func TestGenerate_EmptyInput(t *testing.T) {
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          []*models.Room{},
 		Courses:        []*models.Course{},
 		CourseSessions: []*models.CourseSession{},
@@ -281,7 +287,7 @@ func TestGenerate_HigherWeightCoursesScheduledFirst(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -312,7 +318,7 @@ func TestGenerate_NoMatchingRoomType(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "chemistry_lab", 60, 1)} // No chemistry_lab room
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
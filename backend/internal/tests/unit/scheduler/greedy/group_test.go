@@ -0,0 +1,171 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestGroups_ScheduledOnce tests that the sessions of a group meet once, in a room big enough for
+// their combined enrollment, and that each meeting lists every course it serves
+func TestGroups_ScheduledOnce(t *testing.T) {
+	small := makeRoomWithCapacity(uuid.New(), "Room 101", "lecture", 40)
+	large := makeRoomWithCapacity(uuid.New(), "Great Hall", "lecture", 80)
+	undergrad, grad := makeCourse(uuid.New(), "Databases"), makeCourse(uuid.New(), "Advanced Databases")
+	undergradSession := makeSession(uuid.New(), undergrad.ID, "lecture", 60, 2)
+	undergradSession.Enrollment = ptr(int32(35))
+	gradSession := makeSession(uuid.New(), grad.ID, "lecture", 60, 2)
+	gradSession.Enrollment = ptr(int32(15))
+	group := models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", []uuid.UUID{undergradSession.ID, gradSession.ID}, nil, nil)
+
+	input, groups, err := scheduler.CombineSessions(&scheduler.Input{
+		Rooms:          []*models.Room{small, large},
+		Courses:        []*models.Course{undergrad, grad},
+		CourseSessions: []*models.CourseSession{undergradSession, gradSession},
+	}, []*models.SessionGroup{group})
+	require.NoError(t, err)
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	groups.Restore(output)
+
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 2, "The group should meet twice, not twice per course")
+	for _, ss := range output.ScheduledSessions {
+		assert.Equal(t, large.ID, ss.RoomID, "Only the hall holds both courses")
+		assert.Equal(t, undergradSession.ID, ss.CourseSessionID, "Meetings refer to the group's first session")
+		assert.Equal(t, undergrad.ID, ss.CourseID)
+		assert.Equal(t, group.ID, ss.GroupID)
+		assert.Equal(t, []uuid.UUID{undergrad.ID, grad.ID}, ss.CourseIDs)
+	}
+}
+
+// TestGroups_CohortsAndInstructors tests that a group keeps clear of the cohorts of every member
+// course and of every member's instructor, and that a pin on any member fixes the group
+func TestGroups_CohortsAndInstructors(t *testing.T) {
+	rooms := []*models.Room{
+		makeRoom(uuid.New(), "Room 101", "lecture"),
+		makeRoom(uuid.New(), "Room 102", "lecture"),
+		makeRoom(uuid.New(), "Room 103", "lecture"),
+	}
+	undergrad, grad := makeCourse(uuid.New(), "Databases"), makeCourse(uuid.New(), "Advanced Databases")
+	seminar, tutorial := makeCourse(uuid.New(), "Research Seminar"), makeCourse(uuid.New(), "Programming Tutorial")
+	undergradSession := makeSession(uuid.New(), undergrad.ID, "lecture", 60, 1)
+	gradSession := makeSession(uuid.New(), grad.ID, "lecture", 60, 1)
+	seminarSession := makeSession(uuid.New(), seminar.ID, "lecture", 60, 1)
+	tutorialSession := makeSession(uuid.New(), tutorial.ID, "lecture", 60, 1)
+	instructorID := uuid.New()
+
+	input, groups, err := scheduler.CombineSessions(&scheduler.Input{
+		Config:         oneHourMonday(),
+		Rooms:          rooms,
+		Courses:        []*models.Course{undergrad, grad, seminar, tutorial},
+		CourseSessions: []*models.CourseSession{undergradSession, gradSession, seminarSession, tutorialSession},
+		Cohorts: []*models.Cohort{
+			models.NewCohort(uuid.New(), "MSc Computer Science", []uuid.UUID{grad.ID, seminar.ID}, nil, nil),
+		},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(undergradSession.ID, instructorID, nil),
+			models.NewInstructorAssignment(tutorialSession.ID, instructorID, nil),
+		},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), gradSession.ID, rooms[0].ID, int32(scheduler.Monday), 480, nil, nil),
+		},
+	}, []*models.SessionGroup{
+		models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", []uuid.UUID{undergradSession.ID, gradSession.ID}, nil, nil),
+	})
+	require.NoError(t, err)
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	groups.Restore(output)
+
+	require.Len(t, output.ScheduledSessions, 1, "Only the pinned group fits the single hour")
+	assert.Equal(t, rooms[0].ID, output.ScheduledSessions[0].RoomID)
+	assert.Equal(t, undergradSession.ID, output.ScheduledSessions[0].CourseSessionID)
+
+	codes := make(map[uuid.UUID]scheduler.FailureCode)
+	for _, f := range output.Failures {
+		codes[f.CourseSession.ID] = f.Diagnosis.Code
+	}
+	assert.Equal(t, scheduler.CodeCohortClash, codes[seminarSession.ID], "The graduate course's cohort cannot attend both")
+	assert.Equal(t, scheduler.CodeInstructorConflict, codes[tutorialSession.ID], "The undergraduate course's instructor cannot teach both")
+}
+
+// TestGroups_Mismatch tests that sessions which cannot meet as one are rejected
+func TestGroups_Mismatch(t *testing.T) {
+	undergrad, grad := makeCourse(uuid.New(), "Databases"), makeCourse(uuid.New(), "Advanced Databases")
+	undergradSession := makeSession(uuid.New(), undergrad.ID, "lecture", 60, 2)
+	gradSession := makeSession(uuid.New(), grad.ID, "lecture", 90, 2)
+
+	_, _, err := scheduler.CombineSessions(&scheduler.Input{
+		Courses:        []*models.Course{undergrad, grad},
+		CourseSessions: []*models.CourseSession{undergradSession, gradSession},
+	}, []*models.SessionGroup{
+		models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", []uuid.UUID{undergradSession.ID, gradSession.ID}, nil, nil),
+	})
+
+	require.ErrorIs(t, err, scheduler.ErrSessionGroupMismatch)
+}
+
+// TestGroups_SpreadAcrossMemberCourses tests that a group's meetings count against the spreading
+// rules of every member course, not only against the group itself
+func TestGroups_SpreadAcrossMemberCourses(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	undergrad, grad := makeCourse(uuid.New(), "Databases"), makeCourse(uuid.New(), "Advanced Databases")
+	undergradLecture := makeSession(uuid.New(), undergrad.ID, "lecture", 60, 1)
+	gradLecture := makeSession(uuid.New(), grad.ID, "lecture", 60, 1)
+	undergradTutorial := makeSession(uuid.New(), undergrad.ID, "lecture", 60, 1)
+
+	input, groups, err := scheduler.CombineSessions(&scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:    scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:     []scheduler.Day{scheduler.Monday},
+			MaxSessionsPerDay: 1,
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{undergrad, grad},
+		CourseSessions: []*models.CourseSession{undergradLecture, gradLecture, undergradTutorial},
+	}, []*models.SessionGroup{
+		models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", []uuid.UUID{undergradLecture.ID, gradLecture.ID}, nil, nil),
+	})
+	require.NoError(t, err)
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	groups.Restore(output)
+
+	require.Len(t, output.ScheduledSessions, 1, "The undergraduate course may only meet once on Monday")
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.CodeSpreadRule, output.Failures[0].Diagnosis.Code)
+}
+
+// TestGroups_SectionedCourse tests that a group including a session of a course with sections is
+// rejected, since the group would meet once for every section
+func TestGroups_SectionedCourse(t *testing.T) {
+	undergrad, grad := makeCourse(uuid.New(), "Databases"), makeCourse(uuid.New(), "Advanced Databases")
+	undergradSession := makeSession(uuid.New(), undergrad.ID, "lecture", 60, 2)
+	gradSession := makeSession(uuid.New(), grad.ID, "lecture", 60, 2)
+
+	input, _, err := scheduler.CombineSessions(&scheduler.Input{
+		Courses:        []*models.Course{undergrad, grad},
+		CourseSessions: []*models.CourseSession{undergradSession, gradSession},
+	}, []*models.SessionGroup{
+		models.NewSessionGroup(uuid.New(), "Databases (cross-listed)", []uuid.UUID{undergradSession.ID, gradSession.ID}, nil, nil),
+	})
+	require.NoError(t, err)
+
+	_, _, err = scheduler.ExpandSections(input, []*models.CourseSection{
+		models.NewCourseSection(uuid.New(), undergrad.ID, "Section A", nil, nil, nil, nil),
+	})
+
+	require.ErrorIs(t, err, scheduler.ErrSessionGroupSectioned)
+}
@@ -1,6 +1,7 @@
 package greedy_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -35,7 +36,7 @@ func TestInitAvailability(t *testing.T) {
 	courses := []*models.Course{makeCourse(courseID, "Test Course")}
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 3)}
 
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -66,7 +67,7 @@ func TestRoomsByType(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 2)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -98,7 +99,7 @@ func TestFindFirstAvailableSlot_WithoutAlignment(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -128,7 +129,7 @@ func TestFindFirstAvailableSlot_WithAlignment(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -159,7 +160,7 @@ func TestFindFirstAvailableSlot_AlignmentFallback(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -195,7 +196,7 @@ func TestConsumeSlot_StartOfRange(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -240,7 +241,7 @@ func TestConsumeSlot_MiddleOfRange(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -286,7 +287,7 @@ func TestSortWeightsByDescending(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -320,7 +321,7 @@ func TestNilRoomsHandling(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -345,7 +346,7 @@ func TestNilCoursesHandling(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -369,7 +370,7 @@ func TestNilSessionsHandling(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -0,0 +1,104 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestConfig_Hours tests that per-day ranges replace the operating hours and blocked windows are taken out
+func TestConfig_Hours(t *testing.T) {
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Friday, scheduler.Sunday},
+		DayHours: map[scheduler.Day][]scheduler.TimeRange{
+			scheduler.Friday:   {{Start: 480, End: 780}},
+			scheduler.Saturday: {{Start: 600, End: 720}, {Start: 480, End: 660}},
+			scheduler.Sunday:   {},
+		},
+		BlockedWindows: []scheduler.TimeRange{{Start: 720, End: 780}},
+	}
+
+	assert.Equal(t, []scheduler.Day{scheduler.Monday, scheduler.Friday, scheduler.Saturday}, config.Days())
+	assert.Equal(t, []scheduler.TimeRange{{Start: 480, End: 720}, {Start: 780, End: 1020}}, config.Hours(scheduler.Monday))
+	assert.Equal(t, []scheduler.TimeRange{{Start: 480, End: 720}}, config.Hours(scheduler.Friday), "Friday closes at 13:00, less lunch")
+	assert.Equal(t, []scheduler.TimeRange{{Start: 480, End: 720}}, config.Hours(scheduler.Saturday), "Overlapping ranges should merge")
+	assert.Empty(t, config.Hours(scheduler.Sunday))
+	assert.Empty(t, config.Hours(scheduler.Tuesday))
+	assert.Equal(t, 240, config.LongestOpening())
+}
+
+// TestDayHours_RespectsEachDay tests that sessions stay within each day's own hours and out of blocked windows
+func TestDayHours_RespectsEachDay(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	var courses []*models.Course
+	var sessions []*models.CourseSession
+	for _, name := range []string{"Algebra", "Biology", "Chemistry", "Drama", "Economics"} {
+		course := makeCourse(uuid.New(), name)
+		courses = append(courses, course)
+		sessions = append(sessions, makeSession(uuid.New(), course.ID, "lecture", 60, 1))
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 660, End: 840},
+			OperatingDays:  []scheduler.Day{scheduler.Friday},
+			DayHours: map[scheduler.Day][]scheduler.TimeRange{
+				scheduler.Saturday: {{Start: 480, End: 600}},
+			},
+			BlockedWindows:        []scheduler.TimeRange{{Start: 720, End: 780}},
+			PreferredSlotDuration: 60,
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        courses,
+		CourseSessions: sessions,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 4, "Friday has an hour either side of lunch and Saturday two")
+	require.Len(t, output.Failures, 1)
+
+	for _, ss := range output.ScheduledSessions {
+		switch ss.Day {
+		case int(scheduler.Friday):
+			assert.True(t, ss.StartTime >= 660 && ss.EndTime <= 840)
+		case int(scheduler.Saturday):
+			assert.True(t, ss.StartTime >= 480 && ss.EndTime <= 600)
+		default:
+			assert.Failf(t, "session on a closed day", "day %d", ss.Day)
+		}
+		assert.False(t, ss.StartTime < 780 && ss.EndTime > 720, "Session should avoid the blocked lunch hour")
+	}
+}
+
+// TestDiagnosis_DurationTooLong_BlockedWindow tests that a session longer than every opening left by the
+// blocked windows is reported as too long
+func TestDiagnosis_DurationTooLong_BlockedWindow(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Seminar")
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			BlockedWindows: []scheduler.TimeRange{{Start: 720, End: 780}},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture", 300, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.CodeDurationTooLong, output.Failures[0].Diagnosis.Code)
+}
@@ -0,0 +1,172 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// overlaps reports whether two scheduled sessions share any time on the same day
+func overlaps(a, b *models.ScheduledSession) bool {
+	return a.Day == b.Day && a.StartTime < b.EndTime && b.StartTime < a.EndTime
+}
+
+// TestInstructor_NoDoubleBooking tests that sessions sharing an instructor never overlap, even in different rooms
+func TestInstructor_NoDoubleBooking(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+
+	course1 := makeCourse(uuid.New(), "Algorithms")
+	course2 := makeCourse(uuid.New(), "Compilers")
+	session1 := makeSession(uuid.New(), course1.ID, "lecture", 120, 1)
+	session2 := makeSession(uuid.New(), course2.ID, "lecture", 120, 1)
+
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{roomA, roomB},
+		Courses:        []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{session1, session2},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session1.ID, instructorID, nil),
+			models.NewInstructorAssignment(session2.ID, instructorID, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+	assert.False(t, overlaps(output.ScheduledSessions[0], output.ScheduledSessions[1]),
+		"Sessions taught by the same instructor must not overlap")
+}
+
+// TestInstructor_DifferentInstructorsShareTime tests that unrelated instructors can teach at the same time
+func TestInstructor_DifferentInstructorsShareTime(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+
+	course1 := makeCourse(uuid.New(), "Algorithms")
+	course2 := makeCourse(uuid.New(), "Compilers")
+	session1 := makeSession(uuid.New(), course1.ID, "lecture", 240, 1)
+	session2 := makeSession(uuid.New(), course2.ID, "lecture", 240, 1)
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{roomA, roomB},
+		Courses:        []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{session1, session2},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session1.ID, uuid.New(), nil),
+			models.NewInstructorAssignment(session2.ID, uuid.New(), nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+}
+
+// TestInstructor_ConflictFailure tests the failure reason when rooms are free but the instructor is not
+func TestInstructor_ConflictFailure(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+
+	course1 := makeCourse(uuid.New(), "Algorithms")
+	course2 := makeCourse(uuid.New(), "Compilers")
+	session1 := makeSession(uuid.New(), course1.ID, "lecture", 240, 1)
+	session2 := makeSession(uuid.New(), course2.ID, "lecture", 240, 1)
+
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{roomA, roomB},
+		Courses:        []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{session1, session2},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session1.ID, instructorID, nil),
+			models.NewInstructorAssignment(session2.ID, instructorID, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorConflict, output.Failures[0].Reason)
+}
+
+// TestInstructor_SharedSessionBlocksAllInstructors tests that a co-taught session blocks each of its instructors
+func TestInstructor_SharedSessionBlocksAllInstructors(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+	roomC := makeRoom(uuid.New(), "Room C", "lecture")
+
+	coTaught := makeCourse(uuid.New(), "Capstone")
+	soloA := makeCourse(uuid.New(), "Databases")
+	soloB := makeCourse(uuid.New(), "Networks")
+
+	coTaughtSession := makeSession(uuid.New(), coTaught.ID, "lecture", 180, 1)
+	soloASession := makeSession(uuid.New(), soloA.ID, "lecture", 60, 1)
+	soloBSession := makeSession(uuid.New(), soloB.ID, "lecture", 60, 1)
+
+	instructorA := uuid.New()
+	instructorB := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{roomA, roomB, roomC},
+		Courses:        []*models.Course{coTaught, soloA, soloB},
+		CourseSessions: []*models.CourseSession{coTaughtSession, soloASession, soloBSession},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(coTaughtSession.ID, instructorA, nil),
+			models.NewInstructorAssignment(coTaughtSession.ID, instructorB, nil),
+			models.NewInstructorAssignment(soloASession.ID, instructorA, nil),
+			models.NewInstructorAssignment(soloBSession.ID, instructorB, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+
+	byCourse := make(map[uuid.UUID]*models.ScheduledSession)
+	for _, s := range output.ScheduledSessions {
+		byCourse[s.CourseID] = s
+	}
+
+	assert.False(t, overlaps(byCourse[coTaught.ID], byCourse[soloA.ID]))
+	assert.False(t, overlaps(byCourse[coTaught.ID], byCourse[soloB.ID]))
+}
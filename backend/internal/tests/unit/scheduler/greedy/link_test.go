@@ -0,0 +1,205 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+func meetingOf(t *testing.T, output *scheduler.Output, courseSessionID uuid.UUID) *models.ScheduledSession {
+	t.Helper()
+
+	for _, s := range output.ScheduledSessions {
+		if s.CourseSessionID == courseSessionID {
+			return s
+		}
+	}
+
+	require.Failf(t, "meeting not scheduled", "course session %s", courseSessionID)
+	return nil
+}
+
+// TestLinks_Before tests that the linked session is placed after its anchor even though its heavier
+// course would otherwise be placed first
+func TestLinks_Before(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	chemistry := makeCourse(uuid.New(), "Chemistry")
+	chemistryLab := makeCourse(uuid.New(), "Chemistry Lab")
+	lecture := makeSession(uuid.New(), chemistry.ID, "lecture", 60, 1)
+	lab := makeSession(uuid.New(), chemistryLab.ID, "lecture", 120, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{chemistry, chemistryLab},
+		CourseSessions: []*models.CourseSession{lecture, lab},
+		Links: []*models.SessionLink{
+			models.NewSessionLink(uuid.New(), lecture.ID, lab.ID, models.SessionLinkBefore, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+
+	first, second := meetingOf(t, output, lecture.ID), meetingOf(t, output, lab.ID)
+	assert.Equal(t, 480, first.StartTime)
+	assert.GreaterOrEqual(t, second.StartTime, first.EndTime)
+}
+
+// TestLinks_Consecutive tests that the linked session starts right after its anchor, leaving the
+// minimum break, even though the spread rule would move it to another day
+func TestLinks_Consecutive(t *testing.T) {
+	lectureRoom := makeRoom(uuid.New(), "Lecture Hall", "lecture")
+	labRoom := makeRoom(uuid.New(), "Lab 1", "lab")
+	course := makeCourse(uuid.New(), "Biology")
+	lecture := makeSession(uuid.New(), course.ID, "lecture", 90, 1)
+	lab := makeSession(uuid.New(), course.ID, "lab", 60, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:          scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:           []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			MinBreakBetweenSessions: 10,
+			PreferredSlotDuration:   60,
+		},
+		Rooms:          []*models.Room{lectureRoom, labRoom},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{lecture, lab},
+		Links: []*models.SessionLink{
+			models.NewSessionLink(uuid.New(), lecture.ID, lab.ID, models.SessionLinkConsecutive, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+
+	first, second := meetingOf(t, output, lecture.ID), meetingOf(t, output, lab.ID)
+	assert.Equal(t, first.Day, second.Day)
+	assert.Equal(t, first.EndTime+10, second.StartTime, "Lab should start after the break, off the hourly grid")
+}
+
+// TestLinks_DifferentDay tests that the linked session avoids the days its anchor meets
+func TestLinks_DifferentDay(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+	midterm := makeCourse(uuid.New(), "Midterm")
+	review := makeCourse(uuid.New(), "Review")
+	midtermSession := makeSession(uuid.New(), midterm.ID, "lecture", 120, 1)
+	reviewSession := makeSession(uuid.New(), review.ID, "lecture", 60, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{roomA, roomB},
+		Courses:        []*models.Course{midterm, review},
+		CourseSessions: []*models.CourseSession{midtermSession, reviewSession},
+		Links: []*models.SessionLink{
+			models.NewSessionLink(uuid.New(), midtermSession.ID, reviewSession.ID, models.SessionLinkDifferentDay, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	assert.NotEqual(t, meetingOf(t, output, midtermSession.ID).Day, meetingOf(t, output, reviewSession.ID).Day)
+}
+
+// TestLinks_SameRoom tests that the linked session uses its anchor's room rather than the first room by name
+func TestLinks_SameRoom(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+	course := makeCourse(uuid.New(), "Studio Art")
+	critique := makeCourse(uuid.New(), "Critique")
+	studio := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+	critiqueSession := makeSession(uuid.New(), critique.ID, "lecture", 60, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{roomA, roomB},
+		Courses:        []*models.Course{course, critique},
+		CourseSessions: []*models.CourseSession{studio, critiqueSession},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), studio.ID, roomB.ID, int32(scheduler.Monday), 540, nil, nil),
+		},
+		Links: []*models.SessionLink{
+			models.NewSessionLink(uuid.New(), studio.ID, critiqueSession.ID, models.SessionLinkSameRoom, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	assert.Equal(t, roomB.ID, meetingOf(t, output, critiqueSession.ID).RoomID)
+}
+
+// TestLinks_NoSlot_Failure tests that a session with nowhere to go on its anchor's day fails with a link conflict
+func TestLinks_NoSlot_Failure(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	lecture := makeCourse(uuid.New(), "Physics")
+	tutorial := makeCourse(uuid.New(), "Physics Tutorial")
+	lectureSession := makeSession(uuid.New(), lecture.ID, "lecture", 240, 1)
+	tutorialSession := makeSession(uuid.New(), tutorial.ID, "lecture", 60, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{lecture, tutorial},
+		CourseSessions: []*models.CourseSession{lectureSession, tutorialSession},
+		Links: []*models.SessionLink{
+			models.NewSessionLink(uuid.New(), lectureSession.ID, tutorialSession.ID, models.SessionLinkSameDay, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+
+	failure := output.Failures[0]
+	assert.Equal(t, tutorialSession.ID, failure.CourseSession.ID)
+	assert.Equal(t, scheduler.ReasonLinkConflict, failure.Reason)
+	require.NotNil(t, failure.Diagnosis)
+	assert.Equal(t, scheduler.CodeLinkConflict, failure.Diagnosis.Code)
+}
+
+// TestLinks_Cycle_ReportsViolation tests that links which cannot all hold are reported as failures
+// instead of being broken silently
+func TestLinks_Cycle_ReportsViolation(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Debate")
+	opening := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+	closing := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{opening, closing},
+		Links: []*models.SessionLink{
+			models.NewSessionLink(uuid.New(), opening.ID, closing.ID, models.SessionLinkBefore, nil, nil),
+			models.NewSessionLink(uuid.New(), closing.ID, opening.ID, models.SessionLinkBefore, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonLinkConflict, output.Failures[0].Reason)
+}
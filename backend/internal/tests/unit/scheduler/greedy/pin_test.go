@@ -0,0 +1,260 @@
+package greedy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestPins_ReservedBeforeOtherSessions tests that a pinned meeting keeps its slot even though
+// the heavier course would otherwise have taken it
+func TestPins_ReservedBeforeOtherSessions(t *testing.T) {
+	senate := makeRoom(uuid.New(), "Senate Room", "lecture")
+	seminar := makeCourse(uuid.New(), "Dean's Seminar")
+	statistics := makeCourse(uuid.New(), "Statistics")
+	seminarSession := makeSession(uuid.New(), seminar.ID, "lecture", 60, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:   []*models.Room{senate},
+		Courses: []*models.Course{seminar, statistics},
+		CourseSessions: []*models.CourseSession{
+			seminarSession,
+			makeSession(uuid.New(), statistics.ID, "lecture", 120, 1),
+		},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), seminarSession.ID, senate.ID, int32(scheduler.Monday), 540, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 2)
+
+	for _, s := range output.ScheduledSessions {
+		if s.CourseSessionID == seminarSession.ID {
+			assert.Equal(t, 540, s.StartTime)
+			assert.Equal(t, 600, s.EndTime)
+		} else {
+			assert.Equal(t, 600, s.StartTime, "Statistics should be placed after the pinned seminar")
+		}
+	}
+}
+
+// TestPins_CountTowardsSessions tests that pinned meetings are not scheduled a second time
+func TestPins_CountTowardsSessions(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Calculus")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 3)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), session.ID, room.ID, int32(scheduler.Tuesday), 600, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+
+	days := make(map[int]bool)
+	for _, s := range output.ScheduledSessions {
+		days[s.Day] = true
+	}
+	assert.Len(t, days, 3, "Remaining meetings should still be spread around the pinned day")
+	assert.True(t, days[int(scheduler.Tuesday)])
+}
+
+// TestPins_ConsumeInstructorAvailability tests that other sessions taught by a pinned instructor avoid the pin
+func TestPins_ConsumeInstructorAvailability(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+	ethics := makeCourse(uuid.New(), "Ethics")
+	logic := makeCourse(uuid.New(), "Logic")
+	ethicsSession := makeSession(uuid.New(), ethics.ID, "lecture", 60, 1)
+	logicSession := makeSession(uuid.New(), logic.ID, "lecture", 60, 1)
+	instructorID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{roomA, roomB},
+		Courses:        []*models.Course{ethics, logic},
+		CourseSessions: []*models.CourseSession{ethicsSession, logicSession},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(ethicsSession.ID, instructorID, nil),
+			models.NewInstructorAssignment(logicSession.ID, instructorID, nil),
+		},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), ethicsSession.ID, roomA.ID, int32(scheduler.Monday), 480, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	for _, s := range output.ScheduledSessions {
+		if s.CourseSessionID == logicSession.ID {
+			assert.Equal(t, 540, s.StartTime, "The instructor is busy with the pinned session until 9:00")
+		}
+	}
+}
+
+// TestPins_HonouredOutsideOperatingHours tests that a pin is kept as given even outside operating hours
+func TestPins_HonouredOutsideOperatingHours(t *testing.T) {
+	room := makeRoom(uuid.New(), "Senate Room", "lecture")
+	course := makeCourse(uuid.New(), "Evening Lecture")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), session.ID, room.ID, int32(scheduler.Saturday), 1140, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, int(scheduler.Saturday), output.ScheduledSessions[0].Day)
+	assert.Equal(t, 1140, output.ScheduledSessions[0].StartTime)
+}
+
+// TestPins_ConflictsAreErrors tests that pins which cannot all be honoured are reported rather than dropped
+func TestPins_ConflictsAreErrors(t *testing.T) {
+	room := makeRoom(uuid.New(), "Senate Room", "lecture")
+	other := makeRoom(uuid.New(), "Room 101", "lecture")
+	seminar := makeCourse(uuid.New(), "Dean's Seminar")
+	board := makeCourse(uuid.New(), "Board Meeting")
+	seminarSession := makeSession(uuid.New(), seminar.ID, "lecture", 60, 1)
+	boardSession := makeSession(uuid.New(), board.ID, "lecture", 90, 1)
+	cohortID := uuid.New()
+
+	input := func(pins ...*models.SessionPin) *scheduler.Input {
+		return &scheduler.Input{
+			Rooms:          []*models.Room{room, other},
+			Courses:        []*models.Course{seminar, board},
+			CourseSessions: []*models.CourseSession{seminarSession, boardSession},
+			Cohorts:        []*models.Cohort{models.NewCohort(cohortID, "Faculty", []uuid.UUID{seminar.ID, board.ID}, nil, nil)},
+			Pins:           pins,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		pins   []*models.SessionPin
+		reason string
+	}{
+		{
+			name: "same room",
+			pins: []*models.SessionPin{
+				models.NewSessionPin(uuid.New(), seminarSession.ID, room.ID, 0, 540, nil, nil),
+				models.NewSessionPin(uuid.New(), boardSession.ID, room.ID, 0, 570, nil, nil),
+			},
+			reason: scheduler.ReasonPinRoomClash,
+		},
+		{
+			name: "shared cohort",
+			pins: []*models.SessionPin{
+				models.NewSessionPin(uuid.New(), seminarSession.ID, room.ID, 0, 540, nil, nil),
+				models.NewSessionPin(uuid.New(), boardSession.ID, other.ID, 0, 570, nil, nil),
+			},
+			reason: scheduler.ReasonPinCohortClash,
+		},
+		{
+			name: "too many pins",
+			pins: []*models.SessionPin{
+				models.NewSessionPin(uuid.New(), seminarSession.ID, room.ID, 0, 540, nil, nil),
+				models.NewSessionPin(uuid.New(), seminarSession.ID, room.ID, 1, 540, nil, nil),
+			},
+			reason: scheduler.ReasonPinTooMany,
+		},
+		{
+			name: "unknown room",
+			pins: []*models.SessionPin{
+				models.NewSessionPin(uuid.New(), seminarSession.ID, uuid.New(), 0, 540, nil, nil),
+			},
+			reason: scheduler.ReasonPinUnknownRoom,
+		},
+		{
+			name: "day out of range",
+			pins: []*models.SessionPin{
+				models.NewSessionPin(uuid.New(), seminarSession.ID, room.ID, 7, 540, nil, nil),
+			},
+			reason: scheduler.ReasonPinBadDay,
+		},
+		{
+			name: "negative start time",
+			pins: []*models.SessionPin{
+				models.NewSessionPin(uuid.New(), seminarSession.ID, room.ID, 0, -30, nil, nil),
+			},
+			reason: scheduler.ReasonPinBadStart,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+			output, err := sched.Generate(context.Background(), input(tt.pins...))
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			var conflictErr *scheduler.PinConflictError
+			require.True(t, errors.As(err, &conflictErr))
+			require.Len(t, conflictErr.Conflicts, 1)
+			assert.Equal(t, tt.reason, conflictErr.Conflicts[0].Reason)
+			assert.Equal(t, tt.pins[len(tt.pins)-1].ID, conflictErr.Conflicts[0].Pin.ID)
+		})
+	}
+}
+
+// TestPins_BackToBackAllowed tests that pins may sit back to back in the same room
+func TestPins_BackToBackAllowed(t *testing.T) {
+	room := makeRoom(uuid.New(), "Senate Room", "lecture")
+	course := makeCourse(uuid.New(), "Seminar")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 2)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:          scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:           []scheduler.Day{scheduler.Monday},
+			MinBreakBetweenSessions: 15,
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), session.ID, room.ID, 0, 540, nil, nil),
+			models.NewSessionPin(uuid.New(), session.ID, room.ID, 0, 600, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 2)
+}
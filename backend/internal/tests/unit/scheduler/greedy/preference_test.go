@@ -0,0 +1,145 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestPreference_UnavailableWindowRespected tests that sessions are never placed while an instructor is unavailable
+func TestPreference_UnavailableWindowRespected(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room A", "lecture")
+	course := makeCourse(uuid.New(), "Algorithms")
+	session := makeSession(uuid.New(), course.ID, "lecture", 120, 1)
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, int32(scheduler.Monday), 480, 780, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, 780, output.ScheduledSessions[0].StartTime, "Session should start once the instructor is available")
+	assert.Zero(t, output.PreferenceViolations)
+}
+
+// TestPreference_UnavailableAllDayFails tests that an instructor unavailable all week causes an instructor failure
+func TestPreference_UnavailableAllDayFails(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room A", "lecture")
+	course := makeCourse(uuid.New(), "Algorithms")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, int32(scheduler.Monday), 0, 1440, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorConflict, output.Failures[0].Reason)
+}
+
+// TestPreference_PreferredWindowChosen tests that a session lands inside the instructor's preferred window when possible
+func TestPreference_PreferredWindowChosen(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room A", "lecture")
+	course := makeCourse(uuid.New(), "Algorithms")
+	session := makeSession(uuid.New(), course.ID, "lecture", 120, 1)
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityPreferred, int32(scheduler.Tuesday), 840, 960, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, int(scheduler.Tuesday), output.ScheduledSessions[0].Day)
+	assert.Equal(t, 840, output.ScheduledSessions[0].StartTime)
+	assert.Zero(t, output.PreferenceViolations)
+}
+
+// TestPreference_ViolationCounted tests that a session placed outside the preferred windows is reported
+func TestPreference_ViolationCounted(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room A", "lecture")
+	course := makeCourse(uuid.New(), "Algorithms")
+	session := makeSession(uuid.New(), course.ID, "lecture", 120, 1)
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session.ID, instructorID, nil),
+		},
+		InstructorAvailability: []*models.InstructorAvailability{
+			// Too short for a two-hour session, so the preference cannot be honoured
+			models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityPreferred, int32(scheduler.Monday), 480, 540, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Empty(t, output.Failures)
+	assert.Equal(t, 1, output.PreferenceViolations)
+}
@@ -0,0 +1,127 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// mondayConfig opens Monday only, so every session competes for the same day
+func mondayConfig(selection scheduler.RoomSelection) *scheduler.Config {
+	return &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+		RoomSelection:  selection,
+	}
+}
+
+// TestRoomSelection_FirstFit tests that first fit takes the first listed room, while the default
+// takes the room with the fewest spare seats
+func TestRoomSelection_FirstFit(t *testing.T) {
+	big := makeRoomWithCapacity(uuid.New(), "Auditorium", "lecture", 100)
+	small := makeRoomWithCapacity(uuid.New(), "Seminar Room", "lecture", 40)
+	course := makeCourse(uuid.New(), "Ethics")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+
+	tests := []struct {
+		selection scheduler.RoomSelection
+		room      uuid.UUID
+	}{
+		{"", small.ID},
+		{scheduler.RoomSelectionBestFit, small.ID},
+		{scheduler.RoomSelectionFirstFit, big.ID},
+	}
+
+	for _, tt := range tests {
+		output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+			Config:         mondayConfig(tt.selection),
+			Rooms:          []*models.Room{big, small},
+			Courses:        []*models.Course{course},
+			CourseSessions: []*models.CourseSession{session},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.ScheduledSessions, 1)
+		assert.Equal(t, tt.room, output.ScheduledSessions[0].RoomID, "Policy %q", tt.selection)
+	}
+}
+
+// TestRoomSelection_LeastUtilised tests that bookings are spread over equal rooms rather than
+// filling the first one
+func TestRoomSelection_LeastUtilised(t *testing.T) {
+	roomA := makeRoom(uuid.New(), "Room A", "lecture")
+	roomB := makeRoom(uuid.New(), "Room B", "lecture")
+	first := makeCourse(uuid.New(), "Algebra")
+	second := makeCourse(uuid.New(), "Geometry")
+
+	input := &scheduler.Input{
+		Rooms:   []*models.Room{roomA, roomB},
+		Courses: []*models.Course{first, second},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), first.ID, "lecture", 90, 1),
+			makeSession(uuid.New(), second.ID, "lecture", 60, 1),
+		},
+	}
+
+	input.Config = mondayConfig(scheduler.RoomSelectionBestFit)
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Equal(t, roomA.ID, output.ScheduledSessions[1].RoomID, "Best fit should keep filling Room A")
+
+	input.Config = mondayConfig(scheduler.RoomSelectionLeastUtilised)
+	output, err = greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Equal(t, roomA.ID, output.ScheduledSessions[0].RoomID)
+	assert.Equal(t, roomB.ID, output.ScheduledSessions[1].RoomID, "The unused room should be taken next")
+	assert.Equal(t, 480, output.ScheduledSessions[1].StartTime)
+}
+
+// TestRoomSelection_SameBuilding tests that a course's later sessions favour the building it already meets in
+func TestRoomSelection_SameBuilding(t *testing.T) {
+	lab := makeRoom(uuid.New(), "Science Lab", "lab")
+	hallA := makeRoom(uuid.New(), "Hall A", "lecture")
+	hallB := makeRoom(uuid.New(), "Hall B", "lecture")
+	hallB.Building = lab.Building
+
+	course := makeCourse(uuid.New(), "Chemistry")
+	labSession := makeSession(uuid.New(), course.ID, "lab", 120, 1)
+	lecture := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+
+	// A course spreads its meetings over the week, so the lecture needs a second day
+	input := &scheduler.Input{
+		Rooms:          []*models.Room{lab, hallA, hallB},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{labSession, lecture},
+	}
+
+	input.Config = mondayConfig("")
+	input.Config.OperatingDays = append(input.Config.OperatingDays, scheduler.Tuesday)
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, hallA.ID, meetingOf(t, output, lecture.ID).RoomID)
+
+	input.Config = mondayConfig(scheduler.RoomSelectionSameBuilding)
+	input.Config.OperatingDays = append(input.Config.OperatingDays, scheduler.Tuesday)
+	output, err = greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, hallB.ID, meetingOf(t, output, lecture.ID).RoomID, "The lecture should follow the lab into its building")
+}
+
+// TestRoomSelection_Unknown tests that an unknown policy is rejected
+func TestRoomSelection_Unknown(t *testing.T) {
+	_, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+		Config: mondayConfig("random"),
+	})
+
+	require.ErrorIs(t, err, scheduler.ErrUnknownRoomSelection)
+}
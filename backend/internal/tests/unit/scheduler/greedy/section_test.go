@@ -0,0 +1,92 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestSections_ScheduledIndependently tests that each section gets every meeting of the course and
+// spreads them over the week on its own, rather than sharing the course's days
+func TestSections_ScheduledIndependently(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Calculus")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 2)
+	sectionA := models.NewCourseSection(uuid.New(), course.ID, "Section A", nil, nil, nil, nil)
+	sectionB := models.NewCourseSection(uuid.New(), course.ID, "Section B", nil, nil, nil, nil)
+
+	input, sections, err := scheduler.ExpandSections(&scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+	}, []*models.CourseSection{sectionA, sectionB})
+	require.NoError(t, err)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), input)
+	require.NoError(t, err)
+	sections.Restore(output)
+
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 4, "Each section should meet twice")
+
+	days := make(map[uuid.UUID]map[int]bool)
+	for _, ss := range output.ScheduledSessions {
+		assert.Equal(t, session.ID, ss.CourseSessionID, "Meetings should refer to the stored session")
+		if days[ss.SectionID] == nil {
+			days[ss.SectionID] = make(map[int]bool)
+		}
+		days[ss.SectionID][ss.Day] = true
+	}
+
+	assert.Len(t, days[sectionA.ID], 2, "Section A should meet on both days")
+	assert.Len(t, days[sectionB.ID], 2, "Section B should meet on both days")
+}
+
+// TestSections_PinFixesFirstSection tests that a pin on a sectioned session fixes its first section only
+func TestSections_PinFixesFirstSection(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Calculus")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+	sectionA := models.NewCourseSection(uuid.New(), course.ID, "Section A", nil, nil, nil, nil)
+	sectionB := models.NewCourseSection(uuid.New(), course.ID, "Section B", nil, nil, nil, nil)
+
+	input, sections, err := scheduler.ExpandSections(&scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+		Pins: []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), session.ID, room.ID, int32(scheduler.Wednesday), 600, nil, nil),
+		},
+	}, []*models.CourseSection{sectionA, sectionB})
+	require.NoError(t, err)
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), input)
+	require.NoError(t, err)
+	sections.Restore(output)
+
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 2)
+
+	for _, ss := range output.ScheduledSessions {
+		if ss.SectionID == sectionA.ID {
+			assert.Equal(t, int(scheduler.Wednesday), ss.Day)
+			assert.Equal(t, 600, ss.StartTime)
+		} else {
+			assert.Equal(t, sectionB.ID, ss.SectionID)
+		}
+	}
+}
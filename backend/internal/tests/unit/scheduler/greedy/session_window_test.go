@@ -0,0 +1,151 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestSessionWindows_Allowed tests that an evening session only meets inside its allowed window
+// even though earlier slots are free
+func TestSessionWindows_Allowed(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Evening Accounting")
+	session := makeSession(uuid.New(), course.ID, "lecture", 90, 2)
+	session.AllowedWindows = []models.SessionWindow{{StartTime: 1020, EndTime: 1260}}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 2)
+	for _, ss := range output.ScheduledSessions {
+		assert.GreaterOrEqual(t, ss.StartTime, 1020, "Session should start after 17:00")
+	}
+}
+
+// TestSessionWindows_AllowedOnDay tests that a window for one day keeps the session to that day
+func TestSessionWindows_AllowedOnDay(t *testing.T) {
+	room := makeRoom(uuid.New(), "Lab 1", "lab")
+	course := makeCourse(uuid.New(), "Microbiology")
+	session := makeSession(uuid.New(), course.ID, "lab", 120, 1)
+	session.AllowedWindows = []models.SessionWindow{{Day: ptr(int32(scheduler.Thursday)), StartTime: 480, EndTime: 720}}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, int(scheduler.Thursday), output.ScheduledSessions[0].Day)
+	assert.LessOrEqual(t, output.ScheduledSessions[0].EndTime, 720)
+}
+
+// TestSessionWindows_Preferred tests that a preferred window is used when free, and counted when it is not
+func TestSessionWindows_Preferred(t *testing.T) {
+	room := makeRoom(uuid.New(), "Lab 1", "lab")
+	first := makeCourse(uuid.New(), "Organic Chemistry")
+	second := makeCourse(uuid.New(), "Physical Chemistry")
+	firstLab := makeSession(uuid.New(), first.ID, "lab", 120, 1)
+	secondLab := makeSession(uuid.New(), second.ID, "lab", 60, 1)
+	firstLab.PreferredWindows = []models.SessionWindow{{StartTime: 600, EndTime: 720}}
+	secondLab.PreferredWindows = []models.SessionWindow{{StartTime: 600, EndTime: 720}}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{first, second},
+		CourseSessions: []*models.CourseSession{firstLab, secondLab},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	assert.Equal(t, 600, meetingOf(t, output, firstLab.ID).StartTime, "Heavier lab should take the preferred window over the earliest slot")
+	assert.Equal(t, 480, meetingOf(t, output, secondLab.ID).StartTime, "Lighter lab should fall back to the earliest slot")
+	assert.Equal(t, 1, output.PreferenceViolations)
+}
+
+// TestSessionWindows_Weights tests that a heavier preferred window is tried before a lighter one
+// that comes earlier in the day, and that falling back to the lighter window counts the difference
+func TestSessionWindows_Weights(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	seminar := makeCourse(uuid.New(), "Research Seminar")
+	meeting := makeCourse(uuid.New(), "Faculty Meeting")
+	seminarSession := makeSession(uuid.New(), seminar.ID, "lecture", 60, 1)
+	seminarSession.PreferredWindows = []models.SessionWindow{
+		{StartTime: 480, EndTime: 600, Weight: ptr(int32(1))},
+		{StartTime: 720, EndTime: 840, Weight: ptr(int32(3))},
+	}
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	t.Run("heavier window wins", func(t *testing.T) {
+		output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+			Config:         config,
+			Rooms:          []*models.Room{room},
+			Courses:        []*models.Course{seminar},
+			CourseSessions: []*models.CourseSession{seminarSession},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 720, meetingOf(t, output, seminarSession.ID).StartTime)
+		assert.Zero(t, output.PreferenceViolations)
+	})
+
+	t.Run("lighter window when the heavier is taken", func(t *testing.T) {
+		meetingSession := makeSession(uuid.New(), meeting.ID, "lecture", 120, 1)
+		output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+			Config:         config,
+			Rooms:          []*models.Room{room},
+			Courses:        []*models.Course{seminar, meeting},
+			CourseSessions: []*models.CourseSession{seminarSession, meetingSession},
+			Pins:           []*models.SessionPin{models.NewSessionPin(uuid.New(), meetingSession.ID, room.ID, 0, 720, nil, nil)},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 480, meetingOf(t, output, seminarSession.ID).StartTime)
+		assert.Equal(t, 2, output.PreferenceViolations, "The lighter window misses the heavier by 3 - 1")
+	})
+}
+
+// TestDiagnosis_AllowedWindows tests that a session whose allowed windows are taken fails with the allowed_windows code
+func TestDiagnosis_AllowedWindows(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Night School")
+	session := makeSession(uuid.New(), course.ID, "lecture", 60, 1)
+	session.AllowedWindows = []models.SessionWindow{{StartTime: 1260, EndTime: 1380}}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{session},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonAllowedWindows, output.Failures[0].Reason)
+	assert.Equal(t, scheduler.CodeAllowedWindows, output.Failures[0].Diagnosis.Code)
+}
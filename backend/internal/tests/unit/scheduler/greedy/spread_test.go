@@ -0,0 +1,118 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// generateSpread schedules one course session in a single room under the given config
+func generateSpread(t *testing.T, config *scheduler.Config, session *models.CourseSession) *scheduler.Output {
+	t.Helper()
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(session.CourseID, "Statistics")},
+		CourseSessions: []*models.CourseSession{session},
+	})
+	require.NoError(t, err)
+
+	return output
+}
+
+// meetingDays returns the days of the scheduled meetings in the order they were placed
+func meetingDays(output *scheduler.Output) []int {
+	days := make([]int, 0, len(output.ScheduledSessions))
+	for _, ss := range output.ScheduledSessions {
+		days = append(days, ss.Day)
+	}
+	return days
+}
+
+// TestSpread_SixDayWeek tests that meetings spread over every operating day of a six-day week,
+// even when one day has far more free time than the others
+func TestSpread_SixDayWeek(t *testing.T) {
+	session := makeSession(uuid.New(), uuid.New(), "lecture", 60, 6)
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+		OperatingDays: []scheduler.Day{
+			scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday,
+			scheduler.Thursday, scheduler.Friday, scheduler.Saturday,
+		},
+		DayHours: map[scheduler.Day][]scheduler.TimeRange{scheduler.Monday: {{Start: 480, End: 1260}}},
+	}
+
+	output := generateSpread(t, config, session)
+
+	assert.Empty(t, output.Failures)
+	assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5}, meetingDays(output), "One meeting each day")
+}
+
+// TestSpread_MaxPerDay tests that meetings beyond the per-day cap fail with the spread rule code
+func TestSpread_MaxPerDay(t *testing.T) {
+	session := makeSession(uuid.New(), uuid.New(), "lecture", 60, 3)
+	config := mondayConfig("")
+
+	output := generateSpread(t, config, session)
+	assert.Len(t, output.ScheduledSessions, 3, "Without a cap every meeting fits on the only day")
+
+	config.MaxSessionsPerDay = 2
+	output = generateSpread(t, config, session)
+
+	assert.Len(t, output.ScheduledSessions, 2)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.CodeSpreadRule, output.Failures[0].Diagnosis.Code)
+}
+
+// TestSpread_MinDaysBetween tests that meetings are kept the minimum number of days apart
+func TestSpread_MinDaysBetween(t *testing.T) {
+	session := makeSession(uuid.New(), uuid.New(), "lecture", 60, 3)
+	config := scheduler.DefaultConfig()
+	config.MinDaysBetweenSessions = 2
+
+	output := generateSpread(t, config, session)
+
+	assert.Empty(t, output.Failures)
+	assert.ElementsMatch(t, []int{0, 2, 4}, meetingDays(output))
+}
+
+// TestSpread_DayPattern tests that a session takes the first pattern with a day for each meeting
+func TestSpread_DayPattern(t *testing.T) {
+	session := makeSession(uuid.New(), uuid.New(), "lecture", 60, 2)
+	config := scheduler.DefaultConfig()
+	config.DayPatterns = [][]scheduler.Day{
+		{scheduler.Monday, scheduler.Wednesday, scheduler.Friday},
+		{scheduler.Tuesday, scheduler.Thursday},
+	}
+
+	output := generateSpread(t, config, session)
+
+	assert.Empty(t, output.Failures)
+	assert.ElementsMatch(t, []int{1, 3}, meetingDays(output), "Tuesday and Thursday")
+}
+
+// TestSpread_SessionOverride tests that a session's own rules replace the config's
+func TestSpread_SessionOverride(t *testing.T) {
+	session := makeSession(uuid.New(), uuid.New(), "lecture", 60, 2)
+	session.Spread = &models.SpreadRules{
+		MinDaysBetween: ptr(int32(0)),
+		DayPatterns:    [][]int32{{0, 4}},
+	}
+	config := scheduler.DefaultConfig()
+	config.MinDaysBetweenSessions = 5
+	config.DayPatterns = [][]scheduler.Day{{scheduler.Tuesday, scheduler.Thursday}}
+
+	output := generateSpread(t, config, session)
+
+	assert.Empty(t, output.Failures, "Mondays and Fridays are only four days apart")
+	assert.ElementsMatch(t, []int{0, 4}, meetingDays(output))
+}
@@ -0,0 +1,52 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestWeightStrategy_DecidesWhoGetsTheLastSlot tests that the weight strategy chooses which of two
+// courses competing for a single slot is placed
+func TestWeightStrategy_DecidesWhoGetsTheLastSlot(t *testing.T) {
+	room := makeRoom(uuid.New(), "Room 101", "lecture")
+	long := makeCourse(uuid.New(), "Long Course")
+	urgent := makeCourse(uuid.New(), "Urgent Course")
+	urgent.Priority = 10
+	longSession := makeSession(uuid.New(), long.ID, "lecture", 120, 1)
+	urgentSession := makeSession(uuid.New(), urgent.ID, "lecture", 60, 1)
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{long, urgent},
+		CourseSessions: []*models.CourseSession{longSession, urgentSession},
+	}
+
+	tests := []struct {
+		strategy weight.WeightStrategyInterface
+		placed   uuid.UUID
+	}{
+		{&weight.TotalTimeWeight{}, longSession.ID},
+		{&weight.PriorityWeight{}, urgentSession.ID},
+	}
+
+	for _, tt := range tests {
+		output, err := greedy.NewGreedyScheduler(tt.strategy).Generate(context.Background(), input)
+
+		require.NoError(t, err)
+		require.Len(t, output.ScheduledSessions, 1)
+		assert.Equal(t, tt.placed, output.ScheduledSessions[0].CourseSessionID)
+	}
+}
@@ -0,0 +1,126 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// oneHourMonday opens a single hour on Monday, so each room takes one one-hour session
+func oneHourMonday() *scheduler.Config {
+	return &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 540},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+}
+
+// TestSubstitutes_FallBack tests that a session moves to a substitute room type only once every
+// room of its required type is taken, and that the substituted placement is flagged
+func TestSubstitutes_FallBack(t *testing.T) {
+	lectureRoom := makeRoom(uuid.New(), "Room 101", "lecture_room")
+	hall := makeRoom(uuid.New(), "Great Hall", "lecture_hall")
+	first, second := makeCourse(uuid.New(), "Algebra"), makeCourse(uuid.New(), "Geometry")
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+		Config:    oneHourMonday(),
+		Rooms:     []*models.Room{hall, lectureRoom},
+		RoomTypes: []*models.RoomType{models.NewRoomType("lecture_room", []string{"lecture_hall"}, nil, nil)},
+		Courses:   []*models.Course{first, second},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), first.ID, "lecture_room", 60, 1),
+			makeSession(uuid.New(), second.ID, "lecture_room", 60, 1),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 2)
+
+	assert.Equal(t, lectureRoom.ID, output.ScheduledSessions[0].RoomID, "The required type is tried first")
+	assert.False(t, output.ScheduledSessions[0].Substituted)
+	assert.Equal(t, hall.ID, output.ScheduledSessions[1].RoomID)
+	assert.True(t, output.ScheduledSessions[1].Substituted)
+}
+
+// TestSubstitutes_Chain tests that substitutes of substitutes are tried in order of preference
+func TestSubstitutes_Chain(t *testing.T) {
+	lectureRoom := makeRoom(uuid.New(), "Room 101", "lecture_room")
+	hall := makeRoom(uuid.New(), "Great Hall", "lecture_hall")
+	courses := []*models.Course{makeCourse(uuid.New(), "Ethics"), makeCourse(uuid.New(), "Logic"), makeCourse(uuid.New(), "Rhetoric")}
+
+	input := &scheduler.Input{
+		Config: oneHourMonday(),
+		Rooms:  []*models.Room{hall, lectureRoom},
+		RoomTypes: []*models.RoomType{
+			models.NewRoomType("seminar_room", []string{"lecture_room"}, nil, nil),
+			models.NewRoomType("lecture_room", []string{"lecture_hall"}, nil, nil),
+		},
+		Courses: courses,
+	}
+	for _, course := range courses {
+		input.CourseSessions = append(input.CourseSessions, makeSession(uuid.New(), course.ID, "seminar_room", 60, 1))
+	}
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Equal(t, lectureRoom.ID, output.ScheduledSessions[0].RoomID)
+	assert.Equal(t, hall.ID, output.ScheduledSessions[1].RoomID)
+	for _, ss := range output.ScheduledSessions {
+		assert.True(t, ss.Substituted)
+	}
+
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.CodeSlotsConsumed, output.Failures[0].Diagnosis.Code, "Every room along the chain is taken")
+}
+
+// TestSubstitutes_NotDeclared tests that without substitutes only the required type is used
+func TestSubstitutes_NotDeclared(t *testing.T) {
+	hall := makeRoom(uuid.New(), "Great Hall", "lecture_hall")
+	course := makeCourse(uuid.New(), "Algebra")
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+		Config:         oneHourMonday(),
+		Rooms:          []*models.Room{hall},
+		RoomTypes:      []*models.RoomType{models.NewRoomType("lecture_hall", []string{"lecture_room"}, nil, nil)},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture_room", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions, "Substitutes only go one way")
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.CodeNoRoomsOfType, output.Failures[0].Diagnosis.Code)
+}
+
+// TestSubstitutes_Diagnosis tests that rooms of substitute types are counted apart from rooms of
+// the required type when a session cannot be placed
+func TestSubstitutes_Diagnosis(t *testing.T) {
+	hall := makeRoomWithCapacity(uuid.New(), "Great Hall", "lecture_hall", 20)
+	course := models.NewCourse(uuid.New(), "Algebra", 50, 0, nil, nil)
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), &scheduler.Input{
+		Config:         oneHourMonday(),
+		Rooms:          []*models.Room{hall},
+		RoomTypes:      []*models.RoomType{models.NewRoomType("lecture_room", []string{"lecture_hall"}, nil, nil)},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture_room", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Failures, 1)
+	d := output.Failures[0].Diagnosis
+	assert.Equal(t, scheduler.CodeInsufficientCapacity, d.Code, "The substitute exists but is too small")
+	assert.Zero(t, d.RoomsOfType)
+	assert.Equal(t, 1, d.SubstituteRooms)
+	assert.Zero(t, d.SuitableRooms)
+}
@@ -0,0 +1,174 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// gapBetween returns the minutes between the end of the earlier session and the start of the later one
+func gapBetween(a, b *models.ScheduledSession) int {
+	if a.StartTime > b.StartTime {
+		a, b = b, a
+	}
+	return b.StartTime - a.EndTime
+}
+
+// TestTravel_InstructorGetsTimeToMove tests that an instructor teaching in two buildings has time to travel between them
+func TestTravel_InstructorGetsTimeToMove(t *testing.T) {
+	scienceBlock, library := uuid.New(), uuid.New()
+	lectureRoom := models.NewRoom(uuid.New(), "Science 101", "lecture", scienceBlock, 30, nil, nil)
+	lab := models.NewRoom(uuid.New(), "Library Lab", "lab", library, 30, nil, nil)
+
+	course1 := makeCourse(uuid.New(), "Physics")
+	course2 := makeCourse(uuid.New(), "Physics Lab")
+	session1 := makeSession(uuid.New(), course1.ID, "lecture", 60, 1)
+	session2 := makeSession(uuid.New(), course2.ID, "lab", 60, 1)
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{lectureRoom, lab},
+		Courses:        []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{session1, session2},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session1.ID, instructorID, nil),
+			models.NewInstructorAssignment(session2.ID, instructorID, nil),
+		},
+		TravelTimes: []*models.BuildingTravelTime{
+			models.NewBuildingTravelTime(scienceBlock, library, 15, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.GreaterOrEqual(t, gapBetween(output.ScheduledSessions[0], output.ScheduledSessions[1]), 15,
+		"Instructor needs 15 minutes to walk between buildings")
+}
+
+// TestTravel_CohortGetsTimeToMove tests that students in a cohort have time to travel between buildings
+func TestTravel_CohortGetsTimeToMove(t *testing.T) {
+	scienceBlock, library := uuid.New(), uuid.New()
+	lectureRoom := models.NewRoom(uuid.New(), "Science 101", "lecture", scienceBlock, 30, nil, nil)
+	lab := models.NewRoom(uuid.New(), "Library Lab", "lab", library, 30, nil, nil)
+
+	course1 := makeCourse(uuid.New(), "Chemistry")
+	course2 := makeCourse(uuid.New(), "Chemistry Lab")
+	cohort := models.NewCohort(uuid.New(), "Year 1 Chemistry", []uuid.UUID{course1.ID, course2.ID}, nil, nil)
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:  config,
+		Rooms:   []*models.Room{lectureRoom, lab},
+		Courses: []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1.ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2.ID, "lab", 60, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+		// Only the reverse trip is recorded; it applies both ways
+		TravelTimes: []*models.BuildingTravelTime{
+			models.NewBuildingTravelTime(library, scienceBlock, 20, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.GreaterOrEqual(t, gapBetween(output.ScheduledSessions[0], output.ScheduledSessions[1]), 20,
+		"Cohort needs 20 minutes to walk between buildings")
+}
+
+// TestTravel_SameBuildingNoGap tests that sessions in the same building can run back to back
+func TestTravel_SameBuildingNoGap(t *testing.T) {
+	scienceBlock, library := uuid.New(), uuid.New()
+	lectureRoom := models.NewRoom(uuid.New(), "Science 101", "lecture", scienceBlock, 30, nil, nil)
+	lab := models.NewRoom(uuid.New(), "Science Lab", "lab", scienceBlock, 30, nil, nil)
+
+	course1 := makeCourse(uuid.New(), "Physics")
+	course2 := makeCourse(uuid.New(), "Physics Lab")
+	session1 := makeSession(uuid.New(), course1.ID, "lecture", 60, 1)
+	session2 := makeSession(uuid.New(), course2.ID, "lab", 60, 1)
+	instructorID := uuid.New()
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{lectureRoom, lab},
+		Courses:        []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{session1, session2},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session1.ID, instructorID, nil),
+			models.NewInstructorAssignment(session2.ID, instructorID, nil),
+		},
+		TravelTimes: []*models.BuildingTravelTime{
+			models.NewBuildingTravelTime(scienceBlock, library, 15, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Equal(t, 0, gapBetween(output.ScheduledSessions[0], output.ScheduledSessions[1]))
+}
+
+// TestTravel_NoTimeToTravelFails tests that a session is not placed when there is no room in the day to travel
+func TestTravel_NoTimeToTravelFails(t *testing.T) {
+	scienceBlock, library := uuid.New(), uuid.New()
+	lectureRoom := models.NewRoom(uuid.New(), "Science 101", "lecture", scienceBlock, 30, nil, nil)
+	lab := models.NewRoom(uuid.New(), "Library Lab", "lab", library, 30, nil, nil)
+
+	course1 := makeCourse(uuid.New(), "Physics")
+	course2 := makeCourse(uuid.New(), "Physics Lab")
+	session1 := makeSession(uuid.New(), course1.ID, "lecture", 60, 1)
+	session2 := makeSession(uuid.New(), course2.ID, "lab", 60, 1)
+	instructorID := uuid.New()
+
+	// Exactly two hours: enough for both sessions back to back but not for the walk
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{lectureRoom, lab},
+		Courses:        []*models.Course{course1, course2},
+		CourseSessions: []*models.CourseSession{session1, session2},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(session1.ID, instructorID, nil),
+			models.NewInstructorAssignment(session2.ID, instructorID, nil),
+		},
+		TravelTimes: []*models.BuildingTravelTime{
+			models.NewBuildingTravelTime(scienceBlock, library, 15, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorConflict, output.Failures[0].Reason)
+}
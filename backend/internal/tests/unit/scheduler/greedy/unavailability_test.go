@@ -0,0 +1,94 @@
+package greedy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// TestUnavailability_SkipsBlackoutWindow tests that sessions are never placed inside a room blackout
+func TestUnavailability_SkipsBlackoutWindow(t *testing.T) {
+	room := makeRoom(uuid.New(), "Lab 2", "lab")
+	course := makeCourse(uuid.New(), "Chemistry")
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lab", 120, 1)},
+		RoomUnavailability: []*models.RoomUnavailability{
+			models.NewRoomUnavailability(uuid.New(), room.ID, int32(scheduler.Monday), 480, 720, ptr("Cleaning"), nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, 720, output.ScheduledSessions[0].StartTime, "Session should start once the blackout ends")
+}
+
+// TestUnavailability_WholeDayBlocked tests that a day fully blacked out is skipped
+func TestUnavailability_WholeDayBlocked(t *testing.T) {
+	room := makeRoom(uuid.New(), "Chapel", "lecture")
+	course := makeCourse(uuid.New(), "Theology")
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+		OperatingDays:  []scheduler.Day{scheduler.Thursday, scheduler.Friday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture", 60, 1)},
+		RoomUnavailability: []*models.RoomUnavailability{
+			models.NewRoomUnavailability(uuid.New(), room.ID, int32(scheduler.Friday), 0, 1440, nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, int(scheduler.Thursday), output.ScheduledSessions[0].Day)
+}
+
+// TestUnavailability_OnlyAffectsItsRoom tests that a blackout does not block other rooms
+func TestUnavailability_OnlyAffectsItsRoom(t *testing.T) {
+	closed := makeRoom(uuid.New(), "Chapel", "lecture")
+	open := makeRoom(uuid.New(), "Room 101", "lecture")
+	course := makeCourse(uuid.New(), "Philosophy")
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          []*models.Room{closed, open},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), course.ID, "lecture", 120, 1)},
+		RoomUnavailability: []*models.RoomUnavailability{
+			models.NewRoomUnavailability(uuid.New(), closed.ID, int32(scheduler.Monday), 480, 600, nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, open.ID, output.ScheduledSessions[0].RoomID)
+}
@@ -0,0 +1,111 @@
+package weight_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+func TestSessionsPerWeekWeight_Calculate(t *testing.T) {
+	w := &weight.SessionsPerWeekWeight{}
+	courseID := uuid.New()
+
+	sessions := []*models.CourseSession{
+		makeSession(courseID, 60, 2),
+		makeSession(courseID, 180, 1),
+	}
+
+	assert.Equal(t, 3, w.Calculate(nil, sessions, nil))
+}
+
+func TestPriorityWeight_Calculate(t *testing.T) {
+	w := &weight.PriorityWeight{}
+	course := models.NewCourse(uuid.New(), "Capstone", 0, 7, nil, nil)
+
+	assert.Equal(t, 7, w.Calculate(course, []*models.CourseSession{makeSession(course.ID, 60, 2)}, nil))
+	assert.Equal(t, 0, w.Calculate(nil, nil, nil))
+}
+
+func TestScarcityWeight_Calculate(t *testing.T) {
+	w := &weight.ScarcityWeight{}
+	courseID := uuid.New()
+
+	lab := models.NewRoom(uuid.New(), "Lab 1", "lab", uuid.New(), 30, nil, nil)
+	hallA := models.NewRoom(uuid.New(), "Hall A", "lecture", uuid.New(), 100, nil, nil)
+	hallB := models.NewRoom(uuid.New(), "Hall B", "lecture", uuid.New(), 100, nil, nil)
+
+	// One day of 10 hours: the lab is open 600 minutes, less a 2 hour blackout; the halls 1200
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1080},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{lab, hallA, hallB},
+		RoomUnavailability: []*models.RoomUnavailability{
+			models.NewRoomUnavailability(uuid.New(), lab.ID, int32(scheduler.Monday), 420, 600, nil, nil, nil),
+		},
+	}
+
+	labSession := models.NewCourseSession(uuid.New(), courseID, "lab", "lab", ptr(int32(120)), ptr(int32(1)), nil, nil, nil, nil, nil, nil)
+	lecture := models.NewCourseSession(uuid.New(), courseID, "lecture", "lecture", ptr(int32(120)), ptr(int32(2)), nil, nil, nil, nil, nil, nil)
+	noRooms := models.NewCourseSession(uuid.New(), courseID, "studio", "studio", ptr(int32(60)), ptr(int32(1)), nil, nil, nil, nil, nil, nil)
+
+	assert.Equal(t, 250_000, w.Calculate(nil, []*models.CourseSession{labSession}, input), "120 of 480 lab minutes")
+	assert.Equal(t, 200_000, w.Calculate(nil, []*models.CourseSession{lecture}, input), "240 of 1200 lecture minutes")
+	assert.Equal(t, 450_000, w.Calculate(nil, []*models.CourseSession{labSession, lecture, noRooms}, input), "Sessions without rooms add nothing")
+}
+
+func TestScarcityWeight_OverlappingBlackouts(t *testing.T) {
+	w := &weight.ScarcityWeight{}
+	lab := models.NewRoom(uuid.New(), "Lab 1", "lab", uuid.New(), 30, nil, nil)
+	session := models.NewCourseSession(uuid.New(), uuid.New(), "lab", "lab", ptr(int32(60)), ptr(int32(1)), nil, nil, nil, nil, nil, nil)
+
+	// 600 open minutes less 09:00-11:00 and 10:00-12:00, which overlap to close 180 minutes, not 240
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1080},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{lab},
+		RoomUnavailability: []*models.RoomUnavailability{
+			models.NewRoomUnavailability(uuid.New(), lab.ID, int32(scheduler.Monday), 540, 660, nil, nil, nil),
+			models.NewRoomUnavailability(uuid.New(), lab.ID, int32(scheduler.Monday), 600, 720, nil, nil, nil),
+		},
+	}
+
+	assert.Equal(t, 142_857, w.Calculate(nil, []*models.CourseSession{session}, input), "60 of 420 lab minutes")
+
+	// A different input is worked out afresh rather than reusing the first one's open minutes
+	other := &scheduler.Input{Config: input.Config, Rooms: input.Rooms}
+	assert.Equal(t, 100_000, w.Calculate(nil, []*models.CourseSession{session}, other), "60 of 600 lab minutes")
+}
+
+func TestRegistry_Get(t *testing.T) {
+	registry := weight.DefaultRegistry()
+
+	strategy, ok := registry.Get("priority")
+	require.True(t, ok)
+	assert.IsType(t, &weight.PriorityWeight{}, strategy)
+
+	_, ok = registry.Get("alphabetical")
+	assert.False(t, ok)
+}
+
+func TestRegistry_List(t *testing.T) {
+	registry := weight.NewRegistry(
+		weight.Strategy{Name: "b", Strategy: &weight.TotalTimeWeight{}},
+		weight.Strategy{Name: "a", Strategy: &weight.PriorityWeight{}},
+	)
+
+	strategies := registry.List()
+
+	require.Len(t, strategies, 2)
+	assert.Equal(t, "b", strategies[0].Name, "Strategies should keep registration order")
+	assert.Equal(t, "a", strategies[1].Name)
+}
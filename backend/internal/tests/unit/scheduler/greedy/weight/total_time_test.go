@@ -13,7 +13,7 @@ import (
 func ptr[T any](v T) *T { return &v }
 
 func makeSession(courseID uuid.UUID, duration, numSessions int32) *models.CourseSession {
-	return models.NewCourseSession(uuid.New(), courseID, "lecture", "lecture", ptr(duration), ptr(numSessions), nil, nil)
+	return models.NewCourseSession(uuid.New(), courseID, "lecture", "lecture", ptr(duration), ptr(numSessions), nil, nil, nil, nil, nil, nil)
 }
 
 func TestTotalTimeWeight_Calculate_SingleSession(t *testing.T) {
@@ -24,7 +24,7 @@ func TestTotalTimeWeight_Calculate_SingleSession(t *testing.T) {
 		makeSession(courseID, 60, 2), // 60 * 2 = 120
 	}
 
-	result := w.Calculate(sessions)
+	result := w.Calculate(nil, sessions, nil)
 	assert.Equal(t, 120, result)
 }
 
@@ -38,7 +38,7 @@ func TestTotalTimeWeight_Calculate_MultipleSessions(t *testing.T) {
 		makeSession(courseID, 45, 3),  // 45 * 3 = 135
 	}
 
-	result := w.Calculate(sessions)
+	result := w.Calculate(nil, sessions, nil)
 	assert.Equal(t, 345, result) // 120 + 90 + 135
 }
 
@@ -47,7 +47,7 @@ func TestTotalTimeWeight_Calculate_EmptySessions(t *testing.T) {
 
 	sessions := []*models.CourseSession{}
 
-	result := w.Calculate(sessions)
+	result := w.Calculate(nil, sessions, nil)
 	assert.Equal(t, 0, result)
 }
 
@@ -60,7 +60,7 @@ func TestTotalTimeWeight_Calculate_ZeroDuration(t *testing.T) {
 		makeSession(courseID, 0, 5), // 0 * 5 = 0
 	}
 
-	result := w.Calculate(sessions)
+	result := w.Calculate(nil, sessions, nil)
 	assert.Equal(t, 0, result)
 }
 
@@ -73,7 +73,7 @@ func TestTotalTimeWeight_Calculate_LargeValues(t *testing.T) {
 		makeSession(courseID, 120, 10),  // 120 * 10 = 1200
 	}
 
-	result := w.Calculate(sessions)
+	result := w.Calculate(nil, sessions, nil)
 	assert.Equal(t, 2100, result)
 }
 
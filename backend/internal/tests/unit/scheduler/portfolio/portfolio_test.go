@@ -0,0 +1,180 @@
+package portfolio_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/portfolio"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(name string, capacity int32) *models.Room {
+	return models.NewRoom(uuid.New(), name, "lecture", uuid.New(), capacity, nil, nil)
+}
+
+func makeCourse(name string, enrollment int32) *models.Course {
+	return models.NewCourse(uuid.New(), name, enrollment, 0, nil, nil)
+}
+
+func makeSession(courseID uuid.UUID, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(uuid.New(), courseID, "lecture", "lecture", ptr(duration), ptr(numSessions), nil, nil, nil, nil, nil, nil)
+}
+
+// departmentInput is a small week of courses competing for three rooms of different sizes
+func departmentInput() *scheduler.Input {
+	input := &scheduler.Input{
+		Rooms: []*models.Room{makeRoom("Great Hall", 120), makeRoom("Room 101", 40), makeRoom("Room 102", 25)},
+	}
+
+	for i, name := range []string{"Algebra", "Biology", "Chemistry", "Databases", "Ethics", "French", "Geology", "History"} {
+		course := makeCourse(name, int32(20+i*12))
+		input.Courses = append(input.Courses, course)
+		input.CourseSessions = append(input.CourseSessions, makeSession(course.ID, int32(60+(i%3)*30), int32(1+i%3)))
+	}
+
+	return input
+}
+
+// TestPortfolio_ListsEveryVariant tests that every combination of strategy, room selection
+// policy and seed is run and summarised, and that the best of them is returned
+func TestPortfolio_ListsEveryVariant(t *testing.T) {
+	input := departmentInput()
+	output, err := portfolio.NewPortfolioScheduler(&portfolio.Config{Seeds: 2}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	strategies := len(weight.DefaultRegistry().List())
+	require.Len(t, output.Variants, strategies*len(scheduler.RoomSelections)*3)
+	assert.Empty(t, output.Status, "Every variant ran")
+
+	var best []*scheduler.Variant
+	for _, v := range output.Variants {
+		if v.Best {
+			best = append(best, v)
+		}
+	}
+	require.Len(t, best, 1)
+	assert.Equal(t, input.Meetings()-len(output.ScheduledSessions), best[0].Failures)
+	assert.Equal(t, output.Score.Total, best[0].Score)
+	assert.Equal(t, best[0].Seed, output.Seed)
+
+	for _, v := range output.Variants {
+		assert.True(t, best[0].Failures < v.Failures || (best[0].Failures == v.Failures && best[0].Score <= v.Score),
+			"%s/%s should not beat the returned variant", v.Strategy, v.RoomSelection)
+	}
+}
+
+// TestPortfolio_FewestFailuresWin tests that a variant placing every meeting beats one that does not,
+// and that failures count the meetings left out
+func TestPortfolio_FewestFailuresWin(t *testing.T) {
+	hall, room := makeRoom("Great Hall", 50), makeRoom("Room 101", 10)
+	seminar, lecture := makeCourse("Algebra", 10), makeCourse("Biology", 50)
+
+	output, err := portfolio.NewPortfolioScheduler(&portfolio.Config{
+		RoomSelections: []scheduler.RoomSelection{scheduler.RoomSelectionFirstFit, scheduler.RoomSelectionBestFit},
+	}).Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{hall, room},
+		Courses:        []*models.Course{seminar, lecture},
+		CourseSessions: []*models.CourseSession{makeSession(seminar.ID, 60, 2), makeSession(lecture.ID, 60, 2)},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+
+	strategies := len(weight.DefaultRegistry().List())
+	require.Len(t, output.Variants, strategies*2)
+	assert.Equal(t, 2, output.Variants[0].Failures, "First fit gives the seminar the hall, leaving out both lectures")
+	assert.Equal(t, 0, output.Variants[1].Failures)
+	assert.True(t, output.Variants[1].Best)
+	assert.Equal(t, scheduler.RoomSelectionBestFit, output.Variants[1].RoomSelection)
+}
+
+// TestPortfolio_Deterministic tests that the result does not depend on how many workers run it
+func TestPortfolio_Deterministic(t *testing.T) {
+	input := departmentInput()
+
+	single, err := portfolio.NewPortfolioScheduler(&portfolio.Config{Seeds: 3, Workers: 1}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	parallel, err := portfolio.NewPortfolioScheduler(&portfolio.Config{Seeds: 3, Workers: 8}).Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	assert.Equal(t, single.ScheduledSessions, parallel.ScheduledSessions)
+	assert.Equal(t, single.Variants, parallel.Variants)
+}
+
+// TestPortfolio_TimeLimit tests that variants not started within the budget are skipped and
+// those running are stopped
+func TestPortfolio_TimeLimit(t *testing.T) {
+	output, err := portfolio.NewPortfolioScheduler(&portfolio.Config{
+		Seeds:     4,
+		Workers:   1,
+		TimeLimit: time.Nanosecond,
+	}).Generate(context.Background(), departmentInput())
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusTimedOut, output.Status)
+	require.Len(t, output.Variants, 1, "Only the first variant runs")
+	assert.True(t, output.Variants[0].Best)
+	assert.Equal(t, scheduler.StatusTimedOut, output.Variants[0].Status, "The first variant stops before its first session")
+	assert.Empty(t, output.ScheduledSessions)
+}
+
+// TestPortfolio_PinConflict tests that an input error is returned rather than a timetable
+func TestPortfolio_PinConflict(t *testing.T) {
+	input := departmentInput()
+	room := input.Rooms[0]
+	input.Pins = []*models.SessionPin{
+		models.NewSessionPin(uuid.New(), input.CourseSessions[0].ID, room.ID, 0, 480, nil, nil),
+		models.NewSessionPin(uuid.New(), input.CourseSessions[1].ID, room.ID, 0, 480, nil, nil),
+	}
+
+	output, err := portfolio.NewPortfolioScheduler(nil).Generate(context.Background(), input)
+
+	var conflictErr *scheduler.PinConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Nil(t, output)
+}
+
+// TestPortfolio_Canceled tests that no more variants start once the context is canceled
+func TestPortfolio_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	output, err := portfolio.NewPortfolioScheduler(&portfolio.Config{Seeds: 4, Workers: 1}).Generate(ctx, departmentInput())
+
+	require.NoError(t, err)
+	assert.Equal(t, scheduler.StatusCanceled, output.Status)
+	require.Len(t, output.Variants, 1, "Only the first variant runs")
+	assert.Equal(t, scheduler.StatusCanceled, output.Variants[0].Status, "The first variant stops before its first session")
+}
+
+// TestPortfolio_ReportsProgress tests that progress rises to every meeting placed, one report at a time
+func TestPortfolio_ReportsProgress(t *testing.T) {
+	input := departmentInput()
+	var reports []scheduler.Progress
+	input.Progress = func(p scheduler.Progress) {
+		reports = append(reports, p)
+	}
+
+	output, err := portfolio.NewPortfolioScheduler(&portfolio.Config{Seeds: 2, Workers: 8}).Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, reports)
+	for i := 1; i < len(reports); i++ {
+		assert.Less(t, reports[i-1].Placed, reports[i].Placed)
+	}
+	assert.Equal(t, input.Meetings(), reports[0].Total)
+	assert.LessOrEqual(t, len(output.ScheduledSessions), reports[len(reports)-1].Placed)
+}
@@ -0,0 +1,221 @@
+package repair_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/repair"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(name, roomType string) *models.Room {
+	return models.NewRoom(uuid.New(), name, roomType, uuid.New(), 30, nil, nil)
+}
+
+func makeCourse(name string) *models.Course {
+	return models.NewCourse(uuid.New(), name, 0, 0, nil, nil)
+}
+
+func makeSession(courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(uuid.New(), courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil, nil, nil, nil, nil)
+}
+
+func saved(cs *models.CourseSession, room *models.Room, day, start int) models.ScheduledSession {
+	return models.ScheduledSession{
+		CourseID:        cs.CourseID,
+		CourseSessionID: cs.ID,
+		RoomID:          room.ID,
+		Day:             day,
+		StartTime:       start,
+		EndTime:         start + int(*cs.Duration),
+	}
+}
+
+// term is a small timetable of three courses in two rooms on Monday and Tuesday
+type term struct {
+	roomA, roomB       *models.Room
+	algebra, chemistry *models.CourseSession
+	physics            *models.CourseSession
+	courses            []*models.Course
+	schedule           []models.ScheduledSession
+}
+
+func newTerm() *term {
+	algebra, chemistry, physics := makeCourse("Algebra"), makeCourse("Chemistry"), makeCourse("Physics")
+	t := &term{
+		roomA:     makeRoom("Room A", "lecture"),
+		roomB:     makeRoom("Room B", "lecture"),
+		algebra:   makeSession(algebra.ID, "lecture", 60, 2),
+		chemistry: makeSession(chemistry.ID, "lecture", 60, 1),
+		physics:   makeSession(physics.ID, "lecture", 90, 1),
+		courses:   []*models.Course{algebra, chemistry, physics},
+	}
+	t.schedule = []models.ScheduledSession{
+		saved(t.algebra, t.roomA, 0, 480),
+		saved(t.algebra, t.roomA, 1, 480),
+		saved(t.chemistry, t.roomA, 0, 540),
+		saved(t.physics, t.roomB, 0, 480),
+	}
+	return t
+}
+
+func (t *term) input() *scheduler.Input {
+	return &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{t.roomA, t.roomB},
+		Courses:        t.courses,
+		CourseSessions: []*models.CourseSession{t.algebra, t.chemistry, t.physics},
+	}
+}
+
+func TestRepair_NothingChanged(t *testing.T) {
+	tm := newTerm()
+
+	result, err := repair.Repair(tm.input(), tm.schedule)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+	assert.Empty(t, result.Output.Failures)
+	assert.Len(t, result.Output.ScheduledSessions, 4)
+}
+
+func TestRepair_RoomBlackout(t *testing.T) {
+	tm := newTerm()
+	input := tm.input()
+	input.RoomUnavailability = []*models.RoomUnavailability{
+		models.NewRoomUnavailability(uuid.New(), tm.roomA.ID, int32(scheduler.Monday), 540, 600, nil, nil, nil),
+	}
+
+	result, err := repair.Repair(input, tm.schedule)
+
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 1, "Only the session in the blacked out window should move")
+
+	change := result.Changes[0]
+	assert.Equal(t, tm.chemistry.ID, change.CourseSessionID)
+	assert.Equal(t, repair.ReasonNoLongerFits, change.Reason)
+	assert.Equal(t, tm.schedule[2], *change.Old)
+	require.NotNil(t, change.New)
+	assert.Equal(t, 0, change.New.Day, "Chemistry should stay on Monday")
+	assert.Equal(t, 570, change.New.StartTime, "Room B is free once Physics ends at 9:30")
+	assert.Equal(t, tm.roomB.ID, change.New.RoomID)
+}
+
+func TestRepair_RoomDeleted(t *testing.T) {
+	tm := newTerm()
+	input := tm.input()
+	input.Rooms = []*models.Room{tm.roomA}
+
+	result, err := repair.Repair(input, tm.schedule)
+
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 1)
+
+	change := result.Changes[0]
+	assert.Equal(t, tm.physics.ID, change.CourseSessionID)
+	assert.Equal(t, repair.ReasonRoomRemoved, change.Reason)
+	require.NotNil(t, change.New)
+	assert.Equal(t, tm.roomA.ID, change.New.RoomID)
+	assert.Equal(t, 0, change.New.Day)
+	assert.Equal(t, 600, change.New.StartTime, "Physics should take the first gap in Room A on Monday")
+}
+
+func TestRepair_DurationChanged(t *testing.T) {
+	tm := newTerm()
+	tm.algebra.Duration = ptr(int32(90))
+
+	result, err := repair.Repair(tm.input(), tm.schedule)
+
+	require.NoError(t, err)
+
+	reasons := make(map[uuid.UUID][]string)
+	for _, c := range result.Changes {
+		reasons[c.CourseSessionID] = append(reasons[c.CourseSessionID], c.Reason)
+	}
+
+	assert.Equal(t, []string{repair.ReasonDurationChanged, repair.ReasonDurationChanged}, reasons[tm.algebra.ID],
+		"Both Algebra meetings now end later")
+	assert.Equal(t, []string{repair.ReasonClash}, reasons[tm.chemistry.ID],
+		"Chemistry overlaps the longer Algebra meeting")
+	assert.Empty(t, reasons[tm.physics.ID])
+	assert.Empty(t, result.Output.Failures)
+}
+
+func TestRepair_SessionsAddedAndRemoved(t *testing.T) {
+	tm := newTerm()
+	input := tm.input()
+	input.CourseSessions = []*models.CourseSession{tm.algebra, tm.physics}
+	tm.physics.NumberOfSessions = ptr(int32(2))
+
+	result, err := repair.Repair(input, tm.schedule)
+
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 2)
+
+	assert.Equal(t, repair.ReasonSessionRemoved, result.Changes[0].Reason)
+	assert.Equal(t, tm.chemistry.ID, result.Changes[0].CourseSessionID)
+	assert.Nil(t, result.Changes[0].New)
+
+	assert.Equal(t, repair.ReasonNewMeeting, result.Changes[1].Reason)
+	assert.Equal(t, tm.physics.ID, result.Changes[1].CourseSessionID)
+	assert.Nil(t, result.Changes[1].Old)
+	require.NotNil(t, result.Changes[1].New)
+	assert.Equal(t, 1, result.Changes[1].New.Day, "The new Physics meeting should go on the day without one")
+}
+
+func TestRepair_Unplaceable(t *testing.T) {
+	tm := newTerm()
+	input := tm.input()
+	input.Rooms = []*models.Room{tm.roomB}
+	input.Config.OperatingDays = []scheduler.Day{scheduler.Monday}
+	input.Config.OperatingHours = scheduler.TimeRange{Start: 480, End: 570}
+
+	result, err := repair.Repair(input, tm.schedule)
+
+	require.NoError(t, err)
+	assert.Len(t, result.Output.ScheduledSessions, 1, "Only Physics still fits")
+
+	for _, c := range result.Changes {
+		assert.Nil(t, c.New)
+	}
+	assert.Len(t, result.Changes, 3)
+	assert.NotEmpty(t, result.Output.Failures)
+}
+
+func TestRepair_MovesToPin(t *testing.T) {
+	tm := newTerm()
+	input := tm.input()
+	input.Pins = []*models.SessionPin{
+		models.NewSessionPin(uuid.New(), tm.chemistry.ID, tm.roomB.ID, int32(scheduler.Tuesday), 600, nil, nil),
+	}
+
+	result, err := repair.Repair(input, tm.schedule)
+
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 1)
+	assert.Equal(t, repair.ReasonPinned, result.Changes[0].Reason)
+	assert.Equal(t, 1, result.Changes[0].New.Day)
+	assert.Equal(t, 600, result.Changes[0].New.StartTime)
+}
+
+func TestRepair_LegacySessionsMatchedByCourse(t *testing.T) {
+	tm := newTerm()
+	for i := range tm.schedule {
+		tm.schedule[i].CourseSessionID = uuid.Nil
+	}
+
+	result, err := repair.Repair(tm.input(), tm.schedule)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+	assert.Len(t, result.Output.ScheduledSessions, 4)
+}
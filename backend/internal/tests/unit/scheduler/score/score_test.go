@@ -0,0 +1,186 @@
+package score_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/score"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func session(courseID, courseSessionID, roomID uuid.UUID, day, start, end int) *models.ScheduledSession {
+	return &models.ScheduledSession{
+		CourseID:        courseID,
+		CourseSessionID: courseSessionID,
+		RoomID:          roomID,
+		Day:             day,
+		StartTime:       start,
+		EndTime:         end,
+	}
+}
+
+// TestIdleGaps_Instructor tests that an instructor's wait between sessions is counted, less the minimum break
+func TestIdleGaps_Instructor(t *testing.T) {
+	roomID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	instructorID := uuid.New()
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{MinBreakBetweenSessions: 15},
+		InstructorAssignments: []*models.InstructorAssignment{
+			models.NewInstructorAssignment(first, instructorID, nil),
+			models.NewInstructorAssignment(second, instructorID, nil),
+		},
+	}
+	sessions := []*models.ScheduledSession{
+		session(uuid.New(), second, roomID, 0, 660, 720),
+		session(uuid.New(), first, roomID, 0, 480, 540),
+		session(uuid.New(), uuid.New(), roomID, 0, 560, 620), // nobody shared
+	}
+
+	penalty := (&score.IdleGaps{}).Penalty(sessions, input)
+
+	assert.InDelta(t, 1.75, penalty, 0.001, "Two hours between sessions less a 15 minute break")
+}
+
+// TestIdleGaps_CohortAndTravel tests that a cohort's gap is counted and travel time between buildings is allowed
+func TestIdleGaps_CohortAndTravel(t *testing.T) {
+	buildingA, buildingB := uuid.New(), uuid.New()
+	roomA := models.NewRoom(uuid.New(), "A101", "lecture", buildingA, 30, nil, nil)
+	roomB := models.NewRoom(uuid.New(), "B101", "lecture", buildingB, 30, nil, nil)
+	math, physics := uuid.New(), uuid.New()
+
+	input := &scheduler.Input{
+		Config:      &scheduler.Config{},
+		Rooms:       []*models.Room{roomA, roomB},
+		Cohorts:     []*models.Cohort{models.NewCohort(uuid.New(), "Year 1", []uuid.UUID{math, physics}, nil, nil)},
+		TravelTimes: []*models.BuildingTravelTime{models.NewBuildingTravelTime(buildingB, buildingA, 20, nil, nil)},
+	}
+	sessions := []*models.ScheduledSession{
+		session(math, uuid.New(), roomA.ID, 1, 480, 540),
+		session(physics, uuid.New(), roomB.ID, 1, 600, 660),
+	}
+
+	penalty := (&score.IdleGaps{}).Penalty(sessions, input)
+
+	assert.InDelta(t, 40.0/60, penalty, 0.001, "An hour's gap less 20 minutes travel")
+}
+
+// TestLateSessions tests that only the part of a session after the cutoff counts
+func TestLateSessions(t *testing.T) {
+	sessions := []*models.ScheduledSession{
+		session(uuid.New(), uuid.New(), uuid.New(), 0, 960, 1080),
+		session(uuid.New(), uuid.New(), uuid.New(), 0, 1110, 1140),
+		session(uuid.New(), uuid.New(), uuid.New(), 0, 480, 540),
+	}
+
+	assert.InDelta(t, 1.5, (&score.LateSessions{}).Penalty(sessions, &scheduler.Input{}), 0.001)
+	assert.InDelta(t, 0.5, (&score.LateSessions{After: 1110}).Penalty(sessions, &scheduler.Input{}), 0.001)
+}
+
+// TestUnbalancedDays tests that the busiest and quietest operating days are compared
+func TestUnbalancedDays(t *testing.T) {
+	input := &scheduler.Input{
+		Config: &scheduler.Config{OperatingDays: []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday}},
+	}
+	sessions := []*models.ScheduledSession{
+		session(uuid.New(), uuid.New(), uuid.New(), int(scheduler.Monday), 480, 600),
+		session(uuid.New(), uuid.New(), uuid.New(), int(scheduler.Monday), 600, 660),
+		session(uuid.New(), uuid.New(), uuid.New(), int(scheduler.Tuesday), 480, 540),
+		session(uuid.New(), uuid.New(), uuid.New(), int(scheduler.Wednesday), 480, 510),
+	}
+
+	assert.InDelta(t, 2.5, (&score.UnbalancedDays{}).Penalty(sessions, input), 0.001)
+}
+
+// TestWastedCapacity tests that empty seats are counted, using the session enrollment when set
+func TestWastedCapacity(t *testing.T) {
+	room := models.NewRoom(uuid.New(), "Hall", "lecture", uuid.New(), 100, nil, nil)
+	course := models.NewCourse(uuid.New(), "Biology", 50, 0, nil, nil)
+	lab := models.NewCourseSession(uuid.New(), course.ID, "lecture", "lab", ptr(int32(60)), ptr(int32(1)), ptr(int32(80)), nil, nil, nil, nil, nil)
+	unknown := models.NewCourse(uuid.New(), "Seminar", 0, 0, nil, nil)
+
+	input := &scheduler.Input{
+		Rooms:          []*models.Room{room},
+		Courses:        []*models.Course{course, unknown},
+		CourseSessions: []*models.CourseSession{lab},
+	}
+	sessions := []*models.ScheduledSession{
+		session(course.ID, uuid.New(), room.ID, 0, 480, 540),
+		session(course.ID, lab.ID, room.ID, 1, 480, 540),
+		session(unknown.ID, uuid.New(), room.ID, 2, 480, 540),
+	}
+
+	assert.InDelta(t, 0.5+0.2, (&score.WastedCapacity{}).Penalty(sessions, input), 0.001)
+}
+
+// TestSessionPreferences tests that only teaching outside a session's preferred windows is counted
+func TestSessionPreferences(t *testing.T) {
+	evening := models.NewCourseSession(uuid.New(), uuid.New(), "lecture", "lecture", ptr(int32(120)), ptr(int32(2)), nil, nil,
+		[]models.SessionWindow{{StartTime: 1020, EndTime: 1320}}, nil, nil, nil)
+	anytime := models.NewCourseSession(uuid.New(), uuid.New(), "lecture", "lecture", ptr(int32(60)), ptr(int32(1)), nil, nil, nil, nil, nil, nil)
+
+	input := &scheduler.Input{CourseSessions: []*models.CourseSession{evening, anytime}}
+	sessions := []*models.ScheduledSession{
+		session(evening.CourseID, evening.ID, uuid.New(), int(scheduler.Monday), 1080, 1200),
+		session(evening.CourseID, evening.ID, uuid.New(), int(scheduler.Tuesday), 960, 1080),
+		session(anytime.CourseID, anytime.ID, uuid.New(), int(scheduler.Monday), 480, 540),
+	}
+
+	assert.InDelta(t, 2.0, (&score.SessionPreferences{}).Penalty(sessions, input), 0.001, "Only the Tuesday meeting starts before 17:00")
+}
+
+// TestSessionPreferences_Weights tests that an hour in a lighter preferred window counts the
+// difference from the heaviest window, and an hour outside them all the heaviest weight
+func TestSessionPreferences_Weights(t *testing.T) {
+	cs := models.NewCourseSession(uuid.New(), uuid.New(), "lecture", "lecture", ptr(int32(60)), ptr(int32(3)), nil, nil,
+		[]models.SessionWindow{
+			{StartTime: 480, EndTime: 600, Weight: ptr(int32(1))},
+			{StartTime: 720, EndTime: 840, Weight: ptr(int32(3))},
+		}, nil, nil, nil)
+
+	input := &scheduler.Input{CourseSessions: []*models.CourseSession{cs}}
+	sessions := []*models.ScheduledSession{
+		session(cs.CourseID, cs.ID, uuid.New(), int(scheduler.Monday), 720, 780),
+		session(cs.CourseID, cs.ID, uuid.New(), int(scheduler.Tuesday), 480, 540),
+		session(cs.CourseID, cs.ID, uuid.New(), int(scheduler.Wednesday), 960, 1020),
+	}
+
+	assert.InDelta(t, 0.0+2.0+3.0, (&score.SessionPreferences{}).Penalty(sessions, input), 0.001)
+}
+
+// TestScorer_Evaluate tests that the total is the weighted sum of the breakdown
+func TestScorer_Evaluate(t *testing.T) {
+	scorer := score.NewScorer(
+		score.WeightedConstraint{Constraint: &score.LateSessions{}, Weight: 3},
+		score.WeightedConstraint{Constraint: &score.UnbalancedDays{}, Weight: 0.5},
+	)
+	sessions := []*models.ScheduledSession{
+		session(uuid.New(), uuid.New(), uuid.New(), int(scheduler.Monday), 1020, 1080),
+	}
+
+	result := scorer.Evaluate(sessions, &scheduler.Input{
+		Config: &scheduler.Config{OperatingDays: []scheduler.Day{scheduler.Monday, scheduler.Tuesday}},
+	})
+
+	require.Len(t, result.Breakdown, 2)
+	assert.Equal(t, "late_sessions", result.Breakdown[0].Name)
+	assert.InDelta(t, 3.0, result.Breakdown[0].Score, 0.001)
+	assert.Equal(t, "unbalanced_days", result.Breakdown[1].Name)
+	assert.InDelta(t, 0.5, result.Breakdown[1].Score, 0.001)
+	assert.InDelta(t, 3.5, result.Total, 0.001)
+}
+
+// TestScorer_EmptyTimetable tests that an empty timetable scores zero with the default constraints
+func TestScorer_EmptyTimetable(t *testing.T) {
+	result := score.DefaultScorer().Evaluate(nil, nil)
+
+	assert.Len(t, result.Breakdown, 5)
+	assert.Zero(t, result.Total)
+}
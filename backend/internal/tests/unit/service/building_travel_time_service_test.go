@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestBuildingTravelTimeService_Set(t *testing.T) {
+	ctx := context.Background()
+	travelTime := models.NewBuildingTravelTime(uuid.New(), uuid.New(), 10, nil, nil)
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingTravelTimeRepository{
+			SetFunc: func(ctx context.Context, tt *models.BuildingTravelTime) (*models.BuildingTravelTime, error) {
+				return tt, nil
+			},
+		}
+
+		svc := service.NewBuildingTravelTimeService(mockRepo)
+		result, err := svc.Set(ctx, travelTime)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), result.Minutes)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingTravelTimeRepository{
+			SetFunc: func(ctx context.Context, tt *models.BuildingTravelTime) (*models.BuildingTravelTime, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewBuildingTravelTimeService(mockRepo)
+		result, err := svc.Set(ctx, travelTime)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBuildingTravelTimeService_GetByID(t *testing.T) {
+	ctx := context.Background()
+	fromID := uuid.New()
+	toID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingTravelTimeRepository{
+			GetByIDFunc: func(ctx context.Context, reqFromID uuid.UUID, reqToID uuid.UUID) (*models.BuildingTravelTime, error) {
+				return models.NewBuildingTravelTime(reqFromID, reqToID, 5, nil, nil), nil
+			},
+		}
+
+		svc := service.NewBuildingTravelTimeService(mockRepo)
+		result, err := svc.GetByID(ctx, fromID, toID)
+
+		require.NoError(t, err)
+		assert.Equal(t, fromID, result.FromBuildingID)
+		assert.Equal(t, toID, result.ToBuildingID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingTravelTimeRepository{
+			GetByIDFunc: func(ctx context.Context, reqFromID uuid.UUID, reqToID uuid.UUID) (*models.BuildingTravelTime, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewBuildingTravelTimeService(mockRepo)
+		result, err := svc.GetByID(ctx, fromID, toID)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBuildingTravelTimeService_GetByBuildingID(t *testing.T) {
+	ctx := context.Background()
+	fromID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingTravelTimeRepository{
+			GetByBuildingIDFunc: func(ctx context.Context, reqFromID uuid.UUID) ([]*models.BuildingTravelTime, error) {
+				return []*models.BuildingTravelTime{
+					models.NewBuildingTravelTime(reqFromID, uuid.New(), 5, nil, nil),
+					models.NewBuildingTravelTime(reqFromID, uuid.New(), 15, nil, nil),
+				}, nil
+			},
+		}
+
+		svc := service.NewBuildingTravelTimeService(mockRepo)
+		result, err := svc.GetByBuildingID(ctx, fromID)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestBuildingTravelTimeService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingTravelTimeRepository{
+			DeleteFunc: func(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error {
+				return nil
+			},
+		}
+
+		svc := service.NewBuildingTravelTimeService(mockRepo)
+		err := svc.Delete(ctx, uuid.New(), uuid.New())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingTravelTimeRepository{
+			DeleteFunc: func(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error {
+				return repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewBuildingTravelTimeService(mockRepo)
+		err := svc.Delete(ctx, uuid.New(), uuid.New())
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestCohortService_Create(t *testing.T) {
+	ctx := context.Background()
+	cohort := &models.Cohort{ID: uuid.New(), Name: "Year 2 Computer Science", CourseIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			CreateFunc: func(ctx context.Context, i *models.Cohort) (*models.Cohort, error) {
+				return cohort, nil
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		result, err := svc.Create(ctx, cohort)
+
+		require.NoError(t, err)
+		assert.Equal(t, cohort.ID, result.ID)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			CreateFunc: func(ctx context.Context, i *models.Cohort) (*models.Cohort, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		result, err := svc.Create(ctx, cohort)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCohortService_CreateBatch(t *testing.T) {
+	ctx := context.Background()
+	cohorts := []*models.Cohort{
+		{ID: uuid.New(), Name: "Year 1 Computer Science"},
+		{ID: uuid.New(), Name: "Year 2 Computer Science"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			CreateBatchFunc: func(ctx context.Context, i []*models.Cohort) ([]*models.Cohort, error) {
+				return cohorts, nil
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		result, err := svc.CreateBatch(ctx, cohorts)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestCohortService_GetByID(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	cohort := &models.Cohort{ID: id, Name: "Year 1 Computer Science"}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Cohort, error) {
+				return cohort, nil
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.NoError(t, err)
+		assert.Equal(t, id, result.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Cohort, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCohortService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{{ID: uuid.New(), Name: "Year 1 Computer Science"}}, nil
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		result, err := svc.List(ctx)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestCohortService_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				return nil
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		err := svc.Delete(ctx, id)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCohortService_Update(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	courseIDs := []uuid.UUID{uuid.New()}
+	updates := &models.CohortUpdate{CourseIDs: &courseIDs}
+	updated := &models.Cohort{ID: id, Name: "Year 2 Computer Science", CourseIDs: courseIDs}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockCohortRepository{
+			UpdateFunc: func(ctx context.Context, reqID uuid.UUID, u *models.CohortUpdate) (*models.Cohort, error) {
+				return updated, nil
+			},
+		}
+
+		svc := service.NewCohortService(mockRepo)
+		result, err := svc.Update(ctx, id, updates)
+
+		require.NoError(t, err)
+		assert.Equal(t, courseIDs, result.CourseIDs)
+	})
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestCourseSectionService_Create(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+
+	invalid := []struct {
+		name    string
+		section *models.CourseSection
+	}{
+		{"missing course", models.NewCourseSection(uuid.New(), uuid.Nil, "A", nil, nil, nil, nil)},
+		{"blank name", models.NewCourseSection(uuid.New(), courseID, "  ", nil, nil, nil, nil)},
+		{"negative capacity", models.NewCourseSection(uuid.New(), courseID, "A", ptr(int32(-1)), nil, nil, nil)},
+		{"empty instructor", models.NewCourseSection(uuid.New(), courseID, "A", nil, ptr(uuid.Nil), nil, nil)},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockCourseSectionRepository{
+				CreateFunc: func(ctx context.Context, s *models.CourseSection) (*models.CourseSection, error) {
+					called = true
+					return s, nil
+				},
+			}
+
+			svc := service.NewCourseSectionService(mockRepo)
+			result, err := svc.Create(ctx, tt.section)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid section must not reach the repository")
+		})
+	}
+
+	valid := []struct {
+		name    string
+		section *models.CourseSection
+	}{
+		{"no overrides", models.NewCourseSection(uuid.New(), courseID, "A", nil, nil, nil, nil)},
+		{"zero capacity", models.NewCourseSection(uuid.New(), courseID, "B", ptr(int32(0)), nil, nil, nil)},
+		{"capacity and instructor", models.NewCourseSection(uuid.New(), courseID, "C", ptr(int32(40)), ptr(uuid.New()), nil, nil)},
+	}
+
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockCourseSectionRepository{
+				CreateFunc: func(ctx context.Context, s *models.CourseSection) (*models.CourseSection, error) {
+					called = true
+					return s, nil
+				},
+			}
+
+			svc := service.NewCourseSectionService(mockRepo)
+			_, err := svc.Create(ctx, tt.section)
+
+			require.NoError(t, err)
+			assert.True(t, called)
+		})
+	}
+}
+
+func TestCourseSectionService_Update(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+	id := uuid.New()
+
+	invalid := []struct {
+		name    string
+		updates *models.CourseSectionUpdate
+	}{
+		{"nil updates", nil},
+		{"blank name", &models.CourseSectionUpdate{Name: ptr("")}},
+		{"negative capacity", &models.CourseSectionUpdate{Capacity: ptr(int32(-5))}},
+		{"empty instructor", &models.CourseSectionUpdate{InstructorID: ptr(uuid.Nil)}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockCourseSectionRepository{
+				UpdateFunc: func(ctx context.Context, courseID uuid.UUID, id uuid.UUID, u *models.CourseSectionUpdate) (*models.CourseSection, error) {
+					called = true
+					return nil, nil
+				},
+			}
+
+			svc := service.NewCourseSectionService(mockRepo)
+			result, err := svc.Update(ctx, courseID, id, tt.updates)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid update must not reach the repository")
+		})
+	}
+
+	t.Run("raise capacity", func(t *testing.T) {
+		called := false
+		mockRepo := &mocks.MockCourseSectionRepository{
+			UpdateFunc: func(ctx context.Context, reqCourseID uuid.UUID, reqID uuid.UUID, u *models.CourseSectionUpdate) (*models.CourseSection, error) {
+				called = true
+				return models.NewCourseSection(reqID, reqCourseID, "A", u.Capacity, nil, nil, nil), nil
+			},
+		}
+
+		svc := service.NewCourseSectionService(mockRepo)
+		_, err := svc.Update(ctx, courseID, id, &models.CourseSectionUpdate{Capacity: ptr(int32(60))})
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
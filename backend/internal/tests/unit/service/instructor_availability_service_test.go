@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestInstructorAvailabilityService_Create(t *testing.T) {
+	ctx := context.Background()
+	instructorID := uuid.New()
+
+	invalid := []struct {
+		name         string
+		availability *models.InstructorAvailability
+	}{
+		{"missing instructor", models.NewInstructorAvailability(uuid.New(), uuid.Nil, models.AvailabilityPreferred, 0, 480, 720, nil, nil)},
+		{"unknown kind", models.NewInstructorAvailability(uuid.New(), instructorID, "busy", 0, 480, 720, nil, nil)},
+		{"empty kind", models.NewInstructorAvailability(uuid.New(), instructorID, "", 0, 480, 720, nil, nil)},
+		{"day out of range", models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, 7, 480, 720, nil, nil)},
+		{"ends past midnight", models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityUnavailable, 0, 1200, models.MinutesPerDay+60, nil, nil)},
+		{"reversed range", models.NewInstructorAvailability(uuid.New(), instructorID, models.AvailabilityPreferred, 0, 720, 480, nil, nil)},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockInstructorAvailabilityRepository{
+				CreateFunc: func(ctx context.Context, a *models.InstructorAvailability) (*models.InstructorAvailability, error) {
+					called = true
+					return a, nil
+				},
+			}
+
+			svc := service.NewInstructorAvailabilityService(mockRepo)
+			result, err := svc.Create(ctx, tt.availability)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid availability must not reach the repository")
+		})
+	}
+
+	for _, kind := range []string{models.AvailabilityUnavailable, models.AvailabilityPreferred} {
+		t.Run("accepts "+kind, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockInstructorAvailabilityRepository{
+				CreateFunc: func(ctx context.Context, a *models.InstructorAvailability) (*models.InstructorAvailability, error) {
+					called = true
+					return a, nil
+				},
+			}
+
+			svc := service.NewInstructorAvailabilityService(mockRepo)
+			_, err := svc.Create(ctx, models.NewInstructorAvailability(uuid.New(), instructorID, kind, 2, 480, 720, nil, nil))
+
+			require.NoError(t, err)
+			assert.True(t, called)
+		})
+	}
+}
+
+func TestInstructorAvailabilityService_Update(t *testing.T) {
+	ctx := context.Background()
+	instructorID := uuid.New()
+	id := uuid.New()
+
+	invalid := []struct {
+		name    string
+		updates *models.InstructorAvailabilityUpdate
+	}{
+		{"nil updates", nil},
+		{"unknown kind", &models.InstructorAvailabilityUpdate{Kind: ptr("busy")}},
+		{"day out of range", &models.InstructorAvailabilityUpdate{Day: ptr(int32(-1))}},
+		{"reversed range", &models.InstructorAvailabilityUpdate{StartTime: ptr(int32(720)), EndTime: ptr(int32(600))}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockInstructorAvailabilityRepository{
+				UpdateFunc: func(ctx context.Context, instructorID uuid.UUID, id uuid.UUID, u *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error) {
+					called = true
+					return nil, nil
+				},
+			}
+
+			svc := service.NewInstructorAvailabilityService(mockRepo)
+			result, err := svc.Update(ctx, instructorID, id, tt.updates)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid update must not reach the repository")
+		})
+	}
+
+	t.Run("switch kind", func(t *testing.T) {
+		called := false
+		mockRepo := &mocks.MockInstructorAvailabilityRepository{
+			UpdateFunc: func(ctx context.Context, reqInstructorID uuid.UUID, reqID uuid.UUID, u *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error) {
+				called = true
+				return models.NewInstructorAvailability(reqID, reqInstructorID, *u.Kind, 0, 480, 720, nil, nil), nil
+			},
+		}
+
+		svc := service.NewInstructorAvailabilityService(mockRepo)
+		_, err := svc.Update(ctx, instructorID, id, &models.InstructorAvailabilityUpdate{Kind: ptr(models.AvailabilityUnavailable)})
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
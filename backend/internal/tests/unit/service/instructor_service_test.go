@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestInstructorService_Create(t *testing.T) {
+	ctx := context.Background()
+	instructor := &models.Instructor{ID: uuid.New(), Name: "Dr. Smith", Email: ptr("smith@example.com")}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			CreateFunc: func(ctx context.Context, i *models.Instructor) (*models.Instructor, error) {
+				return instructor, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Create(ctx, instructor)
+
+		require.NoError(t, err)
+		assert.Equal(t, instructor.ID, result.ID)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			CreateFunc: func(ctx context.Context, i *models.Instructor) (*models.Instructor, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Create(ctx, instructor)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestInstructorService_CreateBatch(t *testing.T) {
+	ctx := context.Background()
+	instructors := []*models.Instructor{
+		{ID: uuid.New(), Name: "Dr. Smith"},
+		{ID: uuid.New(), Name: "Dr. Jones"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			CreateBatchFunc: func(ctx context.Context, i []*models.Instructor) ([]*models.Instructor, error) {
+				return instructors, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.CreateBatch(ctx, instructors)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestInstructorService_GetByID(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	instructor := &models.Instructor{ID: id, Name: "Dr. Smith"}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Instructor, error) {
+				return instructor, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.NoError(t, err)
+		assert.Equal(t, id, result.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Instructor, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestInstructorService_GetBySessionID(t *testing.T) {
+	ctx := context.Background()
+	sessionID := uuid.New()
+	instructors := []*models.Instructor{
+		{ID: uuid.New(), Name: "Dr. Smith"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			GetBySessionIDFunc: func(ctx context.Context, reqID uuid.UUID) ([]*models.Instructor, error) {
+				assert.Equal(t, sessionID, reqID)
+				return instructors, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.GetBySessionID(ctx, sessionID)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestInstructorService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{{ID: uuid.New(), Name: "Dr. Smith"}}, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.List(ctx)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestInstructorService_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				return nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		err := svc.Delete(ctx, id)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestInstructorService_Update(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	newName := "Prof. Smith"
+	updates := &models.InstructorUpdate{Name: &newName}
+	updated := &models.Instructor{ID: id, Name: newName}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			UpdateFunc: func(ctx context.Context, reqID uuid.UUID, u *models.InstructorUpdate) (*models.Instructor, error) {
+				return updated, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Update(ctx, id, updates)
+
+		require.NoError(t, err)
+		assert.Equal(t, newName, result.Name)
+	})
+}
+
+func TestInstructorService_Assign(t *testing.T) {
+	ctx := context.Background()
+	sessionID := uuid.New()
+	instructorID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			AssignFunc: func(ctx context.Context, sID uuid.UUID, iID uuid.UUID) (*models.InstructorAssignment, error) {
+				return &models.InstructorAssignment{CourseSessionID: sID, InstructorID: iID}, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Assign(ctx, sessionID, instructorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, result.CourseSessionID)
+		assert.Equal(t, instructorID, result.InstructorID)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			AssignFunc: func(ctx context.Context, sID uuid.UUID, iID uuid.UUID) (*models.InstructorAssignment, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Assign(ctx, sessionID, instructorID)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestInstructorService_Unassign(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			UnassignFunc: func(ctx context.Context, sID uuid.UUID, iID uuid.UUID) error {
+				return repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		err := svc.Unassign(ctx, uuid.New(), uuid.New())
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
@@ -228,3 +228,342 @@ func (m *MockScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error
 func (m *MockScheduleRepository) Update(ctx context.Context, id uuid.UUID, updates *models.ScheduleUpdate) (*models.Schedule, error) {
 	return m.UpdateFunc(ctx, id, updates)
 }
+
+// MockInstructorRepository is a mock implementation of InstructorRepositoryInterface
+type MockInstructorRepository struct {
+	CreateFunc          func(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error)
+	CreateBatchFunc     func(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error)
+	GetByIDFunc         func(ctx context.Context, id uuid.UUID) (*models.Instructor, error)
+	GetBySessionIDFunc  func(ctx context.Context, sessionID uuid.UUID) ([]*models.Instructor, error)
+	ListFunc            func(ctx context.Context) ([]*models.Instructor, error)
+	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+	UpdateFunc          func(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error)
+	AssignFunc          func(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) (*models.InstructorAssignment, error)
+	UnassignFunc        func(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) error
+	ListAssignmentsFunc func(ctx context.Context) ([]*models.InstructorAssignment, error)
+}
+
+var _ repository.InstructorRepositoryInterface = (*MockInstructorRepository)(nil)
+
+func (m *MockInstructorRepository) Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error) {
+	return m.CreateFunc(ctx, instructor)
+}
+
+func (m *MockInstructorRepository) CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error) {
+	return m.CreateBatchFunc(ctx, instructors)
+}
+
+func (m *MockInstructorRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockInstructorRepository) GetBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*models.Instructor, error) {
+	return m.GetBySessionIDFunc(ctx, sessionID)
+}
+
+func (m *MockInstructorRepository) List(ctx context.Context) ([]*models.Instructor, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockInstructorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *MockInstructorRepository) Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error) {
+	return m.UpdateFunc(ctx, id, updates)
+}
+
+func (m *MockInstructorRepository) Assign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) (*models.InstructorAssignment, error) {
+	return m.AssignFunc(ctx, sessionID, instructorID)
+}
+
+func (m *MockInstructorRepository) Unassign(ctx context.Context, sessionID uuid.UUID, instructorID uuid.UUID) error {
+	return m.UnassignFunc(ctx, sessionID, instructorID)
+}
+
+func (m *MockInstructorRepository) ListAssignments(ctx context.Context) ([]*models.InstructorAssignment, error) {
+	return m.ListAssignmentsFunc(ctx)
+}
+
+// MockCohortRepository is a mock implementation of CohortRepositoryInterface
+type MockCohortRepository struct {
+	CreateFunc      func(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error)
+	CreateBatchFunc func(ctx context.Context, cohorts []*models.Cohort) ([]*models.Cohort, error)
+	GetByIDFunc     func(ctx context.Context, id uuid.UUID) (*models.Cohort, error)
+	ListFunc        func(ctx context.Context) ([]*models.Cohort, error)
+	DeleteFunc      func(ctx context.Context, id uuid.UUID) error
+	UpdateFunc      func(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error)
+}
+
+var _ repository.CohortRepositoryInterface = (*MockCohortRepository)(nil)
+
+func (m *MockCohortRepository) Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error) {
+	return m.CreateFunc(ctx, cohort)
+}
+
+func (m *MockCohortRepository) CreateBatch(ctx context.Context, cohorts []*models.Cohort) ([]*models.Cohort, error) {
+	return m.CreateBatchFunc(ctx, cohorts)
+}
+
+func (m *MockCohortRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockCohortRepository) List(ctx context.Context) ([]*models.Cohort, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockCohortRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *MockCohortRepository) Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error) {
+	return m.UpdateFunc(ctx, id, updates)
+}
+
+// MockRoomUnavailabilityRepository is a mock implementation of RoomUnavailabilityRepositoryInterface
+type MockRoomUnavailabilityRepository struct {
+	CreateFunc      func(ctx context.Context, unavailability *models.RoomUnavailability) (*models.RoomUnavailability, error)
+	GetByIDFunc     func(ctx context.Context, roomID uuid.UUID, id uuid.UUID) (*models.RoomUnavailability, error)
+	GetByRoomIDFunc func(ctx context.Context, roomID uuid.UUID) ([]*models.RoomUnavailability, error)
+	ListFunc        func(ctx context.Context) ([]*models.RoomUnavailability, error)
+	DeleteFunc      func(ctx context.Context, roomID uuid.UUID, id uuid.UUID) error
+	UpdateFunc      func(ctx context.Context, roomID uuid.UUID, id uuid.UUID, updates *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error)
+}
+
+var _ repository.RoomUnavailabilityRepositoryInterface = (*MockRoomUnavailabilityRepository)(nil)
+
+func (m *MockRoomUnavailabilityRepository) Create(ctx context.Context, unavailability *models.RoomUnavailability) (*models.RoomUnavailability, error) {
+	return m.CreateFunc(ctx, unavailability)
+}
+
+func (m *MockRoomUnavailabilityRepository) GetByID(ctx context.Context, roomID uuid.UUID, id uuid.UUID) (*models.RoomUnavailability, error) {
+	return m.GetByIDFunc(ctx, roomID, id)
+}
+
+func (m *MockRoomUnavailabilityRepository) GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomUnavailability, error) {
+	return m.GetByRoomIDFunc(ctx, roomID)
+}
+
+func (m *MockRoomUnavailabilityRepository) List(ctx context.Context) ([]*models.RoomUnavailability, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockRoomUnavailabilityRepository) Delete(ctx context.Context, roomID uuid.UUID, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, roomID, id)
+}
+
+func (m *MockRoomUnavailabilityRepository) Update(ctx context.Context, roomID uuid.UUID, id uuid.UUID, updates *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error) {
+	return m.UpdateFunc(ctx, roomID, id, updates)
+}
+
+// MockInstructorAvailabilityRepository is a mock implementation of InstructorAvailabilityRepositoryInterface
+type MockInstructorAvailabilityRepository struct {
+	CreateFunc            func(ctx context.Context, availability *models.InstructorAvailability) (*models.InstructorAvailability, error)
+	GetByIDFunc           func(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) (*models.InstructorAvailability, error)
+	GetByInstructorIDFunc func(ctx context.Context, instructorID uuid.UUID) ([]*models.InstructorAvailability, error)
+	ListFunc              func(ctx context.Context) ([]*models.InstructorAvailability, error)
+	DeleteFunc            func(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) error
+	UpdateFunc            func(ctx context.Context, instructorID uuid.UUID, id uuid.UUID, updates *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error)
+}
+
+var _ repository.InstructorAvailabilityRepositoryInterface = (*MockInstructorAvailabilityRepository)(nil)
+
+func (m *MockInstructorAvailabilityRepository) Create(ctx context.Context, availability *models.InstructorAvailability) (*models.InstructorAvailability, error) {
+	return m.CreateFunc(ctx, availability)
+}
+
+func (m *MockInstructorAvailabilityRepository) GetByID(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) (*models.InstructorAvailability, error) {
+	return m.GetByIDFunc(ctx, instructorID, id)
+}
+
+func (m *MockInstructorAvailabilityRepository) GetByInstructorID(ctx context.Context, instructorID uuid.UUID) ([]*models.InstructorAvailability, error) {
+	return m.GetByInstructorIDFunc(ctx, instructorID)
+}
+
+func (m *MockInstructorAvailabilityRepository) List(ctx context.Context) ([]*models.InstructorAvailability, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockInstructorAvailabilityRepository) Delete(ctx context.Context, instructorID uuid.UUID, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, instructorID, id)
+}
+
+func (m *MockInstructorAvailabilityRepository) Update(ctx context.Context, instructorID uuid.UUID, id uuid.UUID, updates *models.InstructorAvailabilityUpdate) (*models.InstructorAvailability, error) {
+	return m.UpdateFunc(ctx, instructorID, id, updates)
+}
+
+// MockBuildingTravelTimeRepository is a mock implementation of BuildingTravelTimeRepositoryInterface
+type MockBuildingTravelTimeRepository struct {
+	SetFunc             func(ctx context.Context, travelTime *models.BuildingTravelTime) (*models.BuildingTravelTime, error)
+	GetByIDFunc         func(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) (*models.BuildingTravelTime, error)
+	GetByBuildingIDFunc func(ctx context.Context, fromBuildingID uuid.UUID) ([]*models.BuildingTravelTime, error)
+	ListFunc            func(ctx context.Context) ([]*models.BuildingTravelTime, error)
+	DeleteFunc          func(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error
+}
+
+var _ repository.BuildingTravelTimeRepositoryInterface = (*MockBuildingTravelTimeRepository)(nil)
+
+func (m *MockBuildingTravelTimeRepository) Set(ctx context.Context, travelTime *models.BuildingTravelTime) (*models.BuildingTravelTime, error) {
+	return m.SetFunc(ctx, travelTime)
+}
+
+func (m *MockBuildingTravelTimeRepository) GetByID(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) (*models.BuildingTravelTime, error) {
+	return m.GetByIDFunc(ctx, fromBuildingID, toBuildingID)
+}
+
+func (m *MockBuildingTravelTimeRepository) GetByBuildingID(ctx context.Context, fromBuildingID uuid.UUID) ([]*models.BuildingTravelTime, error) {
+	return m.GetByBuildingIDFunc(ctx, fromBuildingID)
+}
+
+func (m *MockBuildingTravelTimeRepository) List(ctx context.Context) ([]*models.BuildingTravelTime, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockBuildingTravelTimeRepository) Delete(ctx context.Context, fromBuildingID uuid.UUID, toBuildingID uuid.UUID) error {
+	return m.DeleteFunc(ctx, fromBuildingID, toBuildingID)
+}
+
+// MockSessionPinRepository is a mock implementation of SessionPinRepositoryInterface
+type MockSessionPinRepository struct {
+	CreateFunc               func(ctx context.Context, pin *models.SessionPin) (*models.SessionPin, error)
+	GetByIDFunc              func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionPin, error)
+	GetByCourseSessionIDFunc func(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionPin, error)
+	ListFunc                 func(ctx context.Context) ([]*models.SessionPin, error)
+	DeleteFunc               func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error
+	UpdateFunc               func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionPinUpdate) (*models.SessionPin, error)
+}
+
+var _ repository.SessionPinRepositoryInterface = (*MockSessionPinRepository)(nil)
+
+func (m *MockSessionPinRepository) Create(ctx context.Context, pin *models.SessionPin) (*models.SessionPin, error) {
+	return m.CreateFunc(ctx, pin)
+}
+
+func (m *MockSessionPinRepository) GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionPin, error) {
+	return m.GetByIDFunc(ctx, courseSessionID, id)
+}
+
+func (m *MockSessionPinRepository) GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionPin, error) {
+	return m.GetByCourseSessionIDFunc(ctx, courseSessionID)
+}
+
+func (m *MockSessionPinRepository) List(ctx context.Context) ([]*models.SessionPin, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockSessionPinRepository) Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, courseSessionID, id)
+}
+
+func (m *MockSessionPinRepository) Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionPinUpdate) (*models.SessionPin, error) {
+	return m.UpdateFunc(ctx, courseSessionID, id, updates)
+}
+
+// MockSessionLinkRepository is a mock implementation of SessionLinkRepositoryInterface
+type MockSessionLinkRepository struct {
+	CreateFunc               func(ctx context.Context, link *models.SessionLink) (*models.SessionLink, error)
+	GetByIDFunc              func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionLink, error)
+	GetByCourseSessionIDFunc func(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionLink, error)
+	ListFunc                 func(ctx context.Context) ([]*models.SessionLink, error)
+	DeleteFunc               func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error
+	UpdateFunc               func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionLinkUpdate) (*models.SessionLink, error)
+}
+
+var _ repository.SessionLinkRepositoryInterface = (*MockSessionLinkRepository)(nil)
+
+func (m *MockSessionLinkRepository) Create(ctx context.Context, link *models.SessionLink) (*models.SessionLink, error) {
+	return m.CreateFunc(ctx, link)
+}
+
+func (m *MockSessionLinkRepository) GetByID(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) (*models.SessionLink, error) {
+	return m.GetByIDFunc(ctx, courseSessionID, id)
+}
+
+func (m *MockSessionLinkRepository) GetByCourseSessionID(ctx context.Context, courseSessionID uuid.UUID) ([]*models.SessionLink, error) {
+	return m.GetByCourseSessionIDFunc(ctx, courseSessionID)
+}
+
+func (m *MockSessionLinkRepository) List(ctx context.Context) ([]*models.SessionLink, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockSessionLinkRepository) Delete(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, courseSessionID, id)
+}
+
+func (m *MockSessionLinkRepository) Update(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, updates *models.SessionLinkUpdate) (*models.SessionLink, error) {
+	return m.UpdateFunc(ctx, courseSessionID, id, updates)
+}
+
+// MockCourseSectionRepository is a mock implementation of CourseSectionRepositoryInterface
+type MockCourseSectionRepository struct {
+	CreateFunc        func(ctx context.Context, section *models.CourseSection) (*models.CourseSection, error)
+	GetByIDFunc       func(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (*models.CourseSection, error)
+	GetByCourseIDFunc func(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSection, error)
+	ListFunc          func(ctx context.Context) ([]*models.CourseSection, error)
+	DeleteFunc        func(ctx context.Context, courseID uuid.UUID, id uuid.UUID) error
+	UpdateFunc        func(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates *models.CourseSectionUpdate) (*models.CourseSection, error)
+}
+
+var _ repository.CourseSectionRepositoryInterface = (*MockCourseSectionRepository)(nil)
+
+func (m *MockCourseSectionRepository) Create(ctx context.Context, section *models.CourseSection) (*models.CourseSection, error) {
+	return m.CreateFunc(ctx, section)
+}
+
+func (m *MockCourseSectionRepository) GetByID(ctx context.Context, courseID uuid.UUID, id uuid.UUID) (*models.CourseSection, error) {
+	return m.GetByIDFunc(ctx, courseID, id)
+}
+
+func (m *MockCourseSectionRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSection, error) {
+	return m.GetByCourseIDFunc(ctx, courseID)
+}
+
+func (m *MockCourseSectionRepository) List(ctx context.Context) ([]*models.CourseSection, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockCourseSectionRepository) Delete(ctx context.Context, courseID uuid.UUID, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, courseID, id)
+}
+
+func (m *MockCourseSectionRepository) Update(ctx context.Context, courseID uuid.UUID, id uuid.UUID, updates *models.CourseSectionUpdate) (*models.CourseSection, error) {
+	return m.UpdateFunc(ctx, courseID, id, updates)
+}
+
+// MockSessionGroupRepository is a mock implementation of SessionGroupRepositoryInterface
+type MockSessionGroupRepository struct {
+	CreateFunc      func(ctx context.Context, group *models.SessionGroup) (*models.SessionGroup, error)
+	CreateBatchFunc func(ctx context.Context, groups []*models.SessionGroup) ([]*models.SessionGroup, error)
+	GetByIDFunc     func(ctx context.Context, id uuid.UUID) (*models.SessionGroup, error)
+	ListFunc        func(ctx context.Context) ([]*models.SessionGroup, error)
+	DeleteFunc      func(ctx context.Context, id uuid.UUID) error
+	UpdateFunc      func(ctx context.Context, id uuid.UUID, updates *models.SessionGroupUpdate) (*models.SessionGroup, error)
+}
+
+var _ repository.SessionGroupRepositoryInterface = (*MockSessionGroupRepository)(nil)
+
+func (m *MockSessionGroupRepository) Create(ctx context.Context, group *models.SessionGroup) (*models.SessionGroup, error) {
+	return m.CreateFunc(ctx, group)
+}
+
+func (m *MockSessionGroupRepository) CreateBatch(ctx context.Context, groups []*models.SessionGroup) ([]*models.SessionGroup, error) {
+	return m.CreateBatchFunc(ctx, groups)
+}
+
+func (m *MockSessionGroupRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SessionGroup, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockSessionGroupRepository) List(ctx context.Context) ([]*models.SessionGroup, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockSessionGroupRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *MockSessionGroupRepository) Update(ctx context.Context, id uuid.UUID, updates *models.SessionGroupUpdate) (*models.SessionGroup, error) {
+	return m.UpdateFunc(ctx, id, updates)
+}
@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestRoomUnavailabilityService_Create(t *testing.T) {
+	ctx := context.Background()
+	roomID := uuid.New()
+
+	invalid := []struct {
+		name           string
+		unavailability *models.RoomUnavailability
+	}{
+		{"missing room", models.NewRoomUnavailability(uuid.New(), uuid.Nil, 4, 780, 1020, nil, nil, nil)},
+		{"day below range", models.NewRoomUnavailability(uuid.New(), roomID, -1, 780, 1020, nil, nil, nil)},
+		{"day above range", models.NewRoomUnavailability(uuid.New(), roomID, 7, 780, 1020, nil, nil, nil)},
+		{"ends past midnight", models.NewRoomUnavailability(uuid.New(), roomID, 4, 780, models.MinutesPerDay+1, nil, nil, nil)},
+		{"starts before midnight", models.NewRoomUnavailability(uuid.New(), roomID, 4, -30, 600, nil, nil, nil)},
+		{"empty range", models.NewRoomUnavailability(uuid.New(), roomID, 4, 780, 780, nil, nil, nil)},
+		{"reversed range", models.NewRoomUnavailability(uuid.New(), roomID, 4, 1020, 780, nil, nil, nil)},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockRoomUnavailabilityRepository{
+				CreateFunc: func(ctx context.Context, u *models.RoomUnavailability) (*models.RoomUnavailability, error) {
+					called = true
+					return u, nil
+				},
+			}
+
+			svc := service.NewRoomUnavailabilityService(mockRepo)
+			result, err := svc.Create(ctx, tt.unavailability)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid unavailability must not reach the repository")
+		})
+	}
+
+	t.Run("whole day is allowed", func(t *testing.T) {
+		called := false
+		mockRepo := &mocks.MockRoomUnavailabilityRepository{
+			CreateFunc: func(ctx context.Context, u *models.RoomUnavailability) (*models.RoomUnavailability, error) {
+				called = true
+				return u, nil
+			},
+		}
+
+		svc := service.NewRoomUnavailabilityService(mockRepo)
+		_, err := svc.Create(ctx, models.NewRoomUnavailability(uuid.New(), roomID, 6, 0, models.MinutesPerDay, nil, nil, nil))
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
+
+func TestRoomUnavailabilityService_Update(t *testing.T) {
+	ctx := context.Background()
+	roomID := uuid.New()
+	id := uuid.New()
+
+	invalid := []struct {
+		name    string
+		updates *models.RoomUnavailabilityUpdate
+	}{
+		{"nil updates", nil},
+		{"day out of range", &models.RoomUnavailabilityUpdate{Day: ptr(int32(7))}},
+		{"start at midnight", &models.RoomUnavailabilityUpdate{StartTime: ptr(int32(models.MinutesPerDay))}},
+		{"end at zero", &models.RoomUnavailabilityUpdate{EndTime: ptr(int32(0))}},
+		{"reversed range", &models.RoomUnavailabilityUpdate{StartTime: ptr(int32(900)), EndTime: ptr(int32(840))}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockRoomUnavailabilityRepository{
+				UpdateFunc: func(ctx context.Context, roomID uuid.UUID, id uuid.UUID, u *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error) {
+					called = true
+					return nil, nil
+				},
+			}
+
+			svc := service.NewRoomUnavailabilityService(mockRepo)
+			result, err := svc.Update(ctx, roomID, id, tt.updates)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid update must not reach the repository")
+		})
+	}
+
+	t.Run("partial update", func(t *testing.T) {
+		called := false
+		mockRepo := &mocks.MockRoomUnavailabilityRepository{
+			UpdateFunc: func(ctx context.Context, reqRoomID uuid.UUID, reqID uuid.UUID, u *models.RoomUnavailabilityUpdate) (*models.RoomUnavailability, error) {
+				called = true
+				return models.NewRoomUnavailability(reqID, reqRoomID, 0, 480, *u.EndTime, nil, nil, nil), nil
+			},
+		}
+
+		svc := service.NewRoomUnavailabilityService(mockRepo)
+		_, err := svc.Update(ctx, roomID, id, &models.RoomUnavailabilityUpdate{EndTime: ptr(int32(660))})
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
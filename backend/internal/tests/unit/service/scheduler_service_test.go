@@ -10,38 +10,839 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/portfolio"
 	"github.com/TerrenceMurray/course-scheduler/internal/service"
 	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
 )
 
+// newSchedulerService builds a SchedulerService whose optional repositories return no data
+func newSchedulerService(
+	sched scheduler.Scheduler,
+	scheduleRepo *mocks.MockScheduleRepository,
+	roomRepo *mocks.MockRoomRepository,
+	courseRepo *mocks.MockCourseRepository,
+	sessionRepo *mocks.MockCourseSessionRepository,
+) *service.SchedulerService {
+	return service.NewSchedulerService(sched, scheduleRepo, roomRepo, courseRepo, sessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+}
+
+func emptyInstructorRepo() *mocks.MockInstructorRepository {
+	return &mocks.MockInstructorRepository{
+		ListAssignmentsFunc: func(ctx context.Context) ([]*models.InstructorAssignment, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptyRoomUnavailabilityRepo() *mocks.MockRoomUnavailabilityRepository {
+	return &mocks.MockRoomUnavailabilityRepository{
+		ListFunc: func(ctx context.Context) ([]*models.RoomUnavailability, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptyInstructorAvailabilityRepo() *mocks.MockInstructorAvailabilityRepository {
+	return &mocks.MockInstructorAvailabilityRepository{
+		ListFunc: func(ctx context.Context) ([]*models.InstructorAvailability, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptyTravelTimeRepo() *mocks.MockBuildingTravelTimeRepository {
+	return &mocks.MockBuildingTravelTimeRepository{
+		ListFunc: func(ctx context.Context) ([]*models.BuildingTravelTime, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptySessionPinRepo() *mocks.MockSessionPinRepository {
+	return &mocks.MockSessionPinRepository{
+		ListFunc: func(ctx context.Context) ([]*models.SessionPin, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptySessionLinkRepo() *mocks.MockSessionLinkRepository {
+	return &mocks.MockSessionLinkRepository{
+		ListFunc: func(ctx context.Context) ([]*models.SessionLink, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptyCourseSectionRepo() *mocks.MockCourseSectionRepository {
+	return &mocks.MockCourseSectionRepository{
+		ListFunc: func(ctx context.Context) ([]*models.CourseSection, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptyRoomTypeRepo() *mocks.MockRoomTypeRepository {
+	return &mocks.MockRoomTypeRepository{
+		ListFunc: func(ctx context.Context) ([]*models.RoomType, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptySessionGroupRepo() *mocks.MockSessionGroupRepository {
+	return &mocks.MockSessionGroupRepository{
+		ListFunc: func(ctx context.Context) ([]*models.SessionGroup, error) {
+			return nil, nil
+		},
+	}
+}
+
+func emptyCohortRepo() *mocks.MockCohortRepository {
+	return &mocks.MockCohortRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+			return nil, nil
+		},
+	}
+}
+
 func TestSchedulerService_Generate(t *testing.T) {
 	ctx := context.Background()
 
-	roomID := uuid.New()
-	courseID := uuid.New()
-	sessionID := uuid.New()
+	roomID := uuid.New()
+	courseID := uuid.New()
+	sessionID := uuid.New()
+
+	rooms := []*models.Room{
+		{ID: roomID, Name: "Room 101", Type: "lecture_room", Capacity: 100},
+	}
+	courses := []models.Course{
+		{ID: courseID, Name: "CS 101"},
+	}
+	sessions := []*models.CourseSession{
+		{ID: sessionID, CourseID: courseID, RequiredRoom: "lecture_room", Type: "lecture", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(1))},
+	}
+
+	scheduledSessions := []*models.ScheduledSession{
+		{CourseID: courseID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				return &scheduler.Output{
+					ScheduledSessions: scheduledSessions,
+					Failures:          []*scheduler.FailedSession{},
+				}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+		assert.Empty(t, output.Failures)
+		require.NotNil(t, output.Score, "Generated output should be scored")
+		assert.NotEmpty(t, output.Score.Breakdown)
+	})
+
+	t.Run("error fetching rooms", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{}
+		mockSessionRepo := &mocks.MockCourseSessionRepository{}
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch rooms")
+	})
+
+	t.Run("error fetching courses", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{}
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch courses")
+	})
+
+	t.Run("named strategy", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				t.Fatal("A named strategy should run its own greedy pass")
+				return nil, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		svc := newSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		output, err := svc.Generate(ctx, nil, nil, "scarcity", nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+		assert.Empty(t, output.Failures)
+	})
+
+	t.Run("portfolio strategy", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				t.Fatal("The portfolio should run its own greedy passes")
+				return nil, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		svc := newSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		output, err := svc.Generate(ctx, nil, nil, service.PortfolioStrategy, nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+		assert.Empty(t, output.Failures)
+		assert.Len(t, output.Variants, len(svc.Strategies())*len(scheduler.RoomSelections)*(1+portfolio.DefaultConfig().Seeds))
+	})
+
+	t.Run("passes context and progress to the scheduler", func(t *testing.T) {
+		type key struct{}
+		requestCtx := context.WithValue(ctx, key{}, "request")
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				assert.Equal(t, "request", ctx.Value(key{}))
+				input.ReportProgress(1, input.Meetings())
+				return &scheduler.Output{ScheduledSessions: scheduledSessions}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		var reports []scheduler.Progress
+		svc := newSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		_, err := svc.Generate(requestCtx, nil, nil, "", func(p scheduler.Progress) {
+			reports = append(reports, p)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []scheduler.Progress{{Placed: 1, Total: 1}}, reports)
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		svc := newSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, &mocks.MockRoomRepository{}, &mocks.MockCourseRepository{}, &mocks.MockCourseSessionRepository{})
+		output, err := svc.Generate(ctx, nil, nil, "alphabetical", nil)
+
+		require.ErrorIs(t, err, service.ErrUnknownStrategy)
+		assert.Nil(t, output)
+	})
+
+	t.Run("error fetching sessions", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch sessions")
+	})
+
+	t.Run("passes instructor assignments", func(t *testing.T) {
+		instructorID := uuid.New()
+		assignments := []*models.InstructorAssignment{
+			{CourseSessionID: sessionID, InstructorID: instructorID},
+		}
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.InstructorAssignments, 1)
+				assert.Equal(t, instructorID, input.InstructorAssignments[0].InstructorID)
+				return &scheduler.Output{ScheduledSessions: scheduledSessions}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListAssignmentsFunc: func(ctx context.Context) ([]*models.InstructorAssignment, error) {
+				return assignments, nil
+			},
+		}
+
+		svc := service.NewSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockInstructorRepo, emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+	})
+
+	t.Run("error fetching instructor assignments", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListAssignmentsFunc: func(ctx context.Context) ([]*models.InstructorAssignment, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockInstructorRepo, emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch instructor assignments")
+	})
+
+	t.Run("error fetching instructor availability", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockAvailabilityRepo := &mocks.MockInstructorAvailabilityRepository{
+			ListFunc: func(ctx context.Context) ([]*models.InstructorAvailability, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), mockAvailabilityRepo, emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch instructor availability")
+	})
+
+	t.Run("error fetching building travel times", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockTravelTimeRepo := &mocks.MockBuildingTravelTimeRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingTravelTime, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), mockTravelTimeRepo, emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch building travel times")
+	})
+
+	t.Run("passes cohorts", func(t *testing.T) {
+		cohorts := []*models.Cohort{
+			{ID: uuid.New(), Name: "Year 2 Computer Science", CourseIDs: []uuid.UUID{courseID}},
+		}
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.Cohorts, 1)
+				assert.Equal(t, cohorts[0].ID, input.Cohorts[0].ID)
+				return &scheduler.Output{ScheduledSessions: scheduledSessions}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return cohorts, nil
+			},
+		}
+
+		svc := service.NewSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), mockCohortRepo, emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+	})
+
+	t.Run("error fetching cohorts", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), mockCohortRepo, emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch cohorts")
+	})
+
+	t.Run("passes stored and requested pins", func(t *testing.T) {
+		stored := models.NewSessionPin(uuid.New(), sessionID, roomID, 0, 540, nil, nil)
+		requested := models.NewSessionPin(uuid.New(), sessionID, roomID, 2, 600, nil, nil)
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.Pins, 2)
+				assert.Equal(t, stored.ID, input.Pins[0].ID)
+				assert.Equal(t, requested.ID, input.Pins[1].ID)
+				return &scheduler.Output{ScheduledSessions: scheduledSessions}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockPinRepo := &mocks.MockSessionPinRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionPin, error) {
+				return []*models.SessionPin{stored}, nil
+			},
+		}
+
+		svc := service.NewSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), mockPinRepo, emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, []*models.SessionPin{requested}, "", nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+	})
+
+	t.Run("drops a requested pin repeating a stored one", func(t *testing.T) {
+		stored := models.NewSessionPin(uuid.New(), sessionID, roomID, 0, 540, nil, nil)
+		repeated := models.NewSessionPin(uuid.New(), sessionID, roomID, 0, 540, nil, nil)
+		requested := models.NewSessionPin(uuid.New(), sessionID, roomID, 2, 600, nil, nil)
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.Pins, 2)
+				assert.Equal(t, stored.ID, input.Pins[0].ID)
+				assert.Equal(t, requested.ID, input.Pins[1].ID)
+				return &scheduler.Output{ScheduledSessions: scheduledSessions}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockPinRepo := &mocks.MockSessionPinRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionPin, error) {
+				return []*models.SessionPin{stored}, nil
+			},
+		}
+
+		svc := service.NewSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), mockPinRepo, emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, []*models.SessionPin{repeated, requested}, "", nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+	})
+
+	t.Run("a requested pin replaces the stored pin with its ID", func(t *testing.T) {
+		stored := models.NewSessionPin(uuid.New(), sessionID, roomID, 0, 540, nil, nil)
+		moved := models.NewSessionPin(stored.ID, sessionID, roomID, 3, 660, nil, nil)
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.Pins, 1)
+				assert.Equal(t, int32(3), input.Pins[0].Day)
+				assert.Equal(t, int32(660), input.Pins[0].StartTime)
+				return &scheduler.Output{ScheduledSessions: scheduledSessions}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockPinRepo := &mocks.MockSessionPinRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionPin, error) {
+				return []*models.SessionPin{stored}, nil
+			},
+		}
+
+		svc := service.NewSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), mockPinRepo, emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, []*models.SessionPin{moved}, "", nil)
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+	})
+
+	t.Run("error fetching session pins", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockPinRepo := &mocks.MockSessionPinRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionPin, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), mockPinRepo, emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch session pins")
+	})
+
+	t.Run("error fetching session links", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockLinkRepo := &mocks.MockSessionLinkRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionLink, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), mockLinkRepo, emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
 
-	rooms := []*models.Room{
-		{ID: roomID, Name: "Room 101", Type: "lecture_room", Capacity: 100},
-	}
-	courses := []models.Course{
-		{ID: courseID, Name: "CS 101"},
-	}
-	sessions := []*models.CourseSession{
-		{ID: sessionID, CourseID: courseID, RequiredRoom: "lecture_room", Type: "lecture", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(1))},
-	}
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch session links")
+	})
 
-	scheduledSessions := []*models.ScheduledSession{
-		{CourseID: courseID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
-	}
+	t.Run("error fetching course sections", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockSectionRepo := &mocks.MockCourseSectionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSection, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), mockSectionRepo, emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch course sections")
+	})
+
+	t.Run("schedules each section", func(t *testing.T) {
+		instructorID := uuid.New()
+		sectionA := models.NewCourseSection(uuid.New(), courseID, "Section A", ptr(int32(30)), nil, nil, nil)
+		sectionB := models.NewCourseSection(uuid.New(), courseID, "Section B", nil, &instructorID, nil, nil)
 
-	t.Run("success", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.CourseSessions, 2)
+				a, b := input.CourseSessions[0], input.CourseSessions[1]
+				assert.Equal(t, sectionA.ID, a.SectionID)
+				assert.Equal(t, int32(30), *a.Enrollment)
+				assert.Equal(t, sectionB.ID, b.SectionID)
+				assert.NotEqual(t, sessionID, a.ID, "Each section should get its own copy of the session")
+				assert.NotEqual(t, a.ID, b.ID)
+
+				require.Len(t, input.InstructorAssignments, 1)
+				assert.Equal(t, b.ID, input.InstructorAssignments[0].CourseSessionID)
+				assert.Equal(t, instructorID, input.InstructorAssignments[0].InstructorID)
+
 				return &scheduler.Output{
-					ScheduledSessions: scheduledSessions,
-					Failures:          []*scheduler.FailedSession{},
+					ScheduledSessions: []*models.ScheduledSession{
+						{CourseID: courseID, CourseSessionID: a.ID, SectionID: a.SectionID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+					},
+					Failures: []*scheduler.FailedSession{
+						{CourseSession: b, Reason: scheduler.ReasonNoTimeSlot},
+					},
 				}, nil
 			},
 		}
@@ -64,39 +865,99 @@ func TestSchedulerService_Generate(t *testing.T) {
 			},
 		}
 
-		mockScheduleRepo := &mocks.MockScheduleRepository{}
+		mockSectionRepo := &mocks.MockCourseSectionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSection, error) {
+				return []*models.CourseSection{sectionA, sectionB}, nil
+			},
+		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		output, err := svc.Generate(ctx, nil)
+		svc := service.NewSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), mockSectionRepo, emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
 
 		require.NoError(t, err)
-		assert.Len(t, output.ScheduledSessions, 1)
-		assert.Empty(t, output.Failures)
+		require.Len(t, output.ScheduledSessions, 1)
+		assert.Equal(t, sessionID, output.ScheduledSessions[0].CourseSessionID, "Meetings should refer to the stored session")
+		assert.Equal(t, sectionA.ID, output.ScheduledSessions[0].SectionID)
+
+		require.Len(t, output.Failures, 1)
+		assert.Equal(t, sessionID, output.Failures[0].CourseSession.ID)
+		assert.Equal(t, sectionB.ID, output.Failures[0].CourseSession.SectionID)
 	})
 
-	t.Run("error fetching rooms", func(t *testing.T) {
-		mockScheduler := &mocks.MockScheduler{}
+	t.Run("schedules each session group once", func(t *testing.T) {
+		gradCourseID, gradSessionID := uuid.New(), uuid.New()
+		group := models.NewSessionGroup(uuid.New(), "CS 101 / CS 601", []uuid.UUID{sessionID, gradSessionID}, nil, nil)
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.CourseSessions, 1, "The group should be scheduled as one session")
+				combined := input.CourseSessions[0]
+				assert.Equal(t, group.ID, combined.ID)
+				assert.Equal(t, int32(50), *combined.Enrollment)
+
+				return &scheduler.Output{
+					ScheduledSessions: []*models.ScheduledSession{
+						{CourseID: combined.CourseID, CourseSessionID: combined.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+					},
+				}, nil
+			},
+		}
 
 		mockRoomRepo := &mocks.MockRoomRepository{
 			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
-				return nil, errors.New("database error")
+				return rooms, nil
 			},
 		}
 
-		mockCourseRepo := &mocks.MockCourseRepository{}
-		mockSessionRepo := &mocks.MockCourseSessionRepository{}
-		mockScheduleRepo := &mocks.MockScheduleRepository{}
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return []models.Course{
+					{ID: courseID, Name: "CS 101", Enrollment: 40},
+					{ID: gradCourseID, Name: "CS 601", Enrollment: 10},
+				}, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				grad := *sessions[0]
+				grad.ID, grad.CourseID = gradSessionID, gradCourseID
+				return []*models.CourseSession{sessions[0], &grad}, nil
+			},
+		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		output, err := svc.Generate(ctx, nil)
+		mockGroupRepo := &mocks.MockSessionGroupRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionGroup, error) {
+				return []*models.SessionGroup{group}, nil
+			},
+		}
 
-		require.Error(t, err)
-		assert.Nil(t, output)
-		assert.Contains(t, err.Error(), "failed to fetch rooms")
+		svc := service.NewSchedulerService(mockScheduler, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), mockGroupRepo)
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
+
+		require.NoError(t, err)
+		require.Len(t, output.ScheduledSessions, 1)
+		assert.Equal(t, sessionID, output.ScheduledSessions[0].CourseSessionID, "Meetings should refer to the group's first session")
+		assert.Equal(t, courseID, output.ScheduledSessions[0].CourseID)
+		assert.Equal(t, group.ID, output.ScheduledSessions[0].GroupID)
+		assert.Equal(t, []uuid.UUID{courseID, gradCourseID}, output.ScheduledSessions[0].CourseIDs)
 	})
 
-	t.Run("error fetching courses", func(t *testing.T) {
-		mockScheduler := &mocks.MockScheduler{}
+	t.Run("session group mismatch", func(t *testing.T) {
+		otherSession := *sessions[0]
+		otherSession.ID, otherSession.Duration = uuid.New(), ptr(int32(90))
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return []*models.CourseSession{sessions[0], &otherSession}, nil
+			},
+		}
+
+		mockGroupRepo := &mocks.MockSessionGroupRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionGroup, error) {
+				return []*models.SessionGroup{models.NewSessionGroup(uuid.New(), "CS 101 / CS 601", []uuid.UUID{sessionID, otherSession.ID}, nil, nil)}, nil
+			},
+		}
 
 		mockRoomRepo := &mocks.MockRoomRepository{
 			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
@@ -106,24 +967,18 @@ func TestSchedulerService_Generate(t *testing.T) {
 
 		mockCourseRepo := &mocks.MockCourseRepository{
 			ListFunc: func(ctx context.Context) ([]models.Course, error) {
-				return nil, errors.New("database error")
+				return courses, nil
 			},
 		}
 
-		mockSessionRepo := &mocks.MockCourseSessionRepository{}
-		mockScheduleRepo := &mocks.MockScheduleRepository{}
-
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		output, err := svc.Generate(ctx, nil)
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), emptyRoomUnavailabilityRepo(), emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), mockGroupRepo)
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
 
-		require.Error(t, err)
+		require.ErrorIs(t, err, scheduler.ErrSessionGroupMismatch)
 		assert.Nil(t, output)
-		assert.Contains(t, err.Error(), "failed to fetch courses")
 	})
 
-	t.Run("error fetching sessions", func(t *testing.T) {
-		mockScheduler := &mocks.MockScheduler{}
-
+	t.Run("error fetching room unavailability", func(t *testing.T) {
 		mockRoomRepo := &mocks.MockRoomRepository{
 			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
 				return rooms, nil
@@ -138,23 +993,27 @@ func TestSchedulerService_Generate(t *testing.T) {
 
 		mockSessionRepo := &mocks.MockCourseSessionRepository{
 			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
-				return nil, errors.New("database error")
+				return sessions, nil
 			},
 		}
 
-		mockScheduleRepo := &mocks.MockScheduleRepository{}
+		mockUnavailabilityRepo := &mocks.MockRoomUnavailabilityRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomUnavailability, error) {
+				return nil, errors.New("database error")
+			},
+		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		output, err := svc.Generate(ctx, nil)
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, emptyInstructorRepo(), emptyCohortRepo(), mockUnavailabilityRepo, emptyInstructorAvailabilityRepo(), emptyTravelTimeRepo(), emptySessionPinRepo(), emptySessionLinkRepo(), emptyCourseSectionRepo(), emptyRoomTypeRepo(), emptySessionGroupRepo())
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
 
 		require.Error(t, err)
 		assert.Nil(t, output)
-		assert.Contains(t, err.Error(), "failed to fetch sessions")
+		assert.Contains(t, err.Error(), "failed to fetch room unavailability")
 	})
 
 	t.Run("scheduler error", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return nil, errors.New("scheduling failed")
 			},
 		}
@@ -179,8 +1038,8 @@ func TestSchedulerService_Generate(t *testing.T) {
 
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		output, err := svc.Generate(ctx, nil)
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		output, err := svc.Generate(ctx, nil, nil, "", nil)
 
 		require.Error(t, err)
 		assert.Nil(t, output)
@@ -210,7 +1069,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return &scheduler.Output{
 					ScheduledSessions: scheduledSessions,
 					Failures:          []*scheduler.FailedSession{},
@@ -244,8 +1103,8 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil, nil, "", nil)
 
 		require.NoError(t, err)
 		assert.NotNil(t, schedule)
@@ -255,9 +1114,52 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 		assert.Empty(t, output.Failures)
 	})
 
+	t.Run("canceled before saving", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				cancel()
+				return &scheduler.Output{Status: scheduler.StatusCanceled}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
+				t.Fatal("A partial schedule should not be saved")
+				return nil, nil
+			},
+		}
+
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		schedule, output, err := svc.GenerateAndSave(canceledCtx, "Fall 2025", nil, nil, "", nil)
+
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, schedule)
+		assert.Equal(t, scheduler.StatusCanceled, output.Status)
+	})
+
 	t.Run("generate error", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return nil, errors.New("scheduling failed")
 			},
 		}
@@ -282,8 +1184,8 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil, nil, "", nil)
 
 		require.Error(t, err)
 		assert.Nil(t, schedule)
@@ -293,7 +1195,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 
 	t.Run("save error returns output", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return &scheduler.Output{
 					ScheduledSessions: scheduledSessions,
 					Failures:          []*scheduler.FailedSession{},
@@ -325,8 +1227,8 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil, nil, "", nil)
 
 		require.Error(t, err)
 		assert.Nil(t, schedule)
@@ -345,7 +1247,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 		}
 
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				// Verify config is passed through
 				assert.Equal(t, config, input.Config)
 				return &scheduler.Output{
@@ -379,8 +1281,8 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", config)
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", config, nil, "", nil)
 
 		require.NoError(t, err)
 		assert.NotNil(t, schedule)
@@ -395,7 +1297,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 		}
 
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return &scheduler.Output{
 					ScheduledSessions: scheduledSessions,
 					Failures: []*scheduler.FailedSession{
@@ -429,8 +1331,8 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
-		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
+		svc := newSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil, nil, "", nil)
 
 		require.NoError(t, err)
 		assert.NotNil(t, schedule)
@@ -439,3 +1341,239 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 		assert.Equal(t, "no available slot", output.Failures[0].Reason)
 	})
 }
+
+func TestSchedulerService_Strategies(t *testing.T) {
+	svc := newSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, &mocks.MockRoomRepository{}, &mocks.MockCourseRepository{}, &mocks.MockCourseSessionRepository{})
+
+	strategies := svc.Strategies()
+
+	names := make([]string, len(strategies))
+	for i, s := range strategies {
+		names[i] = s.Name
+		assert.NotEmpty(t, s.Description)
+	}
+	assert.Equal(t, []string{"total_time", "scarcity", "sessions_per_week", "priority"}, names)
+}
+
+func TestSchedulerService_Score(t *testing.T) {
+	ctx := context.Background()
+
+	roomID := uuid.New()
+	courseID := uuid.New()
+	scheduleID := uuid.New()
+
+	rooms := []*models.Room{
+		{ID: roomID, Name: "Room 101", Type: "lecture_room", Capacity: 100},
+	}
+	courses := []models.Course{
+		{ID: courseID, Name: "CS 101", Enrollment: 25},
+	}
+
+	schedule := &models.Schedule{
+		ID:   scheduleID,
+		Name: "Fall 2025",
+		Sessions: []models.ScheduledSession{
+			{CourseID: courseID, RoomID: roomID, Day: 0, StartTime: 1020, EndTime: 1140},
+		},
+	}
+
+	mockRoomRepo := &mocks.MockRoomRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+			return rooms, nil
+		},
+	}
+
+	mockCourseRepo := &mocks.MockCourseRepository{
+		ListFunc: func(ctx context.Context) ([]models.Course, error) {
+			return courses, nil
+		},
+	}
+
+	mockSessionRepo := &mocks.MockCourseSessionRepository{
+		ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+			return nil, nil
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				assert.Equal(t, scheduleID, id)
+				return schedule, nil
+			},
+		}
+
+		svc := newSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		result, err := svc.Score(ctx, scheduleID)
+
+		require.NoError(t, err)
+		require.Len(t, result.Breakdown, 5)
+
+		breakdown := make(map[string]*scheduler.ConstraintScore)
+		for _, cs := range result.Breakdown {
+			breakdown[cs.Name] = cs
+		}
+		assert.InDelta(t, 2.0, breakdown["late_sessions"].Penalty, 0.001, "Two hours after 17:00")
+		assert.InDelta(t, 0.75, breakdown["wasted_capacity"].Penalty, 0.001, "25 students in 100 seats")
+		assert.InDelta(t, 2.0, breakdown["unbalanced_days"].Penalty, 0.001, "Two hours on Monday, none on other days")
+		assert.Zero(t, breakdown["idle_gaps"].Penalty)
+		assert.Zero(t, breakdown["session_preferences"].Penalty)
+		assert.InDelta(t, 2*2.0+0.75+2.0, result.Total, 0.001)
+	})
+
+	t.Run("schedule not found", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := newSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		result, err := svc.Score(ctx, scheduleID)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error fetching rooms", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return schedule, nil
+			},
+		}
+
+		failingRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return nil, errors.New("db error")
+			},
+		}
+
+		svc := newSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, failingRoomRepo, mockCourseRepo, mockSessionRepo)
+		result, err := svc.Score(ctx, scheduleID)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSchedulerService_Repair(t *testing.T) {
+	ctx := context.Background()
+
+	roomA := &models.Room{ID: uuid.New(), Name: "Room A", Type: "lecture_room", Capacity: 50}
+	roomB := &models.Room{ID: uuid.New(), Name: "Room B", Type: "lecture_room", Capacity: 50}
+	courseID := uuid.New()
+	scheduleID := uuid.New()
+	duration, meetings := int32(60), int32(1)
+	session := &models.CourseSession{ID: uuid.New(), CourseID: courseID, RequiredRoom: "lecture_room", Duration: &duration, NumberOfSessions: &meetings}
+
+	schedule := &models.Schedule{
+		ID:   scheduleID,
+		Name: "Fall 2025",
+		Sessions: []models.ScheduledSession{
+			{CourseID: courseID, CourseSessionID: session.ID, RoomID: roomA.ID, Day: 0, StartTime: 540, EndTime: 600},
+		},
+	}
+
+	roomRepo := func(rooms ...*models.Room) *mocks.MockRoomRepository {
+		return &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+	}
+
+	mockCourseRepo := &mocks.MockCourseRepository{
+		ListFunc: func(ctx context.Context) ([]models.Course, error) {
+			return []models.Course{{ID: courseID, Name: "CS 101", Enrollment: 30}}, nil
+		},
+	}
+
+	mockSessionRepo := &mocks.MockCourseSessionRepository{
+		ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+			return []*models.CourseSession{session}, nil
+		},
+	}
+
+	t.Run("moves sessions out of deleted rooms and saves", func(t *testing.T) {
+		var updated *models.ScheduleUpdate
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				assert.Equal(t, scheduleID, id)
+				return schedule, nil
+			},
+			UpdateFunc: func(ctx context.Context, id uuid.UUID, updates *models.ScheduleUpdate) (*models.Schedule, error) {
+				updated = updates
+				return &models.Schedule{ID: id, Name: schedule.Name, Sessions: updates.Sessions}, nil
+			},
+		}
+
+		svc := newSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, roomRepo(roomB), mockCourseRepo, mockSessionRepo)
+		saved, result, err := svc.Repair(ctx, scheduleID, nil)
+
+		require.NoError(t, err)
+		require.Len(t, result.Changes, 1)
+		assert.Equal(t, roomA.ID, result.Changes[0].Old.RoomID)
+		assert.Equal(t, roomB.ID, result.Changes[0].New.RoomID)
+		assert.Equal(t, 540, result.Changes[0].New.StartTime, "The session should keep its time in the other room")
+		assert.NotNil(t, result.Output.Score)
+
+		require.NotNil(t, updated, "The repaired schedule should be saved")
+		require.Len(t, updated.Sessions, 1)
+		assert.Equal(t, roomB.ID, updated.Sessions[0].RoomID)
+		assert.Equal(t, roomB.ID, saved.Sessions[0].RoomID)
+	})
+
+	t.Run("nothing to repair", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return schedule, nil
+			},
+			UpdateFunc: func(ctx context.Context, id uuid.UUID, updates *models.ScheduleUpdate) (*models.Schedule, error) {
+				t.Fatal("An unchanged schedule should not be saved")
+				return nil, nil
+			},
+		}
+
+		svc := newSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, roomRepo(roomA, roomB), mockCourseRepo, mockSessionRepo)
+		saved, result, err := svc.Repair(ctx, scheduleID, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Changes)
+		assert.Equal(t, schedule, saved)
+	})
+
+	t.Run("schedule not found", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := newSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, roomRepo(roomA), mockCourseRepo, mockSessionRepo)
+		saved, result, err := svc.Repair(ctx, scheduleID, nil)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, saved)
+		assert.Nil(t, result)
+	})
+
+	t.Run("save error returns the repair", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return schedule, nil
+			},
+			UpdateFunc: func(ctx context.Context, id uuid.UUID, updates *models.ScheduleUpdate) (*models.Schedule, error) {
+				return nil, errors.New("db error")
+			},
+		}
+
+		svc := newSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, roomRepo(roomB), mockCourseRepo, mockSessionRepo)
+		saved, result, err := svc.Repair(ctx, scheduleID, nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to save repaired schedule")
+		assert.Nil(t, saved)
+		require.NotNil(t, result)
+		assert.Len(t, result.Changes, 1)
+	})
+}
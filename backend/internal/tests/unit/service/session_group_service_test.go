@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestSessionGroupService_Create(t *testing.T) {
+	ctx := context.Background()
+	group := &models.SessionGroup{ID: uuid.New(), Name: "Databases (COMP 3161 / COMP 6161)", CourseSessionIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			CreateFunc: func(ctx context.Context, i *models.SessionGroup) (*models.SessionGroup, error) {
+				return group, nil
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		result, err := svc.Create(ctx, group)
+
+		require.NoError(t, err)
+		assert.Equal(t, group.ID, result.ID)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			CreateFunc: func(ctx context.Context, i *models.SessionGroup) (*models.SessionGroup, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		result, err := svc.Create(ctx, group)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSessionGroupService_CreateBatch(t *testing.T) {
+	ctx := context.Background()
+	groups := []*models.SessionGroup{
+		{ID: uuid.New(), Name: "Algorithms (COMP 2211 / COMP 6211)"},
+		{ID: uuid.New(), Name: "Databases (COMP 3161 / COMP 6161)"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			CreateBatchFunc: func(ctx context.Context, i []*models.SessionGroup) ([]*models.SessionGroup, error) {
+				return groups, nil
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		result, err := svc.CreateBatch(ctx, groups)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestSessionGroupService_GetByID(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	group := &models.SessionGroup{ID: id, Name: "Algorithms (COMP 2211 / COMP 6211)"}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.SessionGroup, error) {
+				return group, nil
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.NoError(t, err)
+		assert.Equal(t, id, result.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.SessionGroup, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSessionGroupService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionGroup, error) {
+				return []*models.SessionGroup{{ID: uuid.New(), Name: "Algorithms (COMP 2211 / COMP 6211)"}}, nil
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		result, err := svc.List(ctx)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestSessionGroupService_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				return nil
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		err := svc.Delete(ctx, id)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestSessionGroupService_Update(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	sessionIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	updates := &models.SessionGroupUpdate{CourseSessionIDs: &sessionIDs}
+	updated := &models.SessionGroup{ID: id, Name: "Databases (COMP 3161 / COMP 6161)", CourseSessionIDs: sessionIDs}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionGroupRepository{
+			UpdateFunc: func(ctx context.Context, reqID uuid.UUID, u *models.SessionGroupUpdate) (*models.SessionGroup, error) {
+				return updated, nil
+			},
+		}
+
+		svc := service.NewSessionGroupService(mockRepo)
+		result, err := svc.Update(ctx, id, updates)
+
+		require.NoError(t, err)
+		assert.Equal(t, sessionIDs, result.CourseSessionIDs)
+	})
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestSessionLinkService_Create(t *testing.T) {
+	ctx := context.Background()
+	anchorID := uuid.New()
+	otherID := uuid.New()
+
+	invalid := []struct {
+		name string
+		link *models.SessionLink
+	}{
+		{"missing anchor", models.NewSessionLink(uuid.New(), uuid.Nil, otherID, models.SessionLinkBefore, nil, nil)},
+		{"missing other session", models.NewSessionLink(uuid.New(), anchorID, uuid.Nil, models.SessionLinkBefore, nil, nil)},
+		{"linked to itself", models.NewSessionLink(uuid.New(), anchorID, anchorID, models.SessionLinkSameDay, nil, nil)},
+		{"unknown kind", models.NewSessionLink(uuid.New(), anchorID, otherID, "after", nil, nil)},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockSessionLinkRepository{
+				CreateFunc: func(ctx context.Context, l *models.SessionLink) (*models.SessionLink, error) {
+					called = true
+					return l, nil
+				},
+			}
+
+			svc := service.NewSessionLinkService(mockRepo)
+			result, err := svc.Create(ctx, tt.link)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid link must not reach the repository")
+		})
+	}
+
+	kinds := []string{
+		models.SessionLinkBefore,
+		models.SessionLinkSameDay,
+		models.SessionLinkDifferentDay,
+		models.SessionLinkConsecutive,
+		models.SessionLinkSameRoom,
+	}
+	for _, kind := range kinds {
+		t.Run("accepts "+kind, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockSessionLinkRepository{
+				CreateFunc: func(ctx context.Context, l *models.SessionLink) (*models.SessionLink, error) {
+					called = true
+					return l, nil
+				},
+			}
+
+			svc := service.NewSessionLinkService(mockRepo)
+			_, err := svc.Create(ctx, models.NewSessionLink(uuid.New(), anchorID, otherID, kind, nil, nil))
+
+			require.NoError(t, err)
+			assert.True(t, called)
+		})
+	}
+}
+
+func TestSessionLinkService_Update(t *testing.T) {
+	ctx := context.Background()
+	anchorID := uuid.New()
+	id := uuid.New()
+
+	invalid := []struct {
+		name    string
+		updates *models.SessionLinkUpdate
+	}{
+		{"nil updates", nil},
+		{"empty other session", &models.SessionLinkUpdate{OtherSessionID: ptr(uuid.Nil)}},
+		{"retarget onto the anchor", &models.SessionLinkUpdate{OtherSessionID: ptr(anchorID)}},
+		{"unknown kind", &models.SessionLinkUpdate{Kind: ptr("after")}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockSessionLinkRepository{
+				UpdateFunc: func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, u *models.SessionLinkUpdate) (*models.SessionLink, error) {
+					called = true
+					return nil, nil
+				},
+			}
+
+			svc := service.NewSessionLinkService(mockRepo)
+			result, err := svc.Update(ctx, anchorID, id, tt.updates)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid update must not reach the repository")
+		})
+	}
+
+	t.Run("retarget onto another session", func(t *testing.T) {
+		otherID := uuid.New()
+		called := false
+		mockRepo := &mocks.MockSessionLinkRepository{
+			UpdateFunc: func(ctx context.Context, reqAnchorID uuid.UUID, reqID uuid.UUID, u *models.SessionLinkUpdate) (*models.SessionLink, error) {
+				called = true
+				return models.NewSessionLink(reqID, reqAnchorID, *u.OtherSessionID, models.SessionLinkBefore, nil, nil), nil
+			},
+		}
+
+		svc := service.NewSessionLinkService(mockRepo)
+		_, err := svc.Update(ctx, anchorID, id, &models.SessionLinkUpdate{OtherSessionID: ptr(otherID)})
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
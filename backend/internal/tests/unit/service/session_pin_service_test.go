@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestSessionPinService_Create(t *testing.T) {
+	ctx := context.Background()
+	sessionID := uuid.New()
+	roomID := uuid.New()
+
+	invalid := []struct {
+		name string
+		pin  *models.SessionPin
+	}{
+		{"missing session", models.NewSessionPin(uuid.New(), uuid.Nil, roomID, 1, 540, nil, nil)},
+		{"missing room", models.NewSessionPin(uuid.New(), sessionID, uuid.Nil, 1, 540, nil, nil)},
+		{"day below range", models.NewSessionPin(uuid.New(), sessionID, roomID, -1, 540, nil, nil)},
+		{"day above range", models.NewSessionPin(uuid.New(), sessionID, roomID, 7, 540, nil, nil)},
+		{"negative start", models.NewSessionPin(uuid.New(), sessionID, roomID, 1, -15, nil, nil)},
+		{"start at midnight", models.NewSessionPin(uuid.New(), sessionID, roomID, 1, models.MinutesPerDay, nil, nil)},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockSessionPinRepository{
+				CreateFunc: func(ctx context.Context, p *models.SessionPin) (*models.SessionPin, error) {
+					called = true
+					return p, nil
+				},
+			}
+
+			svc := service.NewSessionPinService(mockRepo)
+			result, err := svc.Create(ctx, tt.pin)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid pin must not reach the repository")
+		})
+	}
+
+	t.Run("edges of the week", func(t *testing.T) {
+		for _, pin := range []*models.SessionPin{
+			models.NewSessionPin(uuid.New(), sessionID, roomID, 0, 0, nil, nil),
+			models.NewSessionPin(uuid.New(), sessionID, roomID, 6, models.MinutesPerDay-1, nil, nil),
+		} {
+			called := false
+			mockRepo := &mocks.MockSessionPinRepository{
+				CreateFunc: func(ctx context.Context, p *models.SessionPin) (*models.SessionPin, error) {
+					called = true
+					return p, nil
+				},
+			}
+
+			svc := service.NewSessionPinService(mockRepo)
+			_, err := svc.Create(ctx, pin)
+
+			require.NoError(t, err)
+			assert.True(t, called)
+		}
+	})
+}
+
+func TestSessionPinService_Update(t *testing.T) {
+	ctx := context.Background()
+	sessionID := uuid.New()
+	id := uuid.New()
+
+	invalid := []struct {
+		name    string
+		updates *models.SessionPinUpdate
+	}{
+		{"nil updates", nil},
+		{"empty room", &models.SessionPinUpdate{RoomID: ptr(uuid.Nil)}},
+		{"day out of range", &models.SessionPinUpdate{Day: ptr(int32(7))}},
+		{"start out of range", &models.SessionPinUpdate{StartTime: ptr(int32(models.MinutesPerDay))}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			mockRepo := &mocks.MockSessionPinRepository{
+				UpdateFunc: func(ctx context.Context, courseSessionID uuid.UUID, id uuid.UUID, u *models.SessionPinUpdate) (*models.SessionPin, error) {
+					called = true
+					return nil, nil
+				},
+			}
+
+			svc := service.NewSessionPinService(mockRepo)
+			result, err := svc.Update(ctx, sessionID, id, tt.updates)
+
+			require.ErrorIs(t, err, repository.ErrInvalidInput)
+			assert.Nil(t, result)
+			assert.False(t, called, "an invalid update must not reach the repository")
+		})
+	}
+
+	t.Run("move to another day", func(t *testing.T) {
+		called := false
+		mockRepo := &mocks.MockSessionPinRepository{
+			UpdateFunc: func(ctx context.Context, reqSessionID uuid.UUID, reqID uuid.UUID, u *models.SessionPinUpdate) (*models.SessionPin, error) {
+				called = true
+				return models.NewSessionPin(reqID, reqSessionID, uuid.New(), *u.Day, 540, nil, nil), nil
+			},
+		}
+
+		svc := service.NewSessionPinService(mockRepo)
+		_, err := svc.Update(ctx, sessionID, id, &models.SessionPinUpdate{Day: ptr(int32(4))})
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
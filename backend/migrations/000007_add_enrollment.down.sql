@@ -0,0 +1,8 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP CONSTRAINT IF EXISTS CHK_CourseSessionEnrollment;
+        ALTER TABLE IF EXISTS scheduler.courses DROP CONSTRAINT IF EXISTS CHK_CourseEnrollment;
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP COLUMN IF EXISTS enrollment;
+        ALTER TABLE IF EXISTS scheduler.courses DROP COLUMN IF EXISTS enrollment;
+    END IF;
+END $$;
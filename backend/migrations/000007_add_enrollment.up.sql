@@ -0,0 +1,14 @@
+-- Expected enrollment drives capacity-aware room assignment.
+-- Course sessions may override the course-wide figure (e.g., a lab split into smaller groups).
+ALTER TABLE scheduler.courses ADD COLUMN enrollment INT NOT NULL DEFAULT 0;
+ALTER TABLE scheduler.course_sessions ADD COLUMN enrollment INT NULL;
+
+ALTER TABLE scheduler.courses
+ADD CONSTRAINT CHK_CourseEnrollment CHECK (enrollment >= 0);
+
+ALTER TABLE scheduler.course_sessions
+ADD CONSTRAINT CHK_CourseSessionEnrollment CHECK (enrollment IS NULL OR enrollment >= 0);
+
+-- Database catalog comments
+COMMENT ON COLUMN scheduler.courses.enrollment IS 'Expected number of students enrolled';
+COMMENT ON COLUMN scheduler.course_sessions.enrollment IS 'Overrides the course enrollment for this session when set';
@@ -0,0 +1,7 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TABLE IF EXISTS scheduler.course_session_instructors;
+        DROP TRIGGER IF EXISTS update_instructors_timestamp ON scheduler.instructors;
+        DROP TABLE IF EXISTS scheduler.instructors;
+    END IF;
+END $$;
@@ -0,0 +1,27 @@
+CREATE TABLE scheduler.instructors (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+CREATE TRIGGER update_instructors_timestamp
+BEFORE UPDATE ON scheduler.instructors
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Assigns instructors to course sessions (a session may be co-taught)
+CREATE TABLE scheduler.course_session_instructors (
+    course_session_id UUID NOT NULL,
+    instructor_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (course_session_id, instructor_id)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.course_session_instructors ADD FOREIGN KEY (course_session_id) REFERENCES scheduler.course_sessions(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.course_session_instructors ADD FOREIGN KEY (instructor_id) REFERENCES scheduler.instructors(id) ON DELETE CASCADE;
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.course_session_instructors IS 'Assigns instructors to the course sessions they teach';
@@ -0,0 +1,7 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TABLE IF EXISTS scheduler.cohort_courses;
+        DROP TRIGGER IF EXISTS update_cohorts_timestamp ON scheduler.cohorts;
+        DROP TABLE IF EXISTS scheduler.cohorts;
+    END IF;
+END $$;
@@ -0,0 +1,27 @@
+CREATE TABLE scheduler.cohorts (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+CREATE TRIGGER update_cohorts_timestamp
+BEFORE UPDATE ON scheduler.cohorts
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Links cohorts to the courses their students take together
+CREATE TABLE scheduler.cohort_courses (
+    cohort_id UUID NOT NULL,
+    course_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (cohort_id, course_id)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.cohort_courses ADD FOREIGN KEY (cohort_id) REFERENCES scheduler.cohorts(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.cohort_courses ADD FOREIGN KEY (course_id) REFERENCES scheduler.courses(id) ON DELETE CASCADE;
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.cohorts IS 'Groups of students, such as a year of a programme, whose courses must never overlap';
+COMMENT ON TABLE scheduler.cohort_courses IS 'Links cohorts to the courses their students take together';
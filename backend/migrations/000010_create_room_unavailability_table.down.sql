@@ -0,0 +1,6 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TRIGGER IF EXISTS update_room_unavailability_timestamp ON scheduler.room_unavailability;
+        DROP TABLE IF EXISTS scheduler.room_unavailability;
+    END IF;
+END $$;
@@ -0,0 +1,33 @@
+-- Weekly blackout windows when a room cannot be booked
+-- e.g., "Chapel is reserved every Friday afternoon", "Lab 2 is cleaned on Monday mornings"
+CREATE TABLE scheduler.room_unavailability (
+    id UUID PRIMARY KEY,
+    room_id UUID NOT NULL,
+    day INT NOT NULL,  -- 0-6 (0 = Monday, 6 = Sunday)
+    start_time INT NOT NULL,  -- minutes from midnight
+    end_time INT NOT NULL,  -- minutes from midnight
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.room_unavailability ADD FOREIGN KEY (room_id) REFERENCES scheduler.rooms(id) ON DELETE CASCADE;
+
+ALTER TABLE scheduler.room_unavailability
+ADD CONSTRAINT CHK_RoomUnavailabilityDay CHECK (day BETWEEN 0 AND 6);
+
+ALTER TABLE scheduler.room_unavailability
+ADD CONSTRAINT CHK_RoomUnavailabilityTime CHECK (start_time >= 0 AND start_time < end_time AND end_time <= 1440);
+
+-- Triggers
+CREATE TRIGGER update_room_unavailability_timestamp
+BEFORE UPDATE ON scheduler.room_unavailability
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.room_unavailability IS 'Weekly blackout windows when a room cannot be booked';
+COMMENT ON COLUMN scheduler.room_unavailability.day IS 'Day of the week: 0 = Monday, 6 = Sunday';
+COMMENT ON COLUMN scheduler.room_unavailability.start_time IS 'Start of the blackout in minutes from midnight';
+COMMENT ON COLUMN scheduler.room_unavailability.end_time IS 'End of the blackout in minutes from midnight';
@@ -0,0 +1,7 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TRIGGER IF EXISTS update_instructor_availability_timestamp ON scheduler.instructor_availability;
+        DROP TABLE IF EXISTS scheduler.instructor_availability;
+        DROP TYPE IF EXISTS scheduler.availability_kind;
+    END IF;
+END $$;
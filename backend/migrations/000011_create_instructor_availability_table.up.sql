@@ -0,0 +1,36 @@
+CREATE TYPE scheduler.availability_kind AS ENUM ('unavailable', 'preferred');
+
+-- Weekly windows describing when an instructor can teach
+-- 'unavailable' windows are never scheduled, 'preferred' windows are favoured when there is a choice
+CREATE TABLE scheduler.instructor_availability (
+    id UUID PRIMARY KEY,
+    instructor_id UUID NOT NULL,
+    kind scheduler.availability_kind NOT NULL,
+    day INT NOT NULL,  -- 0-6 (0 = Monday, 6 = Sunday)
+    start_time INT NOT NULL,  -- minutes from midnight
+    end_time INT NOT NULL,  -- minutes from midnight
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.instructor_availability ADD FOREIGN KEY (instructor_id) REFERENCES scheduler.instructors(id) ON DELETE CASCADE;
+
+ALTER TABLE scheduler.instructor_availability
+ADD CONSTRAINT CHK_InstructorAvailabilityDay CHECK (day BETWEEN 0 AND 6);
+
+ALTER TABLE scheduler.instructor_availability
+ADD CONSTRAINT CHK_InstructorAvailabilityTime CHECK (start_time >= 0 AND start_time < end_time AND end_time <= 1440);
+
+-- Triggers
+CREATE TRIGGER update_instructor_availability_timestamp
+BEFORE UPDATE ON scheduler.instructor_availability
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.instructor_availability IS 'Weekly windows when an instructor is unavailable or prefers to teach';
+COMMENT ON COLUMN scheduler.instructor_availability.kind IS 'unavailable (hard constraint) or preferred (soft constraint)';
+COMMENT ON COLUMN scheduler.instructor_availability.day IS 'Day of the week: 0 = Monday, 6 = Sunday';
+COMMENT ON COLUMN scheduler.instructor_availability.start_time IS 'Start of the window in minutes from midnight';
+COMMENT ON COLUMN scheduler.instructor_availability.end_time IS 'End of the window in minutes from midnight';
@@ -0,0 +1,6 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TRIGGER IF EXISTS update_building_travel_times_timestamp ON scheduler.building_travel_times;
+        DROP TABLE IF EXISTS scheduler.building_travel_times;
+    END IF;
+END $$;
@@ -0,0 +1,30 @@
+-- Minutes needed to walk from one building to another
+-- e.g., "Science Block to Library takes 10 minutes"
+CREATE TABLE scheduler.building_travel_times (
+    from_building_id UUID NOT NULL,
+    to_building_id UUID NOT NULL,
+    minutes INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    PRIMARY KEY (from_building_id, to_building_id)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.building_travel_times ADD FOREIGN KEY (from_building_id) REFERENCES scheduler.buildings(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.building_travel_times ADD FOREIGN KEY (to_building_id) REFERENCES scheduler.buildings(id) ON DELETE CASCADE;
+
+ALTER TABLE scheduler.building_travel_times
+ADD CONSTRAINT CHK_BuildingTravelTimeMinutes CHECK (minutes >= 0);
+
+ALTER TABLE scheduler.building_travel_times
+ADD CONSTRAINT CHK_BuildingTravelTimeDistinct CHECK (from_building_id <> to_building_id);
+
+-- Triggers
+CREATE TRIGGER update_building_travel_times_timestamp
+BEFORE UPDATE ON scheduler.building_travel_times
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.building_travel_times IS 'Minutes needed to travel between two buildings';
+COMMENT ON COLUMN scheduler.building_travel_times.minutes IS 'Travel time in minutes; applies in both directions unless the reverse is recorded';
@@ -0,0 +1,6 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TRIGGER IF EXISTS update_session_pins_timestamp ON scheduler.session_pins;
+        DROP TABLE IF EXISTS scheduler.session_pins;
+    END IF;
+END $$;
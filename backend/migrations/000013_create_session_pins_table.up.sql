@@ -0,0 +1,32 @@
+-- Fixed placements that the scheduler must honour for a course session
+-- e.g., "The Dean's seminar is always Monday 9:00 in the Senate room"
+CREATE TABLE scheduler.session_pins (
+    id UUID PRIMARY KEY,
+    course_session_id UUID NOT NULL,
+    room_id UUID NOT NULL,
+    day INT NOT NULL,  -- 0-6 (0 = Monday, 6 = Sunday)
+    start_time INT NOT NULL,  -- minutes from midnight
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.session_pins ADD FOREIGN KEY (course_session_id) REFERENCES scheduler.course_sessions(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.session_pins ADD FOREIGN KEY (room_id) REFERENCES scheduler.rooms(id) ON DELETE CASCADE;
+
+ALTER TABLE scheduler.session_pins
+ADD CONSTRAINT CHK_SessionPinDay CHECK (day BETWEEN 0 AND 6);
+
+ALTER TABLE scheduler.session_pins
+ADD CONSTRAINT CHK_SessionPinStartTime CHECK (start_time >= 0 AND start_time < 1440);
+
+-- Triggers
+CREATE TRIGGER update_session_pins_timestamp
+BEFORE UPDATE ON scheduler.session_pins
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.session_pins IS 'Fixed placements that the scheduler must honour for a course session';
+COMMENT ON COLUMN scheduler.session_pins.day IS 'Day of the week: 0 = Monday, 6 = Sunday';
+COMMENT ON COLUMN scheduler.session_pins.start_time IS 'Start of the pinned meeting in minutes from midnight; it lasts the course session duration';
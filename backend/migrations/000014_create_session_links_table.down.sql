@@ -0,0 +1,7 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TRIGGER IF EXISTS update_session_links_timestamp ON scheduler.session_links;
+        DROP TABLE IF EXISTS scheduler.session_links;
+        DROP TYPE IF EXISTS scheduler.session_link_kind;
+    END IF;
+END $$;
@@ -0,0 +1,34 @@
+CREATE TYPE scheduler.session_link_kind AS ENUM ('before', 'same_day', 'different_day', 'consecutive', 'same_room');
+
+-- Rules placing one course session relative to another
+-- e.g., "The lab must come after the week's first lecture"
+CREATE TABLE scheduler.session_links (
+    id UUID PRIMARY KEY,
+    course_session_id UUID NOT NULL,  -- the session the rule is anchored to
+    other_session_id UUID NOT NULL,  -- the session placed relative to it
+    kind scheduler.session_link_kind NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.session_links ADD FOREIGN KEY (course_session_id) REFERENCES scheduler.course_sessions(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.session_links ADD FOREIGN KEY (other_session_id) REFERENCES scheduler.course_sessions(id) ON DELETE CASCADE;
+
+ALTER TABLE scheduler.session_links
+ADD CONSTRAINT CHK_SessionLinkDistinct CHECK (course_session_id <> other_session_id);
+
+ALTER TABLE scheduler.session_links
+ADD CONSTRAINT UQ_SessionLink UNIQUE (course_session_id, other_session_id, kind);
+
+-- Triggers
+CREATE TRIGGER update_session_links_timestamp
+BEFORE UPDATE ON scheduler.session_links
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.session_links IS 'Rules placing one course session relative to another';
+COMMENT ON COLUMN scheduler.session_links.course_session_id IS 'The session the rule is anchored to';
+COMMENT ON COLUMN scheduler.session_links.other_session_id IS 'The session placed relative to the anchor';
+COMMENT ON COLUMN scheduler.session_links.kind IS 'before, same_day, different_day, consecutive or same_room';
@@ -0,0 +1,6 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TRIGGER IF EXISTS update_course_sections_timestamp ON scheduler.course_sections;
+        DROP TABLE IF EXISTS scheduler.course_sections;
+    END IF;
+END $$;
@@ -0,0 +1,32 @@
+-- Parallel sections of a course, each scheduled on its own
+-- e.g., "Calculus section A and section B each have their own lecture and lab times"
+CREATE TABLE scheduler.course_sections (
+    id UUID PRIMARY KEY,
+    course_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    capacity INT NULL,  -- overrides the enrollment of every course session when set
+    instructor_id UUID NULL,  -- teaches every course session of the section when set
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.course_sections ADD FOREIGN KEY (course_id) REFERENCES scheduler.courses(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.course_sections ADD FOREIGN KEY (instructor_id) REFERENCES scheduler.instructors(id) ON DELETE SET NULL;
+
+ALTER TABLE scheduler.course_sections
+ADD CONSTRAINT UQ_CourseSectionName UNIQUE (course_id, name);
+
+ALTER TABLE scheduler.course_sections
+ADD CONSTRAINT CHK_CourseSectionCapacity CHECK (capacity IS NULL OR capacity >= 0);
+
+-- Triggers
+CREATE TRIGGER update_course_sections_timestamp
+BEFORE UPDATE ON scheduler.course_sections
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.course_sections IS 'Parallel sections of a course, each scheduled on its own';
+COMMENT ON COLUMN scheduler.course_sections.capacity IS 'Overrides the enrollment of every course session of the section when set';
+COMMENT ON COLUMN scheduler.course_sections.instructor_id IS 'Teaches every course session of the section in place of the assigned instructors when set';
@@ -0,0 +1,8 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP CONSTRAINT IF EXISTS CHK_CourseSessionPreferredWindows;
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP CONSTRAINT IF EXISTS CHK_CourseSessionAllowedWindows;
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP COLUMN IF EXISTS preferred_windows;
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP COLUMN IF EXISTS allowed_windows;
+    END IF;
+END $$;
@@ -0,0 +1,14 @@
+-- Weekly time windows restrict or steer when a course session's meetings take place.
+-- Each is a JSONB array of {day (0-6, omitted for every day), start_time (mins), end_time (mins)}.
+ALTER TABLE scheduler.course_sessions ADD COLUMN allowed_windows JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE scheduler.course_sessions ADD COLUMN preferred_windows JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE scheduler.course_sessions
+ADD CONSTRAINT CHK_CourseSessionAllowedWindows CHECK (jsonb_typeof(allowed_windows) = 'array');
+
+ALTER TABLE scheduler.course_sessions
+ADD CONSTRAINT CHK_CourseSessionPreferredWindows CHECK (jsonb_typeof(preferred_windows) = 'array');
+
+-- Database catalog comments
+COMMENT ON COLUMN scheduler.course_sessions.allowed_windows IS 'JSONB array: [{day, start_time, end_time}, ...] - meetings only take place inside these when any are set';
+COMMENT ON COLUMN scheduler.course_sessions.preferred_windows IS 'JSONB array: [{day, start_time, end_time}, ...] - meetings are favoured inside these';
@@ -0,0 +1,6 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        ALTER TABLE IF EXISTS scheduler.courses DROP CONSTRAINT IF EXISTS CHK_CoursePriority;
+        ALTER TABLE IF EXISTS scheduler.courses DROP COLUMN IF EXISTS priority;
+    END IF;
+END $$;
@@ -0,0 +1,8 @@
+-- An explicit priority lets the priority weight strategy place important courses first.
+ALTER TABLE scheduler.courses ADD COLUMN priority INT NOT NULL DEFAULT 0;
+
+ALTER TABLE scheduler.courses
+ADD CONSTRAINT CHK_CoursePriority CHECK (priority >= 0);
+
+-- Database catalog comments
+COMMENT ON COLUMN scheduler.courses.priority IS 'Scheduling priority; higher is placed first by the priority weight strategy';
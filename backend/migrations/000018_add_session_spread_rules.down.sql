@@ -0,0 +1,6 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP CONSTRAINT IF EXISTS CHK_CourseSessionSpreadRules;
+        ALTER TABLE IF EXISTS scheduler.course_sessions DROP COLUMN IF EXISTS spread_rules;
+    END IF;
+END $$;
@@ -0,0 +1,9 @@
+-- Spreading rules override the scheduler config for one course session's meetings.
+-- A JSONB object of {min_days_between, max_per_day, day_patterns}; NULL uses the config.
+ALTER TABLE scheduler.course_sessions ADD COLUMN spread_rules JSONB NULL;
+
+ALTER TABLE scheduler.course_sessions
+ADD CONSTRAINT CHK_CourseSessionSpreadRules CHECK (spread_rules IS NULL OR jsonb_typeof(spread_rules) = 'object');
+
+-- Database catalog comments
+COMMENT ON COLUMN scheduler.course_sessions.spread_rules IS 'JSONB object: {min_days_between, max_per_day, day_patterns} - overrides the scheduler''s spreading rules';
@@ -0,0 +1,5 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TABLE IF EXISTS scheduler.room_type_substitutes;
+    END IF;
+END $$;
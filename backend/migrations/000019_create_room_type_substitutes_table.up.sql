@@ -0,0 +1,23 @@
+-- Room types a session may fall back to when no room of its required type is free
+-- e.g., "a seminar_room session may use a lecture_room, then a lecture_hall"
+CREATE TABLE scheduler.room_type_substitutes (
+    room_type VARCHAR(255) NOT NULL,
+    substitute VARCHAR(255) NOT NULL,
+    rank INT NOT NULL,  -- preference order, lowest first
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (room_type, substitute)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.room_type_substitutes ADD FOREIGN KEY (room_type) REFERENCES scheduler.room_types(name) ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE scheduler.room_type_substitutes ADD FOREIGN KEY (substitute) REFERENCES scheduler.room_types(name) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE scheduler.room_type_substitutes
+ADD CONSTRAINT UQ_RoomTypeSubstituteRank UNIQUE (room_type, rank);
+
+ALTER TABLE scheduler.room_type_substitutes
+ADD CONSTRAINT CHK_RoomTypeSubstituteSelf CHECK (room_type <> substitute);
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.room_type_substitutes IS 'Room types a session may fall back to when no room of its required type is free';
+COMMENT ON COLUMN scheduler.room_type_substitutes.rank IS 'Preference order among the substitutes of a room type, lowest first';
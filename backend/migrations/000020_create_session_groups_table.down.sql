@@ -0,0 +1,7 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        DROP TABLE IF EXISTS scheduler.session_group_members;
+        DROP TRIGGER IF EXISTS update_session_groups_timestamp ON scheduler.session_groups;
+        DROP TABLE IF EXISTS scheduler.session_groups;
+    END IF;
+END $$;
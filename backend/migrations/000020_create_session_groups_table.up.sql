@@ -0,0 +1,35 @@
+-- Combined session groups: course sessions taught together as one meeting in one room,
+-- e.g. an undergraduate and a graduate course cross-listed for the same lecture
+CREATE TABLE scheduler.session_groups (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+CREATE TRIGGER update_session_groups_timestamp
+BEFORE UPDATE ON scheduler.session_groups
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+-- Links session groups to the course sessions they combine
+CREATE TABLE scheduler.session_group_members (
+    group_id UUID NOT NULL,
+    course_session_id UUID NOT NULL,
+    position INT NOT NULL,  -- member order, the first leads the group
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, course_session_id)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.session_group_members ADD FOREIGN KEY (group_id) REFERENCES scheduler.session_groups(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.session_group_members ADD FOREIGN KEY (course_session_id) REFERENCES scheduler.course_sessions(id) ON DELETE CASCADE;
+
+-- A course session is combined with at most one group
+ALTER TABLE scheduler.session_group_members
+ADD CONSTRAINT UQ_SessionGroupMemberSession UNIQUE (course_session_id);
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.session_groups IS 'Course sessions of cross-listed courses scheduled together as one meeting in one room';
+COMMENT ON TABLE scheduler.session_group_members IS 'Links session groups to the course sessions they combine';
+COMMENT ON COLUMN scheduler.session_group_members.position IS 'Member order within the group, lowest first; the first member leads the group';
@@ -0,0 +1,12 @@
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = 'scheduler') THEN
+        UPDATE scheduler.course_sessions
+        SET preferred_windows = (
+            SELECT jsonb_agg(w - 'weight' ORDER BY i)
+            FROM jsonb_array_elements(preferred_windows) WITH ORDINALITY AS e(w, i)
+        )
+        WHERE jsonb_array_length(preferred_windows) > 0;
+
+        COMMENT ON COLUMN scheduler.course_sessions.preferred_windows IS 'JSONB array: [{day, start_time, end_time}, ...] - meetings are favoured inside these';
+    END IF;
+END $$;
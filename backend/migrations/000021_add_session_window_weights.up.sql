@@ -0,0 +1,11 @@
+-- Preferred windows carry a weight (> 0, default 1) saying how strongly each is favoured;
+-- schedulers try heavier windows first. Existing windows get the default weight.
+UPDATE scheduler.course_sessions
+SET preferred_windows = (
+    SELECT jsonb_agg('{"weight": 1}'::jsonb || w ORDER BY i)
+    FROM jsonb_array_elements(preferred_windows) WITH ORDINALITY AS e(w, i)
+)
+WHERE jsonb_array_length(preferred_windows) > 0;
+
+-- Database catalog comments
+COMMENT ON COLUMN scheduler.course_sessions.preferred_windows IS 'JSONB array: [{day, start_time, end_time, weight}, ...] - meetings are favoured inside these, heavier windows first';